@@ -294,7 +294,7 @@ func HandleSolve(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	var result []string
+	var result SolveResponse
 	var solveErr error
 
 	switch req.Matrix.Type {
@@ -309,12 +309,12 @@ func HandleSolve(w http.ResponseWriter, r *http.Request) {
 			http.Error(w, err.Error(), http.StatusBadRequest)
 			return
 		}
-		solution, err := float64Cache.CacheSolveSystem(mat, vec)
+		solution, err := float64Cache.CacheSolveGeneral(mat, vec)
 		if err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 			return
 		}
-		result = VectorToStrings(solution)
+		result = GeneralSolutionToResponse(solution)
 
 	case "complex":
 		mat, err := ParseComplexMatrix(req.Matrix)
@@ -327,12 +327,12 @@ func HandleSolve(w http.ResponseWriter, r *http.Request) {
 			http.Error(w, err.Error(), http.StatusBadRequest)
 			return
 		}
-		solution, err := complexCache.CacheSolveSystem(mat, vec)
+		solution, err := complexCache.CacheSolveGeneral(mat, vec)
 		if err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 			return
 		}
-		result = VectorToStrings(solution)
+		result = GeneralSolutionToResponse(solution)
 
 	case "rational":
 		mat, err := ParseRationalMatrix(req.Matrix)
@@ -345,12 +345,12 @@ func HandleSolve(w http.ResponseWriter, r *http.Request) {
 			http.Error(w, err.Error(), http.StatusBadRequest)
 			return
 		}
-		solution, err := rationalCache.CacheSolveSystem(mat, vec)
+		solution, err := rationalCache.CacheSolveGeneral(mat, vec)
 		if err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 			return
 		}
-		result = VectorToStrings(solution)
+		result = GeneralSolutionToResponse(solution)
 
 	case "gf":
 		mat, err := ParseGFMatrix(req.Matrix)
@@ -363,12 +363,12 @@ func HandleSolve(w http.ResponseWriter, r *http.Request) {
 			http.Error(w, err.Error(), http.StatusBadRequest)
 			return
 		}
-		solution, err := gfCache.CacheSolveSystem(mat, vec)
+		solution, err := gfCache.CacheSolveGeneral(mat, vec)
 		if err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 			return
 		}
-		result = VectorToStrings(solution)
+		result = GeneralSolutionToResponse(solution)
 
 	default:
 		http.Error(w, "неподдерживаемый тип поля", http.StatusBadRequest)
@@ -381,5 +381,5 @@ func HandleSolve(w http.ResponseWriter, r *http.Request) {
 	}
 
 	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(map[string][]string{"result": result})
+	json.NewEncoder(w).Encode(result)
 }
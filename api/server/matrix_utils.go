@@ -5,6 +5,7 @@ import (
 	"MatrixGo/internal/matrix"
 	"MatrixGo/internal/vector"
 	"fmt"
+	"math"
 	"strconv"
 	"strings"
 )
@@ -182,6 +183,22 @@ func MatrixToStrings(m interface{}) [][]string {
 	}
 }
 
+// ParseVector преобразует массив строк в вектор того же типа, что и матрица запроса
+func ParseVector(req MatrixRequest, data []string) (interface{}, error) {
+	switch req.Type {
+	case "float64":
+		return ParseFloat64Vector(data)
+	case "complex":
+		return ParseComplexVector(data)
+	case "rational":
+		return ParseRationalVector(data)
+	case "gf":
+		return ParseGFVector(data, req.ModP)
+	default:
+		return nil, fmt.Errorf("неподдерживаемый тип матрицы: %s", req.Type)
+	}
+}
+
 // ParseFloat64Vector конвертирует массив строк в вектор float64
 func ParseFloat64Vector(data []string) (*vector.Vector[field.Float64], error) {
 	if len(data) == 0 {
@@ -292,3 +309,278 @@ func VectorToStrings[T field.Field[T]](vec *vector.Vector[T]) []string {
 	}
 	return result
 }
+
+// VectorsToStrings конвертирует набор векторов в двумерный массив строк (по вектору на строку)
+func VectorsToStrings[T field.Field[T]](vecs []*vector.Vector[T]) [][]string {
+	result := make([][]string, len(vecs))
+	for i, v := range vecs {
+		result[i] = VectorToStrings(v)
+	}
+	return result
+}
+
+// GeneralSolutionToResponse преобразует общее решение системы в ответ сервера
+func GeneralSolutionToResponse[T field.Field[T]](sol *matrix.GeneralSolution[T]) SolveResponse {
+	resp := SolveResponse{
+		Kind: sol.Kind.String(),
+		Rank: sol.Rank,
+	}
+	if sol.Kind == matrix.Inconsistent {
+		return resp
+	}
+
+	resp.Result = [][]string{VectorToStrings(sol.Particular)}
+	if sol.Kind == matrix.InfiniteSolutions {
+		resp.Kernel = VectorsToStrings(sol.Kernel)
+		resp.FreeVariables = sol.FreeVariableNames()
+		resp.Parametric = sol.Parametric()
+	}
+	return resp
+}
+
+// roundedComplexString округляет части числа до 12 значащих цифр, скрывая ошибки округления
+func roundedComplexString(z field.Complex) string {
+	round := func(x float64) float64 {
+		r, _ := strconv.ParseFloat(strconv.FormatFloat(x, 'g', 12, 64), 64)
+		return r
+	}
+	return field.Complex{Re: round(z.Re), Im: round(z.Im)}.String()
+}
+
+// EigenToResponse вычисляет собственные значения и векторы и преобразует их в ответ сервера
+func EigenToResponse(m interface{}) (EigenResponse, error) {
+	var dec *matrix.EigenDecomposition
+	var err error
+	switch m := m.(type) {
+	case *matrix.Matrix[field.Float64]:
+		dec, err = matrix.Eigen(m)
+	case *matrix.Matrix[field.Complex]:
+		dec, err = matrix.Eigen(m)
+	default:
+		return EigenResponse{}, fmt.Errorf("собственные значения вычисляются только для float64 и complex матриц")
+	}
+	if err != nil {
+		return EigenResponse{}, err
+	}
+
+	resp := EigenResponse{
+		Values:    make([]string, len(dec.Values)),
+		Vectors:   make([][]string, len(dec.Values)),
+		Hermitian: dec.Hermitian,
+	}
+	for j, v := range dec.Values {
+		resp.Values[j] = roundedComplexString(v)
+		resp.Vectors[j] = make([]string, dec.Vectors.Rows)
+		for i := 0; i < dec.Vectors.Rows; i++ {
+			resp.Vectors[j][i] = roundedComplexString(dec.Vectors.Data[i][j])
+		}
+	}
+	return resp, nil
+}
+
+// matrixNorms заполняет нормы матрицы в ответе с диагностикой
+func matrixNorms[T field.Scalar[T]](m *matrix.Matrix[T]) (*DiagnosticsResponse, error) {
+	resp := &DiagnosticsResponse{}
+	for kind, dst := range map[matrix.NormKind]*float64{
+		matrix.NormOne:       &resp.NormOne,
+		matrix.NormInf:       &resp.NormInf,
+		matrix.NormFrobenius: &resp.NormFrobenius,
+		matrix.NormMax:       &resp.NormMax,
+	} {
+		norm, err := m.Norm(kind)
+		if err != nil {
+			return nil, err
+		}
+		*dst = norm
+	}
+	return resp, nil
+}
+
+// setCondition записывает число обусловленности, отмечая вырожденность вместо +Inf,
+// которое нельзя представить в JSON
+func (d *DiagnosticsResponse) setCondition(cond float64) {
+	if math.IsInf(cond, 1) || math.IsNaN(cond) {
+		d.Singular = true
+		return
+	}
+	d.Condition = cond
+}
+
+// SolveDiagnosticsToResponse преобразует диагностику решения системы в ответ сервера
+func SolveDiagnosticsToResponse[T field.Scalar[T]](m *matrix.Matrix[T], d *matrix.SolveDiagnostics) (*DiagnosticsResponse, error) {
+	resp, err := matrixNorms(m)
+	if err != nil {
+		return nil, err
+	}
+	resp.setCondition(d.Condition)
+	resp.Residual = d.Residual
+	resp.RelativeResidual = d.RelativeResidual
+	resp.BackwardError = d.BackwardError
+	if !resp.Singular {
+		resp.ForwardErrorBound = d.ForwardErrorBound
+	}
+	resp.RefinementSteps = d.RefinementSteps
+	return resp, nil
+}
+
+// InverseDiagnosticsToResponse вычисляет диагностику найденной обратной матрицы
+func InverseDiagnosticsToResponse[T field.Scalar[T]](m, inv *matrix.Matrix[T]) (*DiagnosticsResponse, error) {
+	d, err := matrix.DiagnoseInverse(m, inv)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := matrixNorms(m)
+	if err != nil {
+		return nil, err
+	}
+	resp.setCondition(d.Condition)
+	resp.Residual = d.Residual
+	resp.RelativeResidual = d.RelativeResidual
+	return resp, nil
+}
+
+// addSolveDiagnostics добавляет к ответу с единственным решением квадратной системы оценки
+// точности; при req.Refine решение float64 заменяется итерационно уточненным
+func addSolveDiagnostics[T field.Scalar[T]](m *matrix.Matrix[T], b *vector.Vector[T], sol *matrix.GeneralSolution[T], req SystemRequest, resp *SolveResponse) error {
+	if (!req.Diagnostics && !req.Refine) || sol.Kind != matrix.UniqueSolution || m.Rows != m.Cols {
+		return nil
+	}
+	var d *matrix.SolveDiagnostics
+	var err error
+	if fm, ok := any(m).(*matrix.Matrix[field.Float64]); ok && req.Refine {
+		var x *vector.Vector[field.Float64]
+		if x, d, err = matrix.SolveRefined(fm, any(b).(*vector.Vector[field.Float64]), 0); err != nil {
+			return err
+		}
+		resp.Result = [][]string{VectorToStrings(x)}
+	} else if d, err = matrix.DiagnoseSolution(m, b, sol.Particular); err != nil {
+		return err
+	}
+	resp.Diagnostics, err = SolveDiagnosticsToResponse(m, d)
+	return err
+}
+
+// maxMinorCount ограничивает число миноров, вычисляемых за один запрос
+const maxMinorCount = 10000
+
+// binomial вычисляет C(n, k), насыщаясь на maxMinorCount+1
+func binomial(n, k int) int {
+	res := 1
+	for i := 1; i <= k; i++ {
+		res = res * (n - k + i) / i
+		if res > maxMinorCount {
+			return maxMinorCount + 1
+		}
+	}
+	return res
+}
+
+// MinorsToResponse вычисляет запрошенные миноры параллельными методами
+func MinorsToResponse[T field.Field[T]](m *matrix.Matrix[T], req MinorsRequest) (MinorsResponse, error) {
+	var resp MinorsResponse
+	switch req.Kind {
+	case "cofactor":
+		cof, err := m.CofactorMatrixParallel()
+		if err != nil {
+			return resp, err
+		}
+		resp.Result = MatrixToStrings(cof)
+	case "adjugate":
+		adj, err := m.AdjugateParallel()
+		if err != nil {
+			return resp, err
+		}
+		resp.Result = MatrixToStrings(adj)
+	case "minor":
+		var value T
+		var err error
+		if len(req.Rows) > 0 || len(req.Cols) > 0 {
+			value, err = m.MinorOf(req.Rows, req.Cols)
+		} else {
+			value, err = m.Minor(req.Row, req.Col)
+		}
+		if err != nil {
+			return resp, err
+		}
+		resp.Value = fmt.Sprintf("%v", value)
+	case "leading":
+		values, err := m.LeadingPrincipalMinorsParallel()
+		if err != nil {
+			return resp, err
+		}
+		for k, v := range values {
+			indices := make([]int, k+1)
+			for i := range indices {
+				indices[i] = i
+			}
+			resp.Values = append(resp.Values, fmt.Sprintf("%v", v))
+			resp.Indices = append(resp.Indices, indices)
+		}
+	case "principal":
+		if binomial(m.Rows, req.Order) > maxMinorCount {
+			return resp, fmt.Errorf("слишком много миноров (больше %d)", maxMinorCount)
+		}
+		minors, err := m.PrincipalMinorsParallel(req.Order)
+		if err != nil {
+			return resp, err
+		}
+		for _, minor := range minors {
+			resp.Values = append(resp.Values, fmt.Sprintf("%v", minor.Value))
+			resp.Indices = append(resp.Indices, minor.Indices)
+		}
+	case "all":
+		if binomial(m.Rows, req.Order)*binomial(m.Cols, req.Order) > maxMinorCount {
+			return resp, fmt.Errorf("слишком много миноров (больше %d)", maxMinorCount)
+		}
+		compound, err := m.AllMinorsParallel(req.Order)
+		if err != nil {
+			return resp, err
+		}
+		resp.Result = MatrixToStrings(compound.Values)
+		resp.RowSets, resp.ColSets = compound.RowSets, compound.ColSets
+	default:
+		return resp, fmt.Errorf("неизвестный вид вычисления: %s", req.Kind)
+	}
+	return resp, nil
+}
+
+// AnalyzeToResponse строит отчет о структурных свойствах матрицы
+func AnalyzeToResponse(m interface{}, tol float64) (AnalyzeResponse, error) {
+	if tol < 0 {
+		return AnalyzeResponse{}, fmt.Errorf("допуск не может быть отрицательным")
+	}
+	var report *matrix.PropertyReport
+	switch m := m.(type) {
+	case *matrix.Matrix[field.Float64]:
+		report = m.Analyze(tol)
+	case *matrix.Matrix[field.Complex]:
+		report = m.Analyze(tol)
+	case *matrix.Matrix[field.Rational]:
+		report = m.Analyze(tol)
+	case *matrix.Matrix[field.GF]:
+		report = m.Analyze(tol)
+	default:
+		return AnalyzeResponse{}, fmt.Errorf("неподдерживаемый тип матрицы")
+	}
+	return AnalyzeResponse{
+		Rows:             report.Rows,
+		Cols:             report.Cols,
+		Exact:            report.Exact,
+		Tolerance:        report.Tolerance,
+		Square:           report.Square,
+		Symmetric:        report.Symmetric,
+		SkewSymmetric:    report.SkewSymmetric,
+		Hermitian:        report.Hermitian,
+		Orthogonal:       report.Orthogonal,
+		Unitary:          report.Unitary,
+		Diagonal:         report.Diagonal,
+		UpperTriangular:  report.UpperTriangular,
+		LowerTriangular:  report.LowerTriangular,
+		Idempotent:       report.Idempotent,
+		Involutory:       report.Involutory,
+		Nilpotent:        report.Nilpotent,
+		NilpotencyIndex:  report.NilpotencyIndex,
+		PositiveDefinite: report.PositiveDefinite,
+		Diagonalizable:   report.Diagonalizable,
+	}, nil
+}
@@ -6,6 +6,7 @@ import (
 	"MatrixGo/internal/vector"
 	"encoding/json"
 	"fmt"
+	"math/cmplx"
 	"net/http"
 	"strconv"
 	"strings"
@@ -33,6 +34,21 @@ func (s *Server) routes() {
 	s.router.HandleFunc("/api/v1/matrix/inverse", s.handleMatrixInverse()).Methods("POST")
 	s.router.HandleFunc("/api/v1/matrix/determinant", s.handleMatrixDeterminant()).Methods("POST")
 	s.router.HandleFunc("/api/v1/matrix/rank", s.handleMatrixRank()).Methods("POST")
+	s.router.HandleFunc("/api/v1/matrix/rref", s.handleMatrixRREF()).Methods("POST")
+	s.router.HandleFunc("/api/v1/matrix/nullspace", s.handleMatrixNullSpace()).Methods("POST")
+	s.router.HandleFunc("/api/v1/matrix/qr", s.handleMatrixQR()).Methods("POST")
+	s.router.HandleFunc("/api/v1/matrix/least-squares", s.handleMatrixLeastSquares()).Methods("POST")
+	s.router.HandleFunc("/api/v1/matrix/eigen", s.handleMatrixEigen()).Methods("POST")
+	s.router.HandleFunc("/api/v1/matrix/charpoly", s.handleMatrixCharPoly()).Methods("POST")
+	s.router.HandleFunc("/api/v1/matrix/canonical", s.handleMatrixCanonical()).Methods("POST")
+	s.router.HandleFunc("/api/v1/matrix/similar", s.handleMatrixSimilar()).Methods("POST")
+	s.router.HandleFunc("/api/v1/matrix/normal-form", s.handleMatrixNormalForm()).Methods("POST")
+	s.router.HandleFunc("/api/v1/matrix/solve-integer", s.handleMatrixSolveInteger()).Methods("POST")
+	s.router.HandleFunc("/api/v1/matrix/lll", s.handleMatrixLLL()).Methods("POST")
+	s.router.HandleFunc("/api/v1/matrix/power", s.handleMatrixPower()).Methods("POST")
+	s.router.HandleFunc("/api/v1/matrix/function", s.handleMatrixFunction()).Methods("POST")
+	s.router.HandleFunc("/api/v1/matrix/minors", s.handleMatrixMinors()).Methods("POST")
+	s.router.HandleFunc("/api/v1/matrix/analyze", s.handleMatrixAnalyze()).Methods("POST")
 }
 
 func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
@@ -300,66 +316,62 @@ func (s *Server) handleMatrixSolve() http.HandlerFunc {
 			return
 		}
 
+		if req.Diagnostics && req.Matrix.Type != "float64" && req.Matrix.Type != "complex" {
+			http.Error(w, "оценки точности доступны только для float64 и complex", http.StatusBadRequest)
+			return
+		}
+		if req.Refine && req.Matrix.Type != "float64" {
+			http.Error(w, "итерационное уточнение доступно только для float64", http.StatusBadRequest)
+			return
+		}
+
 		// Решаем систему в зависимости от типа
-		var result interface{}
+		var result SolveResponse
 		switch m := m.(type) {
 		case *matrix.Matrix[field.Float64]:
 			b := b.(*vector.Vector[field.Float64])
-			result, err = matrix.SolveSystem(m, b)
+			var sol *matrix.GeneralSolution[field.Float64]
+			if sol, err = matrix.SolveGeneral(m, b); err == nil {
+				result = GeneralSolutionToResponse(sol)
+				err = addSolveDiagnostics(m, b, sol, req, &result)
+			}
 		case *matrix.Matrix[field.Complex]:
 			b := b.(*vector.Vector[field.Complex])
-			result, err = matrix.SolveSystem(m, b)
+			var sol *matrix.GeneralSolution[field.Complex]
+			if sol, err = matrix.SolveGeneral(m, b); err == nil {
+				result = GeneralSolutionToResponse(sol)
+				err = addSolveDiagnostics(m, b, sol, req, &result)
+			}
 		case *matrix.Matrix[field.Rational]:
 			b := b.(*vector.Vector[field.Rational])
-			result, err = matrix.SolveSystem(m, b)
+			var sol *matrix.GeneralSolution[field.Rational]
+			if sol, err = matrix.SolveGeneral(m, b); err == nil {
+				result = GeneralSolutionToResponse(sol)
+			}
 		case *matrix.Matrix[field.GF]:
 			b := b.(*vector.Vector[field.GF])
-			result, err = matrix.SolveSystem(m, b)
+			var sol *matrix.GeneralSolution[field.GF]
+			if sol, err = matrix.SolveGeneral(m, b); err == nil {
+				result = GeneralSolutionToResponse(sol)
+			}
 		default:
 			http.Error(w, "неподдерживаемый тип матрицы", http.StatusBadRequest)
 			return
 		}
 
 		if err != nil {
-			http.Error(w, fmt.Sprintf("ошибка решения системы: %v", err), http.StatusInternalServerError)
+			http.Error(w, fmt.Sprintf("ошибка решения системы: %v", err), http.StatusBadRequest)
 			return
 		}
 
-		// Преобразуем результат в строки
-		var resultStrings []string
-		switch result := result.(type) {
-		case *vector.Vector[field.Float64]:
-			resultStrings = make([]string, result.Size)
-			for i := 0; i < result.Size; i++ {
-				resultStrings[i] = fmt.Sprintf("%v", result.Data[i])
-			}
-		case *vector.Vector[field.Complex]:
-			resultStrings = make([]string, result.Size)
-			for i := 0; i < result.Size; i++ {
-				resultStrings[i] = fmt.Sprintf("%v", result.Data[i])
-			}
-		case *vector.Vector[field.Rational]:
-			resultStrings = make([]string, result.Size)
-			for i := 0; i < result.Size; i++ {
-				resultStrings[i] = fmt.Sprintf("%v", result.Data[i])
-			}
-		case *vector.Vector[field.GF]:
-			resultStrings = make([]string, result.Size)
-			for i := 0; i < result.Size; i++ {
-				resultStrings[i] = fmt.Sprintf("%v", result.Data[i])
-			}
-		}
-
 		w.Header().Set("Content-Type", "application/json")
-		json.NewEncoder(w).Encode(MatrixResponse{
-			Result: [][]string{resultStrings}, // Возвращаем как матрицу 1xn
-		})
+		json.NewEncoder(w).Encode(result)
 	}
 }
 
 func (s *Server) handleMatrixInverse() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		var req MatrixRequest
+		var req InverseRequest
 
 		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 			http.Error(w, err.Error(), http.StatusBadRequest)
@@ -367,19 +379,32 @@ func (s *Server) handleMatrixInverse() http.HandlerFunc {
 		}
 
 		// Парсим матрицу
-		m, err := ParseMatrix(req)
+		m, err := ParseMatrix(req.MatrixRequest)
 		if err != nil {
 			http.Error(w, fmt.Sprintf("ошибка парсинга матрицы: %v", err), http.StatusBadRequest)
 			return
 		}
+		if req.Diagnostics && req.Type != "float64" && req.Type != "complex" {
+			http.Error(w, "оценки точности доступны только для float64 и complex", http.StatusBadRequest)
+			return
+		}
 
 		// Выполняем обращение в зависимости от типа
 		var result interface{}
+		var diagnostics *DiagnosticsResponse
 		switch m := m.(type) {
 		case *matrix.Matrix[field.Float64]:
-			result, err = m.Inverse()
+			var inv *matrix.Matrix[field.Float64]
+			if inv, err = m.Inverse(); err == nil && req.Diagnostics {
+				diagnostics, err = InverseDiagnosticsToResponse(m, inv)
+			}
+			result = inv
 		case *matrix.Matrix[field.Complex]:
-			result, err = m.Inverse()
+			var inv *matrix.Matrix[field.Complex]
+			if inv, err = m.Inverse(); err == nil && req.Diagnostics {
+				diagnostics, err = InverseDiagnosticsToResponse(m, inv)
+			}
+			result = inv
 		case *matrix.Matrix[field.Rational]:
 			result, err = m.Inverse()
 		case *matrix.Matrix[field.GF]:
@@ -395,8 +420,9 @@ func (s *Server) handleMatrixInverse() http.HandlerFunc {
 		}
 
 		w.Header().Set("Content-Type", "application/json")
-		json.NewEncoder(w).Encode(MatrixResponse{
-			Result: MatrixToStrings(result),
+		json.NewEncoder(w).Encode(InverseResponse{
+			Result:      MatrixToStrings(result),
+			Diagnostics: diagnostics,
 		})
 	}
 }
@@ -478,3 +504,830 @@ func (s *Server) handleMatrixRank() http.HandlerFunc {
 		})
 	}
 }
+
+// rrefToResponse преобразует результат RREF в ответ сервера
+func rrefToResponse[T field.Field[T]](res *matrix.RREFResult[T]) RREFResponse {
+	resp := RREFResponse{
+		Result:      MatrixToStrings(res.Reduced),
+		Pivots:      res.Pivots,
+		Rank:        res.Rank,
+		Permutation: res.Perm,
+	}
+	if res.Transform != nil {
+		resp.Transform = MatrixToStrings(res.Transform)
+	}
+	return resp
+}
+
+func (s *Server) handleMatrixRREF() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req RREFRequest
+
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		// Парсим матрицу
+		m, err := ParseMatrix(req.MatrixRequest)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("ошибка парсинга матрицы: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		// Приводим к ступенчатому виду в зависимости от типа
+		var result RREFResponse
+		switch m := m.(type) {
+		case *matrix.Matrix[field.Float64]:
+			result = rrefToResponse(m.RREFParallel(req.Transform))
+		case *matrix.Matrix[field.Complex]:
+			result = rrefToResponse(m.RREFParallel(req.Transform))
+		case *matrix.Matrix[field.Rational]:
+			result = rrefToResponse(m.RREFParallel(req.Transform))
+		case *matrix.Matrix[field.GF]:
+			result = rrefToResponse(m.RREFParallel(req.Transform))
+		default:
+			http.Error(w, "неподдерживаемый тип матрицы", http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(result)
+	}
+}
+
+// nullSpaceToResponse вычисляет базис ядра (или левого ядра) и преобразует его в ответ сервера
+func nullSpaceToResponse[T field.Field[T]](m *matrix.Matrix[T], left bool) NullSpaceResponse {
+	var basis []*vector.Vector[T]
+	if left {
+		basis = matrix.LeftNullSpace(m)
+	} else {
+		basis = matrix.NullSpace(m)
+	}
+	return NullSpaceResponse{
+		Basis:     VectorsToStrings(basis),
+		Dimension: len(basis),
+	}
+}
+
+func (s *Server) handleMatrixNullSpace() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req NullSpaceRequest
+
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		// Парсим матрицу
+		m, err := ParseMatrix(req.MatrixRequest)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("ошибка парсинга матрицы: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		// Вычисляем базис ядра в зависимости от типа
+		var result NullSpaceResponse
+		switch m := m.(type) {
+		case *matrix.Matrix[field.Float64]:
+			result = nullSpaceToResponse(m, req.Left)
+		case *matrix.Matrix[field.Complex]:
+			result = nullSpaceToResponse(m, req.Left)
+		case *matrix.Matrix[field.Rational]:
+			result = nullSpaceToResponse(m, req.Left)
+		case *matrix.Matrix[field.GF]:
+			result = nullSpaceToResponse(m, req.Left)
+		default:
+			http.Error(w, "неподдерживаемый тип матрицы", http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(result)
+	}
+}
+
+// householderQRToResponse вычисляет QR-разложение Хаусхолдера и преобразует его в ответ сервера
+func householderQRToResponse[T field.Scalar[T]](m *matrix.Matrix[T], pivoting bool) QRResponse {
+	qr := matrix.HouseholderQR(m, pivoting)
+	return QRResponse{
+		Q:           MatrixToStrings(qr.Q),
+		R:           MatrixToStrings(qr.R),
+		Permutation: qr.Perm,
+		Rank:        qr.Rank,
+	}
+}
+
+func (s *Server) handleMatrixQR() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req QRRequest
+
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		// Парсим матрицу
+		m, err := ParseMatrix(req.MatrixRequest)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("ошибка парсинга матрицы: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		// Выполняем разложение в зависимости от типа
+		var result QRResponse
+		switch m := m.(type) {
+		case *matrix.Matrix[field.Float64]:
+			result = householderQRToResponse(m, req.Pivoting)
+		case *matrix.Matrix[field.Complex]:
+			result = householderQRToResponse(m, req.Pivoting)
+		case *matrix.Matrix[field.Rational]:
+			qr := matrix.GramSchmidtQR(m)
+			d := make([]string, len(qr.D))
+			for i, v := range qr.D {
+				d[i] = v.String()
+			}
+			result = QRResponse{
+				Q:    MatrixToStrings(qr.Q),
+				R:    MatrixToStrings(qr.R),
+				D:    d,
+				Rank: qr.Rank,
+			}
+		default:
+			http.Error(w, "QR-разложение не поддерживается для данного типа матрицы", http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(result)
+	}
+}
+
+// leastSquaresToResponse решает задачу наименьших квадратов и преобразует результат в ответ сервера
+func leastSquaresToResponse[T field.Field[T]](m *matrix.Matrix[T], b *vector.Vector[T]) (LeastSquaresResponse, error) {
+	res, err := matrix.LeastSquares(m, b)
+	if err != nil {
+		return LeastSquaresResponse{}, err
+	}
+	return LeastSquaresResponse{
+		Result:   [][]string{VectorToStrings(res.Solution)},
+		Residual: VectorToStrings(res.Residual),
+		Rank:     res.Rank,
+	}, nil
+}
+
+func (s *Server) handleMatrixLeastSquares() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req SystemRequest
+
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		// Парсим матрицу и вектор правой части
+		m, err := ParseMatrix(req.Matrix)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("ошибка парсинга матрицы: %v", err), http.StatusBadRequest)
+			return
+		}
+		b, err := ParseVector(req.Matrix, req.Vector)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("ошибка парсинга вектора: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		// Решаем задачу в зависимости от типа
+		var result LeastSquaresResponse
+		switch m := m.(type) {
+		case *matrix.Matrix[field.Float64]:
+			result, err = leastSquaresToResponse(m, b.(*vector.Vector[field.Float64]))
+		case *matrix.Matrix[field.Complex]:
+			result, err = leastSquaresToResponse(m, b.(*vector.Vector[field.Complex]))
+		case *matrix.Matrix[field.Rational]:
+			result, err = leastSquaresToResponse(m, b.(*vector.Vector[field.Rational]))
+		case *matrix.Matrix[field.GF]:
+			result, err = leastSquaresToResponse(m, b.(*vector.Vector[field.GF]))
+		default:
+			http.Error(w, "неподдерживаемый тип матрицы", http.StatusBadRequest)
+			return
+		}
+
+		if err != nil {
+			http.Error(w, fmt.Sprintf("ошибка решения задачи наименьших квадратов: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(result)
+	}
+}
+
+func (s *Server) handleMatrixEigen() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req MatrixRequest
+
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		// Парсим матрицу
+		m, err := ParseMatrix(req)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("ошибка парсинга матрицы: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		result, err := EigenToResponse(m)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("ошибка вычисления собственных значений: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(result)
+	}
+}
+
+// charPolyToResponse вычисляет характеристический и минимальный многочлены и преобразует их в ответ сервера
+func charPolyToResponse[T field.Field[T]](m *matrix.Matrix[T]) (CharPolyResponse, error) {
+	chi, err := matrix.CharacteristicPolynomial(m)
+	if err != nil {
+		return CharPolyResponse{}, err
+	}
+	mu, err := matrix.MinimalPolynomial(m)
+	if err != nil {
+		return CharPolyResponse{}, err
+	}
+	ok, err := matrix.VerifyCayleyHamilton(m)
+	if err != nil {
+		return CharPolyResponse{}, err
+	}
+
+	coeffs := func(c []T) []string {
+		res := make([]string, len(c))
+		for i, v := range c {
+			res[i] = fmt.Sprint(v)
+		}
+		return res
+	}
+	return CharPolyResponse{
+		Characteristic:             chi.String(),
+		CharacteristicCoefficients: coeffs(chi.Coeffs),
+		Minimal:                    mu.String(),
+		MinimalCoefficients:        coeffs(mu.Coeffs),
+		CayleyHamilton:             ok,
+	}, nil
+}
+
+func (s *Server) handleMatrixCharPoly() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req MatrixRequest
+
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		// Парсим матрицу
+		m, err := ParseMatrix(req)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("ошибка парсинга матрицы: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		// Вычисляем многочлены в зависимости от типа
+		var result CharPolyResponse
+		switch m := m.(type) {
+		case *matrix.Matrix[field.Float64]:
+			result, err = charPolyToResponse(m)
+		case *matrix.Matrix[field.Complex]:
+			result, err = charPolyToResponse(m)
+		case *matrix.Matrix[field.Rational]:
+			result, err = charPolyToResponse(m)
+		case *matrix.Matrix[field.GF]:
+			result, err = charPolyToResponse(m)
+		default:
+			http.Error(w, "неподдерживаемый тип матрицы", http.StatusBadRequest)
+			return
+		}
+
+		if err != nil {
+			http.Error(w, fmt.Sprintf("ошибка вычисления многочленов: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(result)
+	}
+}
+
+func canonicalFormToResponse[T field.Field[T]](m *matrix.Matrix[T], form string) (CanonicalFormResponse, error) {
+	switch form {
+	case "", "frobenius":
+		res, err := matrix.FrobeniusForm(m)
+		if err != nil {
+			return CanonicalFormResponse{}, err
+		}
+		factors := make([]string, len(res.InvariantFactors))
+		for i, p := range res.InvariantFactors {
+			factors[i] = p.String()
+		}
+		return CanonicalFormResponse{
+			Form:             "frobenius",
+			Result:           MatrixToStrings(res.F),
+			Transform:        MatrixToStrings(res.P),
+			InvariantFactors: factors,
+		}, nil
+	case "jordan":
+		res, err := matrix.JordanForm(m)
+		if err != nil {
+			return CanonicalFormResponse{}, err
+		}
+		blocks := make([]JordanBlockResponse, len(res.Blocks))
+		for i, b := range res.Blocks {
+			blocks[i] = JordanBlockResponse{Eigenvalue: fmt.Sprint(b.Eigenvalue), Size: b.Size}
+		}
+		return CanonicalFormResponse{
+			Form:      "jordan",
+			Result:    MatrixToStrings(res.J),
+			Transform: MatrixToStrings(res.P),
+			Blocks:    blocks,
+		}, nil
+	default:
+		return CanonicalFormResponse{}, fmt.Errorf("неизвестная каноническая форма: %s", form)
+	}
+}
+
+func (s *Server) handleMatrixCanonical() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req CanonicalFormRequest
+
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		// Парсим матрицу
+		m, err := ParseMatrix(req.MatrixRequest)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("ошибка парсинга матрицы: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		// Вычисляем каноническую форму в зависимости от типа
+		var result CanonicalFormResponse
+		switch m := m.(type) {
+		case *matrix.Matrix[field.Float64]:
+			result, err = canonicalFormToResponse(m, req.Form)
+		case *matrix.Matrix[field.Complex]:
+			result, err = canonicalFormToResponse(m, req.Form)
+		case *matrix.Matrix[field.Rational]:
+			result, err = canonicalFormToResponse(m, req.Form)
+		case *matrix.Matrix[field.GF]:
+			result, err = canonicalFormToResponse(m, req.Form)
+		default:
+			http.Error(w, "неподдерживаемый тип матрицы", http.StatusBadRequest)
+			return
+		}
+
+		if err != nil {
+			http.Error(w, fmt.Sprintf("ошибка вычисления канонической формы: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(result)
+	}
+}
+
+func similarityToResponse[T field.Field[T]](a, b *matrix.Matrix[T]) (SimilarityResponse, error) {
+	ok, S, err := matrix.Similar(a, b)
+	if err != nil {
+		return SimilarityResponse{}, err
+	}
+	res := SimilarityResponse{Similar: ok}
+	if ok {
+		res.Transform = MatrixToStrings(S)
+	}
+	return res, nil
+}
+
+func (s *Server) handleMatrixSimilar() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Matrix1 MatrixRequest `json:"matrix1"`
+			Matrix2 MatrixRequest `json:"matrix2"`
+		}
+
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		// Проверяем, что матрицы одного типа
+		if req.Matrix1.Type != req.Matrix2.Type {
+			http.Error(w, "матрицы разных типов", http.StatusBadRequest)
+			return
+		}
+
+		// Парсим матрицы
+		m1, err := ParseMatrix(req.Matrix1)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("ошибка парсинга первой матрицы: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		m2, err := ParseMatrix(req.Matrix2)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("ошибка парсинга второй матрицы: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		// Проверяем подобие в зависимости от типа
+		var result SimilarityResponse
+		switch m1 := m1.(type) {
+		case *matrix.Matrix[field.Float64]:
+			result, err = similarityToResponse(m1, m2.(*matrix.Matrix[field.Float64]))
+		case *matrix.Matrix[field.Complex]:
+			result, err = similarityToResponse(m1, m2.(*matrix.Matrix[field.Complex]))
+		case *matrix.Matrix[field.Rational]:
+			result, err = similarityToResponse(m1, m2.(*matrix.Matrix[field.Rational]))
+		case *matrix.Matrix[field.GF]:
+			result, err = similarityToResponse(m1, m2.(*matrix.Matrix[field.GF]))
+		default:
+			http.Error(w, "неподдерживаемый тип матрицы", http.StatusBadRequest)
+			return
+		}
+
+		if err != nil {
+			http.Error(w, fmt.Sprintf("ошибка проверки подобия: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(result)
+	}
+}
+
+func (s *Server) handleMatrixNormalForm() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req NormalFormRequest
+
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		// Нормальные формы определены для целочисленных матриц, которые передаются как rational
+		if req.Type != "rational" {
+			http.Error(w, "нормальные формы вычисляются только для целочисленных матриц типа rational", http.StatusBadRequest)
+			return
+		}
+		m, err := ParseRationalMatrix(req.MatrixRequest)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("ошибка парсинга матрицы: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		var result NormalFormResponse
+		switch req.Form {
+		case "", "smith":
+			var res *matrix.SmithDecomposition
+			if res, err = matrix.SmithForm(m); err == nil {
+				result = NormalFormResponse{
+					Form:       "smith",
+					Result:     MatrixToStrings(res.D),
+					U:          MatrixToStrings(res.U),
+					V:          MatrixToStrings(res.V),
+					Invariants: VectorToStrings(vector.NewVector(res.Invariants)),
+					Rank:       res.Rank,
+				}
+			}
+		case "hermite":
+			var res *matrix.HermiteDecomposition
+			if res, err = matrix.HermiteForm(m, req.Reduce); err == nil {
+				result = NormalFormResponse{
+					Form:   "hermite",
+					Result: MatrixToStrings(res.H),
+					U:      MatrixToStrings(res.U),
+					Pivots: res.Pivots,
+					Rank:   res.Rank,
+				}
+			}
+		default:
+			err = fmt.Errorf("неизвестная нормальная форма: %s", req.Form)
+		}
+
+		if err != nil {
+			http.Error(w, fmt.Sprintf("ошибка вычисления нормальной формы: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(result)
+	}
+}
+
+func (s *Server) handleMatrixSolveInteger() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req SystemRequest
+
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		if req.Matrix.Type != "rational" {
+			http.Error(w, "целочисленные решения ищутся только для матриц типа rational", http.StatusBadRequest)
+			return
+		}
+		m, err := ParseRationalMatrix(req.Matrix)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("ошибка парсинга матрицы: %v", err), http.StatusBadRequest)
+			return
+		}
+		b, err := ParseRationalVector(req.Vector)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("ошибка парсинга вектора: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		sol, err := matrix.SolveInteger(m, b)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("ошибка решения системы: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		result := IntegerSolveResponse{Solvable: sol.Solvable}
+		if sol.Solvable {
+			result.Particular = VectorToStrings(sol.Particular)
+			result.Kernel = VectorsToStrings(sol.Kernel)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(result)
+	}
+}
+
+func lllToResponse[T matrix.LatticeField[T]](res *matrix.LLLResult[T]) LLLResponse {
+	est := res.ShortestVectorEstimate()
+	return LLLResponse{
+		Basis:       MatrixToStrings(res.Basis),
+		Transform:   MatrixToStrings(res.U),
+		GramSchmidt: MatrixToStrings(res.GramSchmidt),
+		Mu:          MatrixToStrings(res.Mu),
+		Norms:       VectorToStrings(vector.NewVector(res.Norms)),
+		Swaps:       res.Swaps,
+		Shortest: ShortestVectorResponse{
+			Vector:            VectorToStrings(vector.NewVector(res.Basis.Data[0])),
+			Length:            est.Length,
+			LowerBound:        est.LowerBound,
+			ApproxFactor:      est.ApproxFactor,
+			GaussianHeuristic: est.GaussianHeuristic,
+			MinkowskiBound:    est.MinkowskiBound,
+		},
+	}
+}
+
+func (s *Server) handleMatrixLLL() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req LLLRequest
+
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		// Парсим матрицу
+		m, err := ParseMatrix(req.MatrixRequest)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("ошибка парсинга матрицы: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		// Точный вариант для rational, приближенный для float64
+		var result LLLResponse
+		switch m := m.(type) {
+		case *matrix.Matrix[field.Rational]:
+			delta := field.NewRational(3, 4)
+			if req.Delta != "" {
+				d, err := ParseRationalVector([]string{req.Delta})
+				if err != nil {
+					http.Error(w, fmt.Sprintf("ошибка парсинга delta: %v", err), http.StatusBadRequest)
+					return
+				}
+				delta = d.Data[0]
+			}
+			var res *matrix.LLLResult[field.Rational]
+			if res, err = matrix.LLL(m, delta); err == nil {
+				result = lllToResponse(res)
+			}
+		case *matrix.Matrix[field.Float64]:
+			delta := 0.75
+			if req.Delta != "" {
+				if delta, err = strconv.ParseFloat(req.Delta, 64); err != nil {
+					http.Error(w, fmt.Sprintf("ошибка парсинга delta: %v", err), http.StatusBadRequest)
+					return
+				}
+			}
+			var res *matrix.LLLResult[field.Float64]
+			if res, err = matrix.LLLFloat(m, delta); err == nil {
+				result = lllToResponse(res)
+			}
+		default:
+			http.Error(w, "LLL поддерживается только для типов rational и float64", http.StatusBadRequest)
+			return
+		}
+
+		if err != nil {
+			http.Error(w, fmt.Sprintf("ошибка LLL-приведения: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(result)
+	}
+}
+
+func (s *Server) handleMatrixPower() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req PowerRequest
+
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		// Парсим матрицу
+		m, err := ParseMatrix(req.MatrixRequest)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("ошибка парсинга матрицы: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		var result interface{}
+		switch m := m.(type) {
+		case *matrix.Matrix[field.Float64]:
+			result, err = m.PowParallel(req.Exponent)
+		case *matrix.Matrix[field.Complex]:
+			result, err = m.PowParallel(req.Exponent)
+		case *matrix.Matrix[field.Rational]:
+			result, err = m.PowParallel(req.Exponent)
+		case *matrix.Matrix[field.GF]:
+			result, err = m.PowParallel(req.Exponent)
+		default:
+			http.Error(w, "неподдерживаемый тип матрицы", http.StatusBadRequest)
+			return
+		}
+
+		if err != nil {
+			http.Error(w, fmt.Sprintf("ошибка возведения в степень: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(MatrixResponse{
+			Result: MatrixToStrings(result),
+		})
+	}
+}
+
+// elementaryFunctions — функции, вычисляемые методом Шура–Парлетта по имени из запроса
+var elementaryFunctions = map[string]func(complex128) complex128{
+	"sin":  cmplx.Sin,
+	"cos":  cmplx.Cos,
+	"tan":  cmplx.Tan,
+	"sinh": cmplx.Sinh,
+	"cosh": cmplx.Cosh,
+	"tanh": cmplx.Tanh,
+}
+
+// applyMatrixFunction вычисляет функцию матрицы по имени
+func applyMatrixFunction[T field.Scalar[T]](m *matrix.Matrix[T], name string) (*matrix.Matrix[T], error) {
+	switch name {
+	case "exp":
+		return matrix.ExpParallel(m)
+	case "log":
+		return matrix.LogParallel(m)
+	case "sqrt":
+		return matrix.SqrtParallel(m)
+	}
+	f, ok := elementaryFunctions[name]
+	if !ok {
+		return nil, fmt.Errorf("неизвестная функция %q", name)
+	}
+	return matrix.FunctionParallel(m, func(z field.Complex) field.Complex {
+		r := f(complex(z.Re, z.Im))
+		return field.Complex{Re: real(r), Im: imag(r)}
+	})
+}
+
+func (s *Server) handleMatrixFunction() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req FunctionRequest
+
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		// Парсим матрицу
+		m, err := ParseMatrix(req.MatrixRequest)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("ошибка парсинга матрицы: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		// Функции матриц определены только для числовых полей
+		var result interface{}
+		switch m := m.(type) {
+		case *matrix.Matrix[field.Float64]:
+			result, err = applyMatrixFunction(m, req.Function)
+		case *matrix.Matrix[field.Complex]:
+			result, err = applyMatrixFunction(m, req.Function)
+		default:
+			http.Error(w, "функции матриц поддерживаются только для типов float64 и complex", http.StatusBadRequest)
+			return
+		}
+
+		if err != nil {
+			http.Error(w, fmt.Sprintf("ошибка вычисления функции матрицы: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(MatrixResponse{
+			Result: MatrixToStrings(result),
+		})
+	}
+}
+
+func (s *Server) handleMatrixMinors() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req MinorsRequest
+
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		// Парсим матрицу
+		m, err := ParseMatrix(req.MatrixRequest)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("ошибка парсинга матрицы: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		var result MinorsResponse
+		switch m := m.(type) {
+		case *matrix.Matrix[field.Float64]:
+			result, err = MinorsToResponse(m, req)
+		case *matrix.Matrix[field.Complex]:
+			result, err = MinorsToResponse(m, req)
+		case *matrix.Matrix[field.Rational]:
+			result, err = MinorsToResponse(m, req)
+		case *matrix.Matrix[field.GF]:
+			result, err = MinorsToResponse(m, req)
+		default:
+			http.Error(w, "неподдерживаемый тип матрицы", http.StatusBadRequest)
+			return
+		}
+
+		if err != nil {
+			http.Error(w, fmt.Sprintf("ошибка вычисления миноров: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(result)
+	}
+}
+
+func (s *Server) handleMatrixAnalyze() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req AnalyzeRequest
+
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		// Парсим матрицу
+		m, err := ParseMatrix(req.MatrixRequest)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("ошибка парсинга матрицы: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		result, err := AnalyzeToResponse(m, req.Tolerance)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("ошибка анализа матрицы: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(result)
+	}
+}
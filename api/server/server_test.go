@@ -3,8 +3,10 @@ package server
 import (
 	"bytes"
 	"encoding/json"
+	"math"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -245,3 +247,965 @@ func TestServer_InvalidJSON(t *testing.T) {
 
 	assert.Equal(t, http.StatusBadRequest, w.Code)
 }
+
+func TestServer_HandleMatrixRREF(t *testing.T) {
+	s := NewServer()
+
+	request := RREFRequest{
+		MatrixRequest: MatrixRequest{
+			Type: "rational",
+			Rows: 2,
+			Cols: 3,
+			Data: [][]string{
+				{"1", "2", "3"},
+				{"2", "4", "7"},
+			},
+		},
+		Transform: true,
+	}
+
+	body, _ := json.Marshal(request)
+	req := httptest.NewRequest("POST", "/api/v1/matrix/rref", bytes.NewReader(body))
+	w := httptest.NewRecorder()
+
+	s.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response RREFResponse
+	err := json.NewDecoder(w.Body).Decode(&response)
+	assert.NoError(t, err)
+	assert.Equal(t, [][]string{{"1", "2", "0"}, {"0", "0", "1"}}, response.Result)
+	assert.Equal(t, []int{0, 2}, response.Pivots)
+	assert.Equal(t, 2, response.Rank)
+	assert.Equal(t, []int{0, 1}, response.Permutation)
+	assert.Len(t, response.Transform, 2)
+}
+
+func TestServer_HandleMatrixNullSpace(t *testing.T) {
+	s := NewServer()
+
+	tests := []struct {
+		name      string
+		left      bool
+		dimension int
+	}{
+		{name: "right null space", left: false, dimension: 2},
+		{name: "left null space", left: true, dimension: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := NullSpaceRequest{
+				MatrixRequest: MatrixRequest{
+					Type: "rational",
+					Rows: 2,
+					Cols: 3,
+					Data: [][]string{
+						{"1", "2", "3"},
+						{"2", "4", "6"},
+					},
+				},
+				Left: tt.left,
+			}
+
+			body, _ := json.Marshal(request)
+			req := httptest.NewRequest("POST", "/api/v1/matrix/nullspace", bytes.NewReader(body))
+			w := httptest.NewRecorder()
+
+			s.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusOK, w.Code)
+
+			var response NullSpaceResponse
+			err := json.NewDecoder(w.Body).Decode(&response)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.dimension, response.Dimension)
+			assert.Len(t, response.Basis, tt.dimension)
+		})
+	}
+}
+
+func TestServer_HandleMatrixSolve(t *testing.T) {
+	s := NewServer()
+
+	tests := []struct {
+		name       string
+		request    SystemRequest
+		wantStatus int
+		wantKind   string
+	}{
+		{
+			name: "unique solution",
+			request: SystemRequest{
+				Matrix: MatrixRequest{Type: "float64", Rows: 2, Cols: 2, Data: [][]string{{"2", "1"}, {"1", "3"}}},
+				Vector: []string{"3", "4"},
+			},
+			wantStatus: http.StatusOK,
+			wantKind:   "unique",
+		},
+		{
+			name: "infinite solutions for rectangular system",
+			request: SystemRequest{
+				Matrix: MatrixRequest{Type: "rational", Rows: 1, Cols: 3, Data: [][]string{{"1", "1", "1"}}},
+				Vector: []string{"1"},
+			},
+			wantStatus: http.StatusOK,
+			wantKind:   "infinite",
+		},
+		{
+			name: "inconsistent system",
+			request: SystemRequest{
+				Matrix: MatrixRequest{Type: "gf", Rows: 2, Cols: 2, Data: [][]string{{"1", "1"}, {"1", "1"}}, ModP: 5},
+				Vector: []string{"1", "2"},
+			},
+			wantStatus: http.StatusOK,
+			wantKind:   "inconsistent",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, _ := json.Marshal(tt.request)
+			req := httptest.NewRequest("POST", "/api/v1/matrix/solve", bytes.NewReader(body))
+			w := httptest.NewRecorder()
+
+			s.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+
+			var response SolveResponse
+			err := json.NewDecoder(w.Body).Decode(&response)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantKind, response.Kind)
+			if tt.wantKind == "infinite" {
+				assert.Equal(t, []string{"x2", "x3"}, response.FreeVariables)
+				assert.Equal(t, []string{"x1 = 1 - x2 - x3", "x2 = x2", "x3 = x3"}, response.Parametric)
+			}
+		})
+	}
+}
+
+func TestServer_HandleMatrixQR(t *testing.T) {
+	s := NewServer()
+
+	tests := []struct {
+		name       string
+		request    QRRequest
+		wantStatus int
+		wantRank   int
+	}{
+		{
+			name: "float64 with pivoting",
+			request: QRRequest{
+				MatrixRequest: MatrixRequest{Type: "float64", Rows: 3, Cols: 2, Data: [][]string{{"1", "2"}, {"3", "4"}, {"5", "6"}}},
+				Pivoting:      true,
+			},
+			wantStatus: http.StatusOK,
+			wantRank:   2,
+		},
+		{
+			name: "exact rational",
+			request: QRRequest{
+				MatrixRequest: MatrixRequest{Type: "rational", Rows: 2, Cols: 2, Data: [][]string{{"1", "2"}, {"2", "4"}}},
+			},
+			wantStatus: http.StatusOK,
+			wantRank:   1,
+		},
+		{
+			name: "unsupported gf",
+			request: QRRequest{
+				MatrixRequest: MatrixRequest{Type: "gf", Rows: 1, Cols: 1, Data: [][]string{{"1"}}, ModP: 5},
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, _ := json.Marshal(tt.request)
+			req := httptest.NewRequest("POST", "/api/v1/matrix/qr", bytes.NewReader(body))
+			w := httptest.NewRecorder()
+
+			s.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			if tt.wantStatus == http.StatusOK {
+				var response QRResponse
+				err := json.NewDecoder(w.Body).Decode(&response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantRank, response.Rank)
+				assert.NotEmpty(t, response.Q)
+				assert.NotEmpty(t, response.R)
+			}
+		})
+	}
+}
+
+func TestServer_HandleMatrixLeastSquares(t *testing.T) {
+	s := NewServer()
+
+	request := SystemRequest{
+		Matrix: MatrixRequest{Type: "rational", Rows: 3, Cols: 2, Data: [][]string{{"1", "0"}, {"1", "1"}, {"1", "2"}}},
+		Vector: []string{"0", "1", "1"},
+	}
+
+	body, _ := json.Marshal(request)
+	req := httptest.NewRequest("POST", "/api/v1/matrix/least-squares", bytes.NewReader(body))
+	w := httptest.NewRecorder()
+
+	s.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response LeastSquaresResponse
+	err := json.NewDecoder(w.Body).Decode(&response)
+	assert.NoError(t, err)
+	assert.Equal(t, [][]string{{"1/6", "1/2"}}, response.Result)
+	assert.Equal(t, []string{"-1/6", "1/3", "-1/6"}, response.Residual)
+	assert.Equal(t, 2, response.Rank)
+}
+
+func TestServer_HandleMatrixEigen(t *testing.T) {
+	s := NewServer()
+
+	tests := []struct {
+		name          string
+		request       MatrixRequest
+		wantStatus    int
+		wantValues    []string
+		wantHermitian bool
+	}{
+		{
+			name:          "symmetric float64",
+			request:       MatrixRequest{Type: "float64", Rows: 2, Cols: 2, Data: [][]string{{"2", "1"}, {"1", "2"}}},
+			wantStatus:    http.StatusOK,
+			wantValues:    []string{"1", "3"},
+			wantHermitian: true,
+		},
+		{
+			name:       "rotation has complex eigenvalues",
+			request:    MatrixRequest{Type: "float64", Rows: 2, Cols: 2, Data: [][]string{{"0", "-1"}, {"1", "0"}}},
+			wantStatus: http.StatusOK,
+			wantValues: []string{"-1i", "1i"},
+		},
+		{
+			name:       "unsupported gf",
+			request:    MatrixRequest{Type: "gf", Rows: 1, Cols: 1, Data: [][]string{{"1"}}, ModP: 5},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "not square",
+			request:    MatrixRequest{Type: "float64", Rows: 1, Cols: 2, Data: [][]string{{"1", "2"}}},
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, _ := json.Marshal(tt.request)
+			req := httptest.NewRequest("POST", "/api/v1/matrix/eigen", bytes.NewReader(body))
+			w := httptest.NewRecorder()
+
+			s.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			if tt.wantStatus == http.StatusOK {
+				var response EigenResponse
+				err := json.NewDecoder(w.Body).Decode(&response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantValues, response.Values)
+				assert.Equal(t, tt.wantHermitian, response.Hermitian)
+				assert.Len(t, response.Vectors, len(tt.wantValues))
+			}
+		})
+	}
+}
+
+func TestServer_HandleMatrixCharPoly(t *testing.T) {
+	s := NewServer()
+
+	tests := []struct {
+		name        string
+		request     MatrixRequest
+		wantStatus  int
+		wantCharPol string
+		wantMinimal string
+	}{
+		{
+			name:        "rational",
+			request:     MatrixRequest{Type: "rational", Rows: 2, Cols: 2, Data: [][]string{{"1/2", "1"}, {"0", "1/2"}}},
+			wantStatus:  http.StatusOK,
+			wantCharPol: "x^2 - x + 1/4",
+			wantMinimal: "x^2 - x + 1/4",
+		},
+		{
+			name:        "gf scalar matrix",
+			request:     MatrixRequest{Type: "gf", Rows: 2, Cols: 2, Data: [][]string{{"3", "0"}, {"0", "3"}}, ModP: 5},
+			wantStatus:  http.StatusOK,
+			wantCharPol: "x^2 + 4x + 4 (mod 5)",
+			wantMinimal: "x + 2 (mod 5)",
+		},
+		{
+			name:       "not square",
+			request:    MatrixRequest{Type: "float64", Rows: 1, Cols: 2, Data: [][]string{{"1", "2"}}},
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, _ := json.Marshal(tt.request)
+			req := httptest.NewRequest("POST", "/api/v1/matrix/charpoly", bytes.NewReader(body))
+			w := httptest.NewRecorder()
+
+			s.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			if tt.wantStatus == http.StatusOK {
+				var response CharPolyResponse
+				err := json.NewDecoder(w.Body).Decode(&response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantCharPol, response.Characteristic)
+				assert.Equal(t, tt.wantMinimal, response.Minimal)
+				assert.True(t, response.CayleyHamilton)
+			}
+		})
+	}
+}
+
+func TestServer_HandleMatrixCanonical(t *testing.T) {
+	s := NewServer()
+
+	tests := []struct {
+		name       string
+		request    CanonicalFormRequest
+		wantStatus int
+		wantResult [][]string
+		wantBlocks []JordanBlockResponse
+		wantFactor []string
+	}{
+		{
+			name: "jordan",
+			request: CanonicalFormRequest{
+				MatrixRequest: MatrixRequest{Type: "rational", Rows: 2, Cols: 2, Data: [][]string{{"2", "1"}, {"-1", "0"}}},
+				Form:          "jordan",
+			},
+			wantStatus: http.StatusOK,
+			wantResult: [][]string{{"1", "1"}, {"0", "1"}},
+			wantBlocks: []JordanBlockResponse{{Eigenvalue: "1", Size: 2}},
+		},
+		{
+			name: "frobenius",
+			request: CanonicalFormRequest{
+				MatrixRequest: MatrixRequest{Type: "rational", Rows: 2, Cols: 2, Data: [][]string{{"3", "0"}, {"0", "3"}}},
+				Form:          "frobenius",
+			},
+			wantStatus: http.StatusOK,
+			wantResult: [][]string{{"3", "0"}, {"0", "3"}},
+			wantFactor: []string{"x - 3", "x - 3"},
+		},
+		{
+			name: "jordan not split",
+			request: CanonicalFormRequest{
+				MatrixRequest: MatrixRequest{Type: "rational", Rows: 2, Cols: 2, Data: [][]string{{"0", "-1"}, {"1", "0"}}},
+				Form:          "jordan",
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "unknown form",
+			request: CanonicalFormRequest{
+				MatrixRequest: MatrixRequest{Type: "rational", Rows: 1, Cols: 1, Data: [][]string{{"1"}}},
+				Form:          "schur",
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, _ := json.Marshal(tt.request)
+			req := httptest.NewRequest("POST", "/api/v1/matrix/canonical", bytes.NewReader(body))
+			w := httptest.NewRecorder()
+
+			s.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			if tt.wantStatus == http.StatusOK {
+				var response CanonicalFormResponse
+				err := json.NewDecoder(w.Body).Decode(&response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantResult, response.Result)
+				assert.Equal(t, tt.wantBlocks, response.Blocks)
+				assert.Equal(t, tt.wantFactor, response.InvariantFactors)
+				assert.Len(t, response.Transform, tt.request.Rows)
+			}
+		})
+	}
+}
+
+func TestServer_HandleMatrixSimilar(t *testing.T) {
+	s := NewServer()
+	jordanBlock := MatrixRequest{Type: "rational", Rows: 2, Cols: 2, Data: [][]string{{"1", "1"}, {"0", "1"}}}
+
+	tests := []struct {
+		name        string
+		matrix2     MatrixRequest
+		wantSimilar bool
+	}{
+		{
+			name:        "similar",
+			matrix2:     MatrixRequest{Type: "rational", Rows: 2, Cols: 2, Data: [][]string{{"2", "1"}, {"-1", "0"}}},
+			wantSimilar: true,
+		},
+		{
+			name:        "not similar",
+			matrix2:     MatrixRequest{Type: "rational", Rows: 2, Cols: 2, Data: [][]string{{"1", "0"}, {"0", "1"}}},
+			wantSimilar: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, _ := json.Marshal(map[string]interface{}{
+				"matrix1": jordanBlock,
+				"matrix2": tt.matrix2,
+			})
+			req := httptest.NewRequest("POST", "/api/v1/matrix/similar", bytes.NewReader(body))
+			w := httptest.NewRecorder()
+
+			s.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusOK, w.Code)
+			var response SimilarityResponse
+			err := json.NewDecoder(w.Body).Decode(&response)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantSimilar, response.Similar)
+			if tt.wantSimilar {
+				assert.Len(t, response.Transform, 2)
+			} else {
+				assert.Nil(t, response.Transform)
+			}
+		})
+	}
+}
+
+func TestServer_HandleMatrixNormalForm(t *testing.T) {
+	s := NewServer()
+	mat := MatrixRequest{Type: "rational", Rows: 3, Cols: 3, Data: [][]string{{"2", "4", "4"}, {"-6", "6", "12"}, {"10", "-4", "-16"}}}
+
+	tests := []struct {
+		name       string
+		request    NormalFormRequest
+		wantStatus int
+		wantResult [][]string
+	}{
+		{
+			name:       "smith",
+			request:    NormalFormRequest{MatrixRequest: mat, Form: "smith"},
+			wantStatus: http.StatusOK,
+			wantResult: [][]string{{"2", "0", "0"}, {"0", "6", "0"}, {"0", "0", "12"}},
+		},
+		{
+			name:       "hermite",
+			request:    NormalFormRequest{MatrixRequest: mat, Form: "hermite", Reduce: true},
+			wantStatus: http.StatusOK,
+			wantResult: [][]string{{"2", "4", "4"}, {"0", "6", "0"}, {"0", "0", "12"}},
+		},
+		{
+			name: "not integer",
+			request: NormalFormRequest{
+				MatrixRequest: MatrixRequest{Type: "rational", Rows: 1, Cols: 1, Data: [][]string{{"1/2"}}},
+				Form:          "smith",
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "wrong type",
+			request: NormalFormRequest{
+				MatrixRequest: MatrixRequest{Type: "float64", Rows: 1, Cols: 1, Data: [][]string{{"1"}}},
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, _ := json.Marshal(tt.request)
+			req := httptest.NewRequest("POST", "/api/v1/matrix/normal-form", bytes.NewReader(body))
+			w := httptest.NewRecorder()
+
+			s.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			if tt.wantStatus == http.StatusOK {
+				var response NormalFormResponse
+				err := json.NewDecoder(w.Body).Decode(&response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantResult, response.Result)
+				assert.Equal(t, 3, response.Rank)
+			}
+		})
+	}
+}
+
+func TestServer_HandleMatrixSolveInteger(t *testing.T) {
+	s := NewServer()
+
+	tests := []struct {
+		name         string
+		request      SystemRequest
+		wantSolvable bool
+		wantKernel   int
+	}{
+		{
+			name: "solvable",
+			request: SystemRequest{
+				Matrix: MatrixRequest{Type: "rational", Rows: 1, Cols: 2, Data: [][]string{{"6", "10"}}},
+				Vector: []string{"4"},
+			},
+			wantSolvable: true,
+			wantKernel:   1,
+		},
+		{
+			name: "no integer solution",
+			request: SystemRequest{
+				Matrix: MatrixRequest{Type: "rational", Rows: 1, Cols: 2, Data: [][]string{{"6", "10"}}},
+				Vector: []string{"3"},
+			},
+			wantSolvable: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, _ := json.Marshal(tt.request)
+			req := httptest.NewRequest("POST", "/api/v1/matrix/solve-integer", bytes.NewReader(body))
+			w := httptest.NewRecorder()
+
+			s.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusOK, w.Code)
+			var response IntegerSolveResponse
+			err := json.NewDecoder(w.Body).Decode(&response)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantSolvable, response.Solvable)
+			assert.Len(t, response.Kernel, tt.wantKernel)
+			if tt.wantSolvable {
+				assert.Len(t, response.Particular, 2)
+			}
+		})
+	}
+}
+
+func TestServer_HandleMatrixLLL(t *testing.T) {
+	s := NewServer()
+	data := [][]string{{"1", "1", "1"}, {"-1", "0", "2"}, {"3", "5", "6"}}
+
+	tests := []struct {
+		name       string
+		request    LLLRequest
+		wantStatus int
+		wantBasis  [][]string
+	}{
+		{
+			name:       "rational",
+			request:    LLLRequest{MatrixRequest: MatrixRequest{Type: "rational", Rows: 3, Cols: 3, Data: data}, Delta: "3/4"},
+			wantStatus: http.StatusOK,
+			wantBasis:  [][]string{{"0", "1", "0"}, {"1", "0", "1"}, {"-1", "0", "2"}},
+		},
+		{
+			name:       "float64",
+			request:    LLLRequest{MatrixRequest: MatrixRequest{Type: "float64", Rows: 3, Cols: 3, Data: data}},
+			wantStatus: http.StatusOK,
+			wantBasis:  [][]string{{"0", "1", "0"}, {"1", "0", "1"}, {"-1", "0", "2"}},
+		},
+		{
+			name:       "invalid delta",
+			request:    LLLRequest{MatrixRequest: MatrixRequest{Type: "rational", Rows: 3, Cols: 3, Data: data}, Delta: "2"},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "unsupported type",
+			request:    LLLRequest{MatrixRequest: MatrixRequest{Type: "gf", Rows: 1, Cols: 1, Data: [][]string{{"1"}}, ModP: 5}},
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, _ := json.Marshal(tt.request)
+			req := httptest.NewRequest("POST", "/api/v1/matrix/lll", bytes.NewReader(body))
+			w := httptest.NewRecorder()
+
+			s.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			if tt.wantStatus == http.StatusOK {
+				var response LLLResponse
+				err := json.NewDecoder(w.Body).Decode(&response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantBasis, response.Basis)
+				assert.Equal(t, tt.wantBasis[0], response.Shortest.Vector)
+				assert.InDelta(t, 1.0, response.Shortest.Length, 1e-9)
+			}
+		})
+	}
+}
+
+func TestServer_HandleMatrixPower(t *testing.T) {
+	s := NewServer()
+	fib := [][]string{{"1", "1"}, {"1", "0"}}
+
+	tests := []struct {
+		name       string
+		request    PowerRequest
+		wantStatus int
+		want       [][]string
+	}{
+		{
+			name:       "rational",
+			request:    PowerRequest{MatrixRequest: MatrixRequest{Type: "rational", Rows: 2, Cols: 2, Data: fib}, Exponent: 10},
+			wantStatus: http.StatusOK,
+			want:       [][]string{{"89", "55"}, {"55", "34"}},
+		},
+		{
+			name:       "negative exponent",
+			request:    PowerRequest{MatrixRequest: MatrixRequest{Type: "rational", Rows: 2, Cols: 2, Data: fib}, Exponent: -2},
+			wantStatus: http.StatusOK,
+			want:       [][]string{{"1", "-1"}, {"-1", "2"}},
+		},
+		{
+			name:       "gf",
+			request:    PowerRequest{MatrixRequest: MatrixRequest{Type: "gf", Rows: 2, Cols: 2, Data: fib, ModP: 7}, Exponent: 16},
+			wantStatus: http.StatusOK,
+			want:       [][]string{{"1 (mod 7)", "0 (mod 7)"}, {"0 (mod 7)", "1 (mod 7)"}},
+		},
+		{
+			name:       "singular inverse",
+			request:    PowerRequest{MatrixRequest: MatrixRequest{Type: "rational", Rows: 2, Cols: 2, Data: [][]string{{"1", "2"}, {"2", "4"}}}, Exponent: -1},
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, _ := json.Marshal(tt.request)
+			req := httptest.NewRequest("POST", "/api/v1/matrix/power", bytes.NewReader(body))
+			w := httptest.NewRecorder()
+
+			s.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			if tt.wantStatus == http.StatusOK {
+				var response MatrixResponse
+				err := json.NewDecoder(w.Body).Decode(&response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, response.Result)
+			}
+		})
+	}
+}
+
+func TestServer_HandleMatrixFunction(t *testing.T) {
+	s := NewServer()
+	rotation := [][]string{{"0", "-1"}, {"1", "0"}}
+
+	tests := []struct {
+		name       string
+		request    FunctionRequest
+		wantStatus int
+		want       [][]float64
+	}{
+		{
+			name:       "exp",
+			request:    FunctionRequest{MatrixRequest: MatrixRequest{Type: "float64", Rows: 2, Cols: 2, Data: rotation}, Function: "exp"},
+			wantStatus: http.StatusOK,
+			want:       [][]float64{{math.Cos(1), -math.Sin(1)}, {math.Sin(1), math.Cos(1)}},
+		},
+		{
+			name:       "sqrt",
+			request:    FunctionRequest{MatrixRequest: MatrixRequest{Type: "float64", Rows: 2, Cols: 2, Data: [][]string{{"4", "1"}, {"0", "4"}}}, Function: "sqrt"},
+			wantStatus: http.StatusOK,
+			want:       [][]float64{{2, 0.25}, {0, 2}},
+		},
+		{
+			name:       "log",
+			request:    FunctionRequest{MatrixRequest: MatrixRequest{Type: "float64", Rows: 2, Cols: 2, Data: [][]string{{"1", "1"}, {"0", "1"}}}, Function: "log"},
+			wantStatus: http.StatusOK,
+			want:       [][]float64{{0, 1}, {0, 0}},
+		},
+		{
+			name:       "cos",
+			request:    FunctionRequest{MatrixRequest: MatrixRequest{Type: "float64", Rows: 2, Cols: 2, Data: [][]string{{"0", "0"}, {"0", "3.141592653589793"}}}, Function: "cos"},
+			wantStatus: http.StatusOK,
+			want:       [][]float64{{1, 0}, {0, -1}},
+		},
+		{
+			name:       "unknown function",
+			request:    FunctionRequest{MatrixRequest: MatrixRequest{Type: "float64", Rows: 2, Cols: 2, Data: rotation}, Function: "gamma"},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "unsupported type",
+			request:    FunctionRequest{MatrixRequest: MatrixRequest{Type: "rational", Rows: 2, Cols: 2, Data: rotation}, Function: "exp"},
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, _ := json.Marshal(tt.request)
+			req := httptest.NewRequest("POST", "/api/v1/matrix/function", bytes.NewReader(body))
+			w := httptest.NewRecorder()
+
+			s.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			if tt.wantStatus == http.StatusOK {
+				var response MatrixResponse
+				err := json.NewDecoder(w.Body).Decode(&response)
+				assert.NoError(t, err)
+				for i, row := range tt.want {
+					for j, v := range row {
+						got, err := strconv.ParseFloat(response.Result[i][j], 64)
+						assert.NoError(t, err)
+						assert.InDelta(t, v, got, 1e-9)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestServer_SolveDiagnostics(t *testing.T) {
+	s := NewServer()
+
+	tests := []struct {
+		name       string
+		request    SystemRequest
+		wantStatus int
+		wantSteps  bool
+	}{
+		{
+			name: "float64 diagnostics",
+			request: SystemRequest{
+				Matrix:      MatrixRequest{Type: "float64", Rows: 2, Cols: 2, Data: [][]string{{"4", "1"}, {"2", "3"}}},
+				Vector:      []string{"5", "5"},
+				Diagnostics: true,
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name: "float64 refinement",
+			request: SystemRequest{
+				Matrix: MatrixRequest{Type: "float64", Rows: 2, Cols: 2, Data: [][]string{{"4", "1"}, {"2", "3"}}},
+				Vector: []string{"5", "5"},
+				Refine: true,
+			},
+			wantStatus: http.StatusOK,
+			wantSteps:  true,
+		},
+		{
+			name: "rational diagnostics",
+			request: SystemRequest{
+				Matrix:      MatrixRequest{Type: "rational", Rows: 1, Cols: 1, Data: [][]string{{"1"}}},
+				Vector:      []string{"1"},
+				Diagnostics: true,
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, _ := json.Marshal(tt.request)
+			req := httptest.NewRequest("POST", "/api/v1/matrix/solve", bytes.NewReader(body))
+			w := httptest.NewRecorder()
+
+			s.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			var response SolveResponse
+			assert.NoError(t, json.NewDecoder(w.Body).Decode(&response))
+			assert.Equal(t, [][]string{{"1", "1"}}, response.Result)
+			if assert.NotNil(t, response.Diagnostics) {
+				assert.Equal(t, 6.0, response.Diagnostics.NormOne)
+				assert.Equal(t, 5.0, response.Diagnostics.NormInf)
+				assert.InDelta(t, 3, response.Diagnostics.Condition, 1e-9)
+				assert.Less(t, response.Diagnostics.BackwardError, 1e-15)
+				assert.Equal(t, tt.wantSteps, response.Diagnostics.RefinementSteps > 0)
+			}
+		})
+	}
+}
+
+func TestServer_InverseDiagnostics(t *testing.T) {
+	s := NewServer()
+	body, _ := json.Marshal(InverseRequest{
+		MatrixRequest: MatrixRequest{Type: "float64", Rows: 2, Cols: 2, Data: [][]string{{"1", "2"}, {"2", "4.000001"}}},
+		Diagnostics:   true,
+	})
+	req := httptest.NewRequest("POST", "/api/v1/matrix/inverse", bytes.NewReader(body))
+	w := httptest.NewRecorder()
+	s.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var response InverseResponse
+	assert.NoError(t, json.NewDecoder(w.Body).Decode(&response))
+	assert.Len(t, response.Result, 2)
+	if assert.NotNil(t, response.Diagnostics) {
+		assert.Greater(t, response.Diagnostics.Condition, 1e6)
+		assert.Less(t, response.Diagnostics.RelativeResidual, 1e-12)
+	}
+}
+
+func TestServer_HandleMatrixMinors(t *testing.T) {
+	s := NewServer()
+	singular := MatrixRequest{Type: "gf", Rows: 3, Cols: 3, Data: [][]string{{"1", "2", "3"}, {"2", "4", "6"}, {"1", "0", "6"}}, ModP: 7}
+	rational := MatrixRequest{Type: "rational", Rows: 2, Cols: 3, Data: [][]string{{"1", "2", "3"}, {"4", "5", "6"}}}
+
+	tests := []struct {
+		name       string
+		request    MinorsRequest
+		wantStatus int
+		check      func(t *testing.T, resp MinorsResponse)
+	}{
+		{
+			name:       "adjugate of singular matrix",
+			request:    MinorsRequest{MatrixRequest: singular, Kind: "adjugate"},
+			wantStatus: http.StatusOK,
+			check: func(t *testing.T, resp MinorsResponse) {
+				assert.Equal(t, [][]string{
+					{"3 (mod 7)", "2 (mod 7)", "0 (mod 7)"},
+					{"1 (mod 7)", "3 (mod 7)", "0 (mod 7)"},
+					{"3 (mod 7)", "2 (mod 7)", "0 (mod 7)"},
+				}, resp.Result)
+			},
+		},
+		{
+			name:       "single minor",
+			request:    MinorsRequest{MatrixRequest: rational, Kind: "minor", Rows: []int{0, 1}, Cols: []int{0, 2}},
+			wantStatus: http.StatusOK,
+			check: func(t *testing.T, resp MinorsResponse) {
+				assert.Equal(t, "-6", resp.Value)
+			},
+		},
+		{
+			name:       "all minors",
+			request:    MinorsRequest{MatrixRequest: rational, Kind: "all", Order: 2},
+			wantStatus: http.StatusOK,
+			check: func(t *testing.T, resp MinorsResponse) {
+				assert.Equal(t, [][]string{{"-3", "-6", "-3"}}, resp.Result)
+				assert.Equal(t, [][]int{{0, 1}, {0, 2}, {1, 2}}, resp.ColSets)
+			},
+		},
+		{
+			name:       "leading principal minors",
+			request:    MinorsRequest{MatrixRequest: MatrixRequest{Type: "rational", Rows: 2, Cols: 2, Data: [][]string{{"2", "1"}, {"1", "2"}}}, Kind: "leading"},
+			wantStatus: http.StatusOK,
+			check: func(t *testing.T, resp MinorsResponse) {
+				assert.Equal(t, []string{"2", "3"}, resp.Values)
+				assert.Equal(t, [][]int{{0}, {0, 1}}, resp.Indices)
+			},
+		},
+		{
+			name:       "unknown kind",
+			request:    MinorsRequest{MatrixRequest: rational, Kind: "permanent"},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "non-square cofactor",
+			request:    MinorsRequest{MatrixRequest: rational, Kind: "cofactor"},
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, _ := json.Marshal(tt.request)
+			req := httptest.NewRequest("POST", "/api/v1/matrix/minors", bytes.NewReader(body))
+			w := httptest.NewRecorder()
+
+			s.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			if tt.check != nil {
+				var response MinorsResponse
+				assert.NoError(t, json.NewDecoder(w.Body).Decode(&response))
+				tt.check(t, response)
+			}
+		})
+	}
+}
+
+func TestServer_HandleMatrixAnalyze(t *testing.T) {
+	s := NewServer()
+
+	tests := []struct {
+		name       string
+		request    AnalyzeRequest
+		wantStatus int
+		check      func(t *testing.T, resp AnalyzeResponse)
+	}{
+		{
+			name:       "rational nilpotent",
+			request:    AnalyzeRequest{MatrixRequest: MatrixRequest{Type: "rational", Rows: 3, Cols: 3, Data: [][]string{{"0", "1", "2"}, {"0", "0", "3"}, {"0", "0", "0"}}}},
+			wantStatus: http.StatusOK,
+			check: func(t *testing.T, resp AnalyzeResponse) {
+				assert.True(t, resp.Exact)
+				assert.True(t, resp.Nilpotent)
+				assert.Equal(t, 3, resp.NilpotencyIndex)
+				assert.True(t, resp.UpperTriangular)
+				assert.False(t, *resp.Diagonalizable)
+				assert.False(t, *resp.PositiveDefinite)
+			},
+		},
+		{
+			name:       "float64 symmetric with tolerance",
+			request:    AnalyzeRequest{MatrixRequest: MatrixRequest{Type: "float64", Rows: 2, Cols: 2, Data: [][]string{{"2", "1.0001"}, {"1", "2"}}}, Tolerance: 1e-3},
+			wantStatus: http.StatusOK,
+			check: func(t *testing.T, resp AnalyzeResponse) {
+				assert.False(t, resp.Exact)
+				assert.Equal(t, 1e-3, resp.Tolerance)
+				assert.True(t, resp.Symmetric)
+				assert.True(t, *resp.PositiveDefinite)
+			},
+		},
+		{
+			name:       "gf has no positive definiteness",
+			request:    AnalyzeRequest{MatrixRequest: MatrixRequest{Type: "gf", Rows: 2, Cols: 2, Data: [][]string{{"1", "1"}, {"0", "1"}}, ModP: 2}},
+			wantStatus: http.StatusOK,
+			check: func(t *testing.T, resp AnalyzeResponse) {
+				assert.True(t, resp.Involutory)
+				assert.Nil(t, resp.PositiveDefinite)
+			},
+		},
+		{
+			name:       "negative tolerance",
+			request:    AnalyzeRequest{MatrixRequest: MatrixRequest{Type: "float64", Rows: 1, Cols: 1, Data: [][]string{{"1"}}}, Tolerance: -1},
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, _ := json.Marshal(tt.request)
+			req := httptest.NewRequest("POST", "/api/v1/matrix/analyze", bytes.NewReader(body))
+			w := httptest.NewRecorder()
+
+			s.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			if tt.check != nil {
+				var response AnalyzeResponse
+				assert.NoError(t, json.NewDecoder(w.Body).Decode(&response))
+				tt.check(t, response)
+			}
+		})
+	}
+}
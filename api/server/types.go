@@ -11,8 +11,10 @@ type MatrixRequest struct {
 
 // SystemRequest представляет запрос для решения системы уравнений
 type SystemRequest struct {
-	Matrix MatrixRequest `json:"matrix"` // Матрица системы
-	Vector []string      `json:"vector"` // Вектор правой части
+	Matrix      MatrixRequest `json:"matrix"`                // Матрица системы
+	Vector      []string      `json:"vector"`                // Вектор правой части
+	Diagnostics bool          `json:"diagnostics,omitempty"` // Вернуть оценки точности решения (float64 и complex)
+	Refine      bool          `json:"refine,omitempty"`      // Уточнить решение итерационно (float64)
 }
 
 // MatrixResponse представляет ответ сервера
@@ -21,3 +23,251 @@ type MatrixResponse struct {
 	Value  string     `json:"value,omitempty"`  // Для скалярных результатов
 	Error  string     `json:"error,omitempty"`  // Сообщение об ошибке
 }
+
+// RREFRequest представляет запрос на приведение матрицы к ступенчатому виду
+type RREFRequest struct {
+	MatrixRequest
+	Transform bool `json:"transform,omitempty"` // Вернуть матрицу преобразования E
+}
+
+// RREFResponse представляет ответ с приведенным ступенчатым видом
+type RREFResponse struct {
+	Result      [][]string `json:"result"`              // Приведенный ступенчатый вид
+	Pivots      []int      `json:"pivots"`              // Столбцы ведущих элементов
+	Rank        int        `json:"rank"`                // Ранг матрицы
+	Permutation []int      `json:"permutation"`         // Перестановка строк
+	Transform   [][]string `json:"transform,omitempty"` // Матрица E, такая что E*A = RREF
+}
+
+// NullSpaceRequest представляет запрос на вычисление базиса ядра
+type NullSpaceRequest struct {
+	MatrixRequest
+	Left bool `json:"left,omitempty"` // Вычислить левое ядро (y^T*A = 0)
+}
+
+// NullSpaceResponse представляет ответ с базисом ядра
+type NullSpaceResponse struct {
+	Basis     [][]string `json:"basis"`     // Базисные векторы, по одному на строку
+	Dimension int        `json:"dimension"` // Размерность ядра
+}
+
+// SolveResponse представляет ответ с общим решением системы уравнений
+type SolveResponse struct {
+	Result        [][]string           `json:"result,omitempty"`        // Частное (или единственное) решение как матрица 1xn
+	Kind          string               `json:"kind"`                    // "inconsistent", "unique" или "infinite"
+	Rank          int                  `json:"rank"`                    // Ранг матрицы системы
+	Kernel        [][]string           `json:"kernel,omitempty"`        // Базис ядра, по вектору на строку
+	FreeVariables []string             `json:"freeVariables,omitempty"` // Имена свободных переменных (параметров)
+	Parametric    []string             `json:"parametric,omitempty"`    // Решение в параметрическом виде
+	Diagnostics   *DiagnosticsResponse `json:"diagnostics,omitempty"`   // Оценки точности единственного решения
+	Error         string               `json:"error,omitempty"`         // Сообщение об ошибке
+}
+
+// DiagnosticsResponse содержит нормы матрицы и оценки точности результата.
+// Для вырожденной матрицы поле singular равно true, а condition не заполняется.
+type DiagnosticsResponse struct {
+	NormOne           float64 `json:"normOne"`                     // ||A||₁
+	NormInf           float64 `json:"normInf"`                     // ||A||∞
+	NormFrobenius     float64 `json:"normFrobenius"`               // ||A||_F
+	NormMax           float64 `json:"normMax"`                     // max |a_ij|
+	Condition         float64 `json:"condition,omitempty"`         // Оценка κ₁(A)
+	Singular          bool    `json:"singular,omitempty"`          // Матрица численно вырождена
+	Residual          float64 `json:"residual"`                    // Норма невязки
+	RelativeResidual  float64 `json:"relativeResidual"`            // Относительная невязка
+	BackwardError     float64 `json:"backwardError,omitempty"`     // Нормированная обратная ошибка (системы)
+	ForwardErrorBound float64 `json:"forwardErrorBound,omitempty"` // Оценка относительной ошибки решения
+	RefinementSteps   int     `json:"refinementSteps,omitempty"`   // Шаги итерационного уточнения
+}
+
+// InverseRequest представляет запрос на обращение матрицы
+type InverseRequest struct {
+	MatrixRequest
+	Diagnostics bool `json:"diagnostics,omitempty"` // Вернуть оценки точности (float64 и complex)
+}
+
+// InverseResponse представляет ответ с обратной матрицей
+type InverseResponse struct {
+	Result      [][]string           `json:"result"`                // Обратная матрица
+	Diagnostics *DiagnosticsResponse `json:"diagnostics,omitempty"` // Оценки точности
+}
+
+// QRRequest представляет запрос на QR-разложение
+type QRRequest struct {
+	MatrixRequest
+	Pivoting bool `json:"pivoting,omitempty"` // Выбор ведущего столбца (только float64 и complex)
+}
+
+// QRResponse представляет ответ с QR-разложением
+type QRResponse struct {
+	Q           [][]string `json:"q"`                     // Ортогональный (унитарный) множитель
+	R           [][]string `json:"r"`                     // Верхний треугольный множитель
+	Permutation []int      `json:"permutation,omitempty"` // Перестановка столбцов: A*P = Q*R
+	D           []string   `json:"d,omitempty"`           // Квадраты норм столбцов Q (rational)
+	Rank        int        `json:"rank"`                  // Ранг матрицы
+}
+
+// LeastSquaresResponse представляет ответ с решением задачи наименьших квадратов
+type LeastSquaresResponse struct {
+	Result   [][]string `json:"result"`   // Решение как матрица 1xn
+	Residual []string   `json:"residual"` // Невязка b - A*x
+	Rank     int        `json:"rank"`     // Ранг матрицы
+}
+
+// EigenResponse представляет ответ с собственными значениями и собственными векторами
+type EigenResponse struct {
+	Values    []string   `json:"values"`          // Собственные значения с учетом кратности
+	Vectors   [][]string `json:"vectors"`         // Собственные векторы, по вектору на строку (в порядке values)
+	Hermitian bool       `json:"hermitian"`       // Матрица эрмитова: векторы ортонормированы
+	Error     string     `json:"error,omitempty"` // Сообщение об ошибке
+}
+
+// CharPolyResponse представляет ответ с характеристическим и минимальным многочленами
+type CharPolyResponse struct {
+	Characteristic             string   `json:"characteristic"`             // Запись det(x*I - A)
+	CharacteristicCoefficients []string `json:"characteristicCoefficients"` // Коэффициенты по возрастанию степеней
+	Minimal                    string   `json:"minimal"`                    // Запись минимального многочлена
+	MinimalCoefficients        []string `json:"minimalCoefficients"`        // Коэффициенты по возрастанию степеней
+	CayleyHamilton             bool     `json:"cayleyHamilton"`             // Выполняется ли χ(A) = 0
+}
+
+// CanonicalFormRequest представляет запрос на приведение матрицы к канонической форме
+type CanonicalFormRequest struct {
+	MatrixRequest
+	Form string `json:"form"` // "frobenius" или "jordan"
+}
+
+// JordanBlockResponse описывает жорданову клетку
+type JordanBlockResponse struct {
+	Eigenvalue string `json:"eigenvalue"` // Собственное значение
+	Size       int    `json:"size"`       // Размер клетки
+}
+
+// CanonicalFormResponse представляет ответ с канонической формой P^(-1)*A*P
+type CanonicalFormResponse struct {
+	Form             string                `json:"form"`                       // "frobenius" или "jordan"
+	Result           [][]string            `json:"result"`                     // Каноническая форма
+	Transform        [][]string            `json:"transform"`                  // Матрица перехода P
+	InvariantFactors []string              `json:"invariantFactors,omitempty"` // Инвариантные множители (frobenius)
+	Blocks           []JordanBlockResponse `json:"blocks,omitempty"`           // Жордановы клетки (jordan)
+}
+
+// SimilarityResponse представляет ответ на проверку подобия матриц
+type SimilarityResponse struct {
+	Similar   bool       `json:"similar"`             // Подобны ли матрицы
+	Transform [][]string `json:"transform,omitempty"` // Матрица S, такая что S^(-1)*A*S = B
+}
+
+// NormalFormRequest представляет запрос на нормальную форму целочисленной матрицы
+type NormalFormRequest struct {
+	MatrixRequest
+	Form   string `json:"form"`             // "smith" или "hermite"
+	Reduce bool   `json:"reduce,omitempty"` // Hermite: приводить элементы над ведущими на каждом шаге
+}
+
+// NormalFormResponse представляет ответ с нормальной формой Смита (U*A*V = D) или Эрмита (U*A = H)
+type NormalFormResponse struct {
+	Form       string     `json:"form"`                 // "smith" или "hermite"
+	Result     [][]string `json:"result"`               // Нормальная форма D или H
+	U          [][]string `json:"u"`                    // Унимодулярная матрица строковых преобразований
+	V          [][]string `json:"v,omitempty"`          // Унимодулярная матрица столбцовых преобразований (smith)
+	Invariants []string   `json:"invariants,omitempty"` // Инвариантные множители (smith)
+	Pivots     []int      `json:"pivots,omitempty"`     // Столбцы ведущих элементов (hermite)
+	Rank       int        `json:"rank"`                 // Ранг матрицы
+}
+
+// IntegerSolveResponse представляет ответ с целочисленными решениями системы A*x = b
+type IntegerSolveResponse struct {
+	Solvable   bool       `json:"solvable"`             // Существует ли целочисленное решение
+	Particular []string   `json:"particular,omitempty"` // Частное решение
+	Kernel     [][]string `json:"kernel,omitempty"`     // Базис решетки решений однородной системы
+}
+
+// LLLRequest представляет запрос на LLL-приведение базиса решетки (векторы — строки матрицы)
+type LLLRequest struct {
+	MatrixRequest
+	Delta string `json:"delta,omitempty"` // Параметр δ из (1/4, 1], по умолчанию 3/4
+}
+
+// ShortestVectorResponse содержит оценки длины кратчайшего вектора решетки
+type ShortestVectorResponse struct {
+	Vector            []string `json:"vector"`            // Найденный короткий вектор b_1
+	Length            float64  `json:"length"`            // Длина ||b_1||
+	LowerBound        float64  `json:"lowerBound"`        // Нижняя оценка λ_1
+	ApproxFactor      float64  `json:"approxFactor"`      // Гарантия LLL ||b_1|| ≤ approxFactor * λ_1
+	GaussianHeuristic float64  `json:"gaussianHeuristic"` // Эвристика Гаусса
+	MinkowskiBound    float64  `json:"minkowskiBound"`    // Верхняя оценка Минковского
+}
+
+// LLLResponse представляет ответ с LLL-приведенным базисом
+type LLLResponse struct {
+	Basis       [][]string             `json:"basis"`       // Приведенный базис
+	Transform   [][]string             `json:"transform"`   // Унимодулярная матрица U: basis = U * A
+	GramSchmidt [][]string             `json:"gramSchmidt"` // Ортогонализованные векторы b*_i
+	Mu          [][]string             `json:"mu"`          // Коэффициенты Грама–Шмидта
+	Norms       []string               `json:"norms"`       // Квадраты норм ||b*_i||²
+	Swaps       int                    `json:"swaps"`       // Количество перестановок
+	Shortest    ShortestVectorResponse `json:"shortest"`    // Оценки кратчайшего вектора
+}
+
+// PowerRequest представляет запрос на возведение квадратной матрицы в целую степень
+type PowerRequest struct {
+	MatrixRequest
+	Exponent int64 `json:"exponent"` // Показатель степени; отрицательный — степень обратной матрицы
+}
+
+// FunctionRequest представляет запрос на вычисление функции матрицы (float64 и complex)
+type FunctionRequest struct {
+	MatrixRequest
+	Function string `json:"function"` // "exp", "log", "sqrt", "sin", "cos", "tan", "sinh", "cosh" или "tanh"
+}
+
+// MinorsRequest представляет запрос на вычисление миноров и алгебраических дополнений
+type MinorsRequest struct {
+	MatrixRequest
+	Kind  string `json:"kind"`             // "cofactor", "adjugate", "minor", "principal", "leading" или "all"
+	Row   int    `json:"row,omitempty"`    // minor: удаляемая строка (если rowSet не задан)
+	Col   int    `json:"col,omitempty"`    // minor: удаляемый столбец
+	Rows  []int  `json:"rowSet,omitempty"` // minor: строки подматрицы
+	Cols  []int  `json:"colSet,omitempty"` // minor: столбцы подматрицы
+	Order int    `json:"order,omitempty"`  // principal, all: порядок миноров
+}
+
+// MinorsResponse представляет ответ с минорами
+type MinorsResponse struct {
+	Result  [][]string `json:"result,omitempty"`  // Матрица дополнений, присоединенная или составная матрица
+	Value   string     `json:"value,omitempty"`   // Значение отдельного минора
+	Values  []string   `json:"values,omitempty"`  // Главные миноры
+	Indices [][]int    `json:"indices,omitempty"` // Индексы главных миноров (в порядке values)
+	RowSets [][]int    `json:"rowSets,omitempty"` // all: наборы строк (строки result)
+	ColSets [][]int    `json:"colSets,omitempty"` // all: наборы столбцов (столбцы result)
+}
+
+// AnalyzeRequest представляет запрос на анализ структурных свойств матрицы
+type AnalyzeRequest struct {
+	MatrixRequest
+	Tolerance float64 `json:"tolerance,omitempty"` // Допуск для float64 и complex (0 — значение по умолчанию)
+}
+
+// AnalyzeResponse представляет отчет о свойствах матрицы
+type AnalyzeResponse struct {
+	Rows             int     `json:"rows"`
+	Cols             int     `json:"cols"`
+	Exact            bool    `json:"exact"`     // Проверки выполнены в точной арифметике
+	Tolerance        float64 `json:"tolerance"` // Использованный допуск
+	Square           bool    `json:"square"`
+	Symmetric        bool    `json:"symmetric"`
+	SkewSymmetric    bool    `json:"skewSymmetric"`
+	Hermitian        bool    `json:"hermitian"`
+	Orthogonal       bool    `json:"orthogonal"`
+	Unitary          bool    `json:"unitary"`
+	Diagonal         bool    `json:"diagonal"`
+	UpperTriangular  bool    `json:"upperTriangular"`
+	LowerTriangular  bool    `json:"lowerTriangular"`
+	Idempotent       bool    `json:"idempotent"`
+	Involutory       bool    `json:"involutory"`
+	Nilpotent        bool    `json:"nilpotent"`
+	NilpotencyIndex  int     `json:"nilpotencyIndex"`            // Индекс нильпотентности (0, если не нильпотентна)
+	PositiveDefinite *bool   `json:"positiveDefinite,omitempty"` // Отсутствует, если свойство не определено
+	Diagonalizable   *bool   `json:"diagonalizable,omitempty"`   // Отсутствует, если проверка не удалась
+	Error            string  `json:"error,omitempty"`            // Сообщение об ошибке
+}
@@ -38,6 +38,8 @@ func main() {
 		api.POST("/determinant", handleDeterminant)
 		api.POST("/rank", handleRank)
 		api.POST("/inverse", handleInverse)
+		api.POST("/eigen", handleEigen)
+		api.POST("/analyze", handleAnalyze)
 	}
 
 	// Обработка статических файлов
@@ -111,18 +113,30 @@ func handleSolveSystem(c *gin.Context) {
 		return
 	}
 
-	// Решаем систему
-	var result interface{}
+	// Решаем систему (в том числе прямоугольную или вырожденную)
+	var result server.SolveResponse
 	var solveErr error
 	switch m := mat.(type) {
 	case *matrix.Matrix[field.Float64]:
-		result, solveErr = matrix.SolveSystemParallel(m, vec.(*vector.Vector[field.Float64]))
+		var sol *matrix.GeneralSolution[field.Float64]
+		if sol, solveErr = matrix.SolveGeneralParallel(m, vec.(*vector.Vector[field.Float64])); solveErr == nil {
+			result = server.GeneralSolutionToResponse(sol)
+		}
 	case *matrix.Matrix[field.Complex]:
-		result, solveErr = matrix.SolveSystemParallel(m, vec.(*vector.Vector[field.Complex]))
+		var sol *matrix.GeneralSolution[field.Complex]
+		if sol, solveErr = matrix.SolveGeneralParallel(m, vec.(*vector.Vector[field.Complex])); solveErr == nil {
+			result = server.GeneralSolutionToResponse(sol)
+		}
 	case *matrix.Matrix[field.Rational]:
-		result, solveErr = matrix.SolveSystemParallel(m, vec.(*vector.Vector[field.Rational]))
+		var sol *matrix.GeneralSolution[field.Rational]
+		if sol, solveErr = matrix.SolveGeneralParallel(m, vec.(*vector.Vector[field.Rational])); solveErr == nil {
+			result = server.GeneralSolutionToResponse(sol)
+		}
 	case *matrix.Matrix[field.GF]:
-		result, solveErr = matrix.SolveSystemParallel(m, vec.(*vector.Vector[field.GF]))
+		var sol *matrix.GeneralSolution[field.GF]
+		if sol, solveErr = matrix.SolveGeneralParallel(m, vec.(*vector.Vector[field.GF])); solveErr == nil {
+			result = server.GeneralSolutionToResponse(sol)
+		}
 	default:
 		c.JSON(http.StatusBadRequest, server.MatrixResponse{Error: "неподдерживаемый тип матрицы"})
 		return
@@ -133,20 +147,7 @@ func handleSolveSystem(c *gin.Context) {
 		return
 	}
 
-	// Конвертируем результат в строки
-	var resultStrings []string
-	switch res := result.(type) {
-	case *vector.Vector[field.Float64]:
-		resultStrings = server.VectorToStrings(res)
-	case *vector.Vector[field.Complex]:
-		resultStrings = server.VectorToStrings(res)
-	case *vector.Vector[field.Rational]:
-		resultStrings = server.VectorToStrings(res)
-	case *vector.Vector[field.GF]:
-		resultStrings = server.VectorToStrings(res)
-	}
-
-	c.JSON(http.StatusOK, server.MatrixResponse{Result: [][]string{resultStrings}})
+	c.JSON(http.StatusOK, result)
 }
 
 func handleDeterminant(c *gin.Context) {
@@ -246,3 +247,47 @@ func handleInverse(c *gin.Context) {
 
 	c.JSON(http.StatusOK, server.MatrixResponse{Result: server.MatrixToStrings(result)})
 }
+
+func handleEigen(c *gin.Context) {
+	var req server.MatrixRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, server.EigenResponse{Error: "Неверный формат данных"})
+		return
+	}
+
+	mat, err := server.ParseMatrix(req)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, server.EigenResponse{Error: err.Error()})
+		return
+	}
+
+	result, err := server.EigenToResponse(mat)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, server.EigenResponse{Error: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+func handleAnalyze(c *gin.Context) {
+	var req server.AnalyzeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, server.AnalyzeResponse{Error: "Неверный формат данных"})
+		return
+	}
+
+	mat, err := server.ParseMatrix(req.MatrixRequest)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, server.AnalyzeResponse{Error: err.Error()})
+		return
+	}
+
+	result, err := server.AnalyzeToResponse(mat, req.Tolerance)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, server.AnalyzeResponse{Error: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
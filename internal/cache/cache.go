@@ -163,7 +163,95 @@ func (mc *MatrixCache[T]) CacheSolveSystem(m *matrix.Matrix[T], v *vector.Vector
 		return val.(*vector.Vector[T]), nil
 	}
 
-	result, err := matrix.SolveSystemParallel(m, v)
+	// Повторные решения с той же матрицей используют сохраненное LU-разложение
+	lu, err := mc.CacheLU(m)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := lu.Solve(v)
+	if err != nil {
+		return nil, err
+	}
+
+	mc.cache.Set(key, result)
+	return result, nil
+}
+
+// CacheLU кэширует LU-разложение матрицы
+func (mc *MatrixCache[T]) CacheLU(m *matrix.Matrix[T]) (*matrix.LUFactorization[T], error) {
+	key := generateKey("lu", m.Data)
+	if val, ok := mc.cache.Get(key); ok {
+		return val.(*matrix.LUFactorization[T]), nil
+	}
+
+	result, err := m.LUParallel(false)
+	if err != nil {
+		return nil, err
+	}
+
+	mc.cache.Set(key, result)
+	return result, nil
+}
+
+// CacheSolveGeneral кэширует общее решение произвольной системы уравнений
+func (mc *MatrixCache[T]) CacheSolveGeneral(m *matrix.Matrix[T], v *vector.Vector[T]) (*matrix.GeneralSolution[T], error) {
+	key := generateKey("solve-general", m.Data, v.Data)
+	if val, ok := mc.cache.Get(key); ok {
+		return val.(*matrix.GeneralSolution[T]), nil
+	}
+
+	result, err := matrix.SolveGeneralParallel(m, v)
+	if err != nil {
+		return nil, err
+	}
+
+	mc.cache.Set(key, result)
+	return result, nil
+}
+
+// modularStore хранит образы многомодульных вычислений одной задачи по простым модулям
+type modularStore struct {
+	cache *Cache
+	key   string
+}
+
+func (s modularStore) Image(p int64) (*matrix.ModularImage, bool) {
+	if val, ok := s.cache.Get(generateKey(s.key, p)); ok {
+		return val.(*matrix.ModularImage), true
+	}
+	return nil, false
+}
+
+func (s modularStore) SetImage(img *matrix.ModularImage) {
+	s.cache.Set(generateKey(s.key, img.Prime), img)
+}
+
+// CacheDeterminantModular кэширует многомодульное вычисление определителя рациональной матрицы.
+// Помимо итогового значения сохраняются образы по каждому простому модулю.
+func CacheDeterminantModular(mc *MatrixCache[field.Rational], m *matrix.Matrix[field.Rational]) (field.Rational, error) {
+	key := generateKey("det-modular", m.Data)
+	if val, ok := mc.cache.Get(key); ok {
+		return val.(field.Rational), nil
+	}
+
+	result, err := matrix.DeterminantModular(m, modularStore{cache: mc.cache, key: key + ":image"})
+	if err != nil {
+		return field.Rational{}, err
+	}
+
+	mc.cache.Set(key, result)
+	return result, nil
+}
+
+// CacheSolveModular кэширует многомодульное решение рациональной системы уравнений
+func CacheSolveModular(mc *MatrixCache[field.Rational], m *matrix.Matrix[field.Rational], v *vector.Vector[field.Rational]) (*vector.Vector[field.Rational], error) {
+	key := generateKey("solve-modular", m.Data, v.Data)
+	if val, ok := mc.cache.Get(key); ok {
+		return val.(*vector.Vector[field.Rational]), nil
+	}
+
+	result, err := matrix.SolveSystemModular(m, v, modularStore{cache: mc.cache, key: key + ":image"})
 	if err != nil {
 		return nil, err
 	}
@@ -102,6 +102,20 @@ func TestMatrixCache(t *testing.T) {
 		assert.Equal(t, sol1.Data, sol2.Data)
 		assert.Less(t, secondCall, firstCall)
 	})
+
+	t.Run("cache lu reused across right-hand sides", func(t *testing.T) {
+		lu1, err := cache.CacheLU(mat)
+		assert.NoError(t, err)
+
+		sol, err := cache.CacheSolveSystem(mat, vector.NewVector([]field.Float64{5, 6}))
+		assert.NoError(t, err)
+		assert.InDelta(t, -4.0, float64(sol.Data[0]), 1e-10)
+		assert.InDelta(t, 4.5, float64(sol.Data[1]), 1e-10)
+
+		lu2, err := cache.CacheLU(mat)
+		assert.NoError(t, err)
+		assert.Same(t, lu1, lu2)
+	})
 }
 
 func TestCacheEviction(t *testing.T) {
@@ -179,3 +193,56 @@ func TestCacheThreadSafety(t *testing.T) {
 		<-done
 	}
 }
+
+func TestCacheModular(t *testing.T) {
+	cache := NewMatrixCache[field.Rational](time.Minute, 1000)
+
+	mat, _ := matrix.FromSlice([][]field.Rational{
+		{field.NewRational(2, 1), field.NewRational(1, 3)},
+		{field.NewRational(-1, 1), field.NewRational(4, 1)},
+	})
+	vec := vector.NewVector([]field.Rational{field.NewRational(1, 1), field.NewRational(2, 1)})
+
+	t.Run("determinant", func(t *testing.T) {
+		det, err := CacheDeterminantModular(cache, mat)
+		assert.NoError(t, err)
+		assert.True(t, det.Equal(field.NewRational(25, 3)))
+		// Кроме результата сохранены образы по простым модулям
+		assert.Greater(t, len(cache.cache.data), 1)
+
+		// Если итоговое значение вытеснено, образы по модулям используются повторно
+		delete(cache.cache.data, generateKey("det-modular", mat.Data))
+		again, err := CacheDeterminantModular(cache, mat)
+		assert.NoError(t, err)
+		assert.True(t, det.Equal(again))
+	})
+
+	t.Run("solve", func(t *testing.T) {
+		x, err := CacheSolveModular(cache, mat, vec)
+		assert.NoError(t, err)
+		expected, err := matrix.SolveSystem(mat, vec)
+		assert.NoError(t, err)
+		for i := range x.Data {
+			assert.True(t, expected.Data[i].Equal(x.Data[i]))
+		}
+
+		cached, err := CacheSolveModular(cache, mat, vec)
+		assert.NoError(t, err)
+		assert.Same(t, x, cached)
+	})
+}
+
+func TestCacheSolveSingular(t *testing.T) {
+	cache := NewMatrixCache[field.Float64](time.Minute, 10)
+
+	mat, _ := matrix.FromSlice([][]field.Float64{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}})
+	vec := vector.NewVector([]field.Float64{1, 2, 4})
+
+	x, err := cache.CacheSolveSystem(mat, vec)
+	assert.Error(t, err)
+	assert.Nil(t, x)
+
+	// Ошибка не кэшируется и воспроизводится при повторном вызове
+	_, err = cache.CacheSolveSystem(mat, vec)
+	assert.Error(t, err)
+}
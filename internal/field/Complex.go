@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"math/cmplx"
 	"strconv"
 	"strings"
 )
@@ -73,6 +74,37 @@ func (c Complex) String() string {
 
 var _ Field[Complex] = Complex{0, 0}
 
+func (a Complex) Abs() float64 {
+	return math.Hypot(a.Re, a.Im)
+}
+
+func (a Complex) Conj() Complex {
+	return Complex{Re: a.Re, Im: -a.Im}
+}
+
+func (a Complex) Real() float64 {
+	return a.Re
+}
+
+func (a Complex) Imag() float64 {
+	return a.Im
+}
+
+func (a Complex) Scale(f float64) Complex {
+	return Complex{Re: a.Re * f, Im: a.Im * f}
+}
+
+func (a Complex) FromFloat64(f float64) Complex {
+	return Complex{Re: f, Im: 0}
+}
+
+func (a Complex) Sqrt() Complex {
+	r := cmplx.Sqrt(complex(a.Re, a.Im))
+	return Complex{Re: real(r), Im: imag(r)}
+}
+
+var _ Scalar[Complex] = Complex{0, 0}
+
 // ParseComplex разбирает строки вида:
 // "3+4i", "-2.1-5.3i", "5", "5i", "-i", "i"
 func ParseComplex(s string) (Complex, error) {
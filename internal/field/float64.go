@@ -40,3 +40,26 @@ func ParseFloat64(s string) (Float64, error) {
 	}
 	return Float64(v), nil
 }
+
+func (a Float64) Abs() float64                  { return math.Abs(float64(a)) }
+func (a Float64) Conj() Float64                 { return a }
+func (a Float64) Real() float64                 { return float64(a) }
+func (a Float64) Imag() float64                 { return 0 }
+func (a Float64) Scale(f float64) Float64       { return a * Float64(f) }
+func (a Float64) FromFloat64(f float64) Float64 { return Float64(f) }
+func (a Float64) Sqrt() Float64                 { return Float64(math.Sqrt(float64(a))) }
+
+// Sign возвращает знак числа с той же точностью, что и Equal
+func (a Float64) Sign() int {
+	switch {
+	case a.Equal(0):
+		return 0
+	case a > 0:
+		return 1
+	default:
+		return -1
+	}
+}
+
+var _ Scalar[Float64] = Float64(0)
+var _ Signed = Float64(0)
@@ -118,6 +118,16 @@ func (g GF) Equal(other GF) bool {
 	return g.p.Cmp(other.p) == 0 && g.value.Cmp(other.value) == 0
 }
 
+// Value возвращает представителя элемента в диапазоне [0, p)
+func (g GF) Value() *big.Int {
+	return new(big.Int).Set(g.value)
+}
+
+// Modulus возвращает характеристику поля p
+func (g GF) Modulus() *big.Int {
+	return new(big.Int).Set(g.p)
+}
+
 func (g GF) String() string {
 	return fmt.Sprintf("%d (mod %d)", g.value, g.p)
 }
@@ -107,6 +107,27 @@ func (r Rational) Equal(other Rational) bool {
 	return r.num.Cmp(other.num) == 0 && r.den.Cmp(other.den) == 0
 }
 
+// Num возвращает числитель несократимой дроби
+func (r Rational) Num() *big.Int {
+	return new(big.Int).Set(r.num)
+}
+
+// Den возвращает положительный знаменатель несократимой дроби
+func (r Rational) Den() *big.Int {
+	return new(big.Int).Set(r.den)
+}
+
+// Float64 возвращает ближайшее к дроби число с плавающей точкой
+func (r Rational) Float64() float64 {
+	f, _ := new(big.Rat).SetFrac(r.num, r.den).Float64()
+	return f
+}
+
+// Sign возвращает знак числа: -1, 0 или +1
+func (r Rational) Sign() int {
+	return r.num.Sign()
+}
+
 func (r Rational) String() string {
 	if r.den.Cmp(big.NewInt(1)) == 0 {
 		return r.num.String()
@@ -116,3 +137,4 @@ func (r Rational) String() string {
 
 // Проверка реализации интерфейса Field
 var _ Field[Rational] = Rational{}
+var _ Signed = Rational{}
@@ -0,0 +1,19 @@
+package field
+
+// Scalar описывает числовое поле с модулем и комплексным сопряжением (Float64, Complex).
+// Такие поля нужны ортогональным разложениям и итерационным численным алгоритмам.
+type Scalar[T any] interface {
+	Field[T]
+	Abs() float64            // модуль числа
+	Conj() T                 // комплексное сопряжение (для вещественных чисел — само число)
+	Real() float64           // действительная часть
+	Imag() float64           // мнимая часть
+	Scale(f float64) T       // умножение на вещественное число
+	FromFloat64(f float64) T // вещественное число как элемент поля
+	Sqrt() T                 // главное значение квадратного корня
+}
+
+// Signed описывает элементы упорядоченного поля, у которых определен знак (Float64, Rational)
+type Signed interface {
+	Sign() int // -1, 0 или +1
+}
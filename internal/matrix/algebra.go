@@ -6,6 +6,9 @@ import (
 	"errors"
 )
 
+// SolveSystem решает систему с квадратной матрицей. Структура матрицы определяется
+// автоматически: треугольные, диагональные, трехдиагональные и ленточные системы решаются
+// быстрыми специализированными методами, остальные — исключением Гаусса.
 func SolveSystem[T field.Field[T]](mat *Matrix[T], vec *vector.Vector[T]) (*vector.Vector[T], error) {
 	if mat.Rows != mat.Cols {
 		return nil, errors.New("матрица должна быть квадратной")
@@ -13,6 +16,11 @@ func SolveSystem[T field.Field[T]](mat *Matrix[T], vec *vector.Vector[T]) (*vect
 	if mat.Rows != vec.Len() {
 		return nil, errors.New("размер вектора не совпадает с размером матрицы")
 	}
+	return SolveSystemStructured(mat, vec, mat.DetectStructure())
+}
+
+// solveSystemGauss решает систему исключением Гаусса за O(n³)
+func solveSystemGauss[T field.Field[T]](mat *Matrix[T], vec *vector.Vector[T]) (*vector.Vector[T], error) {
 	n := mat.Rows
 	M := mat.Clone()
 	B := make([]T, n)
@@ -63,10 +71,63 @@ func SolveSystem[T field.Field[T]](mat *Matrix[T], vec *vector.Vector[T]) (*vect
 	return vector.NewVector[T](x), nil
 }
 
-func SolveHomoSystem[T field.Field[T]](mat *Matrix[T]) (*vector.Vector[T], error) {
-	vc := make([]T, mat.Rows)
-	for i := 0; i < mat.Rows; i++ {
-		vc[i] = mat.Data[0][0].Zero()
+// NullSpace возвращает базис ядра матрицы (решений однородной системы A*x = 0).
+// Работает для прямоугольных матриц над любым полем; для невырожденной матрицы базис пуст.
+func NullSpace[T field.Field[T]](mat *Matrix[T]) []*vector.Vector[T] {
+	rref := mat.RREF(false)
+	basis, _ := kernelFromRREF(rref.Reduced, rref.Pivots, mat.Cols)
+	return basis
+}
+
+// kernelFromRREF строит базис ядра по приведенному ступенчатому виду.
+// Учитываются только первые cols столбцов (остальные, например столбец правой части, игнорируются).
+// Возвращает базис и номера свободных переменных, соответствующих базисным векторам.
+func kernelFromRREF[T field.Field[T]](reduced *Matrix[T], pivots []int, cols int) ([]*vector.Vector[T], []int) {
+	zero := reduced.Data[0][0].Zero()
+	one := reduced.Data[0][0].One()
+
+	isPivot := make([]bool, cols)
+	for _, p := range pivots {
+		if p < cols {
+			isPivot[p] = true
+		}
+	}
+
+	basis := make([]*vector.Vector[T], 0)
+	freeVars := make([]int, 0)
+	for free := 0; free < cols; free++ {
+		if isPivot[free] {
+			continue
+		}
+
+		// Свободная переменная равна единице, остальные свободные — нулю,
+		// базисные выражаются через нее из приведенного ступенчатого вида
+		x := make([]T, cols)
+		for j := range x {
+			x[j] = zero
+		}
+		x[free] = one
+		for row, p := range pivots {
+			if p < cols {
+				x[p] = reduced.Data[row][free].Neg()
+			}
+		}
+		basis = append(basis, vector.NewVector(x))
+		freeVars = append(freeVars, free)
+	}
+
+	return basis, freeVars
+}
+
+// LeftNullSpace возвращает базис левого ядра матрицы (векторов y, таких что y^T*A = 0)
+func LeftNullSpace[T field.Field[T]](mat *Matrix[T]) []*vector.Vector[T] {
+	return NullSpace(mat.Transpose())
+}
+
+// SolveSystemSparse решает систему с разреженной правой частью
+func SolveSystemSparse[T field.Field[T]](mat *Matrix[T], vec *vector.SparseVector[T]) (*vector.Vector[T], error) {
+	if mat.Rows != vec.Len() {
+		return nil, errors.New("размер вектора не совпадает с размером матрицы")
 	}
-	return SolveSystem(mat, vector.NewVector(vc))
+	return SolveSystem(mat, vec.ToDense())
 }
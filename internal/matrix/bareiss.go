@@ -0,0 +1,332 @@
+package matrix
+
+import (
+	"MatrixGo/internal/field"
+	"errors"
+	"math/big"
+)
+
+// Алгоритм Бареисса: a_ij ← (a_kk*a_ij - a_ik*a_kj) / p, где p — предыдущий ведущий элемент.
+// Деление всегда точное, поскольку после k-го шага элементы матрицы являются минорами
+// порядка k+1 исходной матрицы. Поэтому вычисления остаются в целых числах, а размер
+// промежуточных значений ограничен оценкой Адамара для миноров.
+
+// cloneIntMatrix копирует целочисленную матрицу
+func cloneIntMatrix(a [][]*big.Int) [][]*big.Int {
+	res := make([][]*big.Int, len(a))
+	for i := range a {
+		res[i] = make([]*big.Int, len(a[i]))
+		for j := range a[i] {
+			res[i][j] = new(big.Int).Set(a[i][j])
+		}
+	}
+	return res
+}
+
+// bareissEliminate выполняет шаг Бареисса для строки i по ведущей строке k, начиная со столбца from
+func bareissEliminate(a [][]*big.Int, i, k, col, from int, prev *big.Int) {
+	pivot, factor := a[k][col], new(big.Int).Set(a[i][col])
+	tmp := new(big.Int)
+	for j := from; j < len(a[i]); j++ {
+		a[i][j].Mul(a[i][j], pivot)
+		tmp.Mul(factor, a[k][j])
+		a[i][j].Sub(a[i][j], tmp)
+		a[i][j].Quo(a[i][j], prev)
+	}
+}
+
+// bareissForward приводит копию матрицы к ступенчатому виду без дробей.
+// Возвращает ступенчатую матрицу, столбцы ведущих элементов и четность числа перестановок строк.
+func bareissForward(a [][]*big.Int, cols int) (res [][]*big.Int, pivots []int, negate bool) {
+	res = cloneIntMatrix(a)
+	rows := len(res)
+	prev := big.NewInt(1)
+	r := 0
+	for c := 0; c < cols && r < rows; c++ {
+		p := r
+		for p < rows && res[p][c].Sign() == 0 {
+			p++
+		}
+		if p == rows {
+			continue
+		}
+		if p != r {
+			res[r], res[p] = res[p], res[r]
+			negate = !negate
+		}
+
+		for i := r + 1; i < rows; i++ {
+			// Столбец c у строки i обнуляется вместе с остальными, начиная с c
+			bareissEliminate(res, i, r, c, c, prev)
+		}
+		prev = new(big.Int).Set(res[r][c])
+		pivots = append(pivots, c)
+		r++
+	}
+	return res, pivots, negate
+}
+
+// BareissDeterminant вычисляет определитель целочисленной квадратной матрицы без дробей
+func BareissDeterminant(a [][]*big.Int) (*big.Int, error) {
+	n := len(a)
+	for _, row := range a {
+		if len(row) != n {
+			return nil, errors.New("матрица должна быть квадратной")
+		}
+	}
+	if n == 0 {
+		return big.NewInt(1), nil
+	}
+
+	res, pivots, negate := bareissForward(a, n)
+	if len(pivots) < n {
+		return big.NewInt(0), nil
+	}
+	// Последний ведущий элемент равен определителю с точностью до знака перестановки
+	det := new(big.Int).Set(res[n-1][n-1])
+	if negate {
+		det.Neg(det)
+	}
+	return det, nil
+}
+
+// BareissRank вычисляет ранг целочисленной матрицы без дробей
+func BareissRank(a [][]*big.Int) int {
+	if len(a) == 0 {
+		return 0
+	}
+	_, pivots, _ := bareissForward(a, len(a[0]))
+	return len(pivots)
+}
+
+// BareissAdjugate вычисляет присоединенную матрицу adj(A) (adj(A)*A = det(A)*I) и определитель.
+// Для невырожденной матрицы используется бесдробный метод Гаусса–Жордана для [A | I]:
+// в конце левая часть равна det*I, а правая — adj(A) с учетом знака перестановки.
+// При ранге n-1 алгебраические дополнения вычисляются как определители миноров,
+// при меньшем ранге присоединенная матрица нулевая.
+func BareissAdjugate(a [][]*big.Int) (adj [][]*big.Int, det *big.Int, err error) {
+	return bareissAdjugate(a, serialRows)
+}
+
+// BareissAdjugateParallel — параллельная версия BareissAdjugate: на каждом шаге строки [A | I]
+// исключаются независимо друг от друга, а в вырожденном случае миноры вычисляются
+// параллельно по удаляемым строкам
+func BareissAdjugateParallel(a [][]*big.Int) (adj [][]*big.Int, det *big.Int, err error) {
+	return bareissAdjugate(a, parallelRows)
+}
+
+func bareissAdjugate(a [][]*big.Int, run rowRangeRunner) (adj [][]*big.Int, det *big.Int, err error) {
+	n := len(a)
+	for _, row := range a {
+		if len(row) != n {
+			return nil, nil, errors.New("матрица должна быть квадратной")
+		}
+	}
+
+	zeroMatrix := func() [][]*big.Int {
+		res := make([][]*big.Int, n)
+		for i := range res {
+			res[i] = make([]*big.Int, n)
+			for j := range res[i] {
+				res[i][j] = new(big.Int)
+			}
+		}
+		return res
+	}
+	if n == 0 {
+		return zeroMatrix(), big.NewInt(1), nil
+	}
+
+	aug := zeroMatrix()
+	for i := range aug {
+		row := make([]*big.Int, 2*n)
+		for j := 0; j < n; j++ {
+			row[j] = new(big.Int).Set(a[i][j])
+			row[n+j] = new(big.Int)
+		}
+		row[n+i].SetInt64(1)
+		aug[i] = row
+	}
+
+	prev := big.NewInt(1)
+	negate := false
+	for k := 0; k < n; k++ {
+		p := k
+		for p < n && aug[p][k].Sign() == 0 {
+			p++
+		}
+		if p == n {
+			return singularAdjugate(a, zeroMatrix, run)
+		}
+		if p != k {
+			aug[k], aug[p] = aug[p], aug[k]
+			negate = !negate
+		}
+
+		// Строка k на этом шаге не меняется, поэтому остальные строки исключаются независимо
+		run(0, n, func(from, to int) {
+			for i := from; i < to; i++ {
+				if i != k {
+					bareissEliminate(aug, i, k, k, 0, prev)
+				}
+			}
+		})
+		prev = new(big.Int).Set(aug[k][k])
+	}
+
+	det = new(big.Int).Set(aug[n-1][n-1])
+	adj = zeroMatrix()
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			adj[i][j].Set(aug[i][n+j])
+			if negate {
+				adj[i][j].Neg(adj[i][j])
+			}
+		}
+	}
+	if negate {
+		det.Neg(det)
+	}
+	return adj, det, nil
+}
+
+// singularAdjugate вычисляет присоединенную матрицу вырожденной матрицы через миноры
+func singularAdjugate(a [][]*big.Int, zeroMatrix func() [][]*big.Int, run rowRangeRunner) ([][]*big.Int, *big.Int, error) {
+	n := len(a)
+	adj := zeroMatrix()
+	if BareissRank(a) < n-1 {
+		return adj, big.NewInt(0), nil
+	}
+
+	// Диапазоны удаляемых строк i обрабатываются независимо: каждый заполняет свои столбцы adj
+	errs := make([]error, n)
+	run(0, n, func(from, to int) {
+		minor := make([][]*big.Int, n-1)
+		for i := from; i < to; i++ {
+			for j := 0; j < n; j++ {
+				// Минор без строки i и столбца j; adj(A)_ji = (-1)^(i+j) * M_ij
+				for r, rr := 0, 0; r < n; r++ {
+					if r == i {
+						continue
+					}
+					minor[rr] = make([]*big.Int, 0, n-1)
+					for c := 0; c < n; c++ {
+						if c != j {
+							minor[rr] = append(minor[rr], a[r][c])
+						}
+					}
+					rr++
+				}
+				d, err := BareissDeterminant(minor)
+				if err != nil {
+					errs[i] = err
+					return
+				}
+				if (i+j)%2 == 1 {
+					d.Neg(d)
+				}
+				adj[j][i] = d
+			}
+		}
+	})
+	if err := errors.Join(errs...); err != nil {
+		return nil, nil, err
+	}
+	return adj, big.NewInt(0), nil
+}
+
+// integerEntries возвращает элементы рациональной матрицы как целые числа,
+// если все знаменатели равны 1
+func integerEntries(m *Matrix[field.Rational]) ([][]*big.Int, bool) {
+	res := make([][]*big.Int, m.Rows)
+	for i := 0; i < m.Rows; i++ {
+		res[i] = make([]*big.Int, m.Cols)
+		for j := 0; j < m.Cols; j++ {
+			if !m.Data[i][j].Den().IsInt64() || m.Data[i][j].Den().Int64() != 1 {
+				return nil, false
+			}
+			res[i][j] = m.Data[i][j].Num()
+		}
+	}
+	return res, true
+}
+
+// scaledIntegerRows умножает каждую строку рациональной матрицы на НОК знаменателей ее элементов.
+// Возвращает целочисленную матрицу D*A и множители d_i диагональной матрицы D.
+func scaledIntegerRows(m *Matrix[field.Rational]) ([][]*big.Int, []*big.Int) {
+	res := make([][]*big.Int, m.Rows)
+	scales := make([]*big.Int, m.Rows)
+	for i := 0; i < m.Rows; i++ {
+		lcm := big.NewInt(1)
+		for j := 0; j < m.Cols; j++ {
+			den := m.Data[i][j].Den()
+			g := new(big.Int).GCD(nil, nil, lcm, den)
+			lcm.Mul(lcm, den.Quo(den, g))
+		}
+		res[i] = make([]*big.Int, m.Cols)
+		for j := 0; j < m.Cols; j++ {
+			x := m.Data[i][j]
+			v := new(big.Int).Quo(lcm, x.Den())
+			res[i][j] = v.Mul(v, x.Num())
+		}
+		scales[i] = lcm
+	}
+	return res, scales
+}
+
+// DeterminantFractionFree вычисляет определитель рациональной матрицы алгоритмом Бареисса.
+// Строки предварительно приводятся к целым: det(A) = det(D*A) / (d_1*...*d_n).
+func DeterminantFractionFree(m *Matrix[field.Rational]) (field.Rational, error) {
+	ints, scales := scaledIntegerRows(m)
+	det, err := BareissDeterminant(ints)
+	if err != nil {
+		return field.Rational{}, err
+	}
+	den := big.NewInt(1)
+	for _, d := range scales {
+		den.Mul(den, d)
+	}
+	return field.NewRationalFromBig(det, den), nil
+}
+
+// RankFractionFree вычисляет ранг рациональной матрицы алгоритмом Бареисса.
+// Умножение строк на ненулевые числа ранг не меняет.
+func RankFractionFree(m *Matrix[field.Rational]) int {
+	ints, _ := scaledIntegerRows(m)
+	return BareissRank(ints)
+}
+
+// AdjugateFractionFree вычисляет присоединенную матрицу рациональной матрицы без дробей.
+// Для B = D*A имеем adj(B) = adj(A)*adj(D) = adj(A)*det(D)*D^(-1), откуда
+// adj(A)_ij = adj(B)_ij * d_j / det(D).
+func AdjugateFractionFree(m *Matrix[field.Rational]) (*Matrix[field.Rational], error) {
+	return adjugateFractionFree(m, serialRows)
+}
+
+// AdjugateFractionFreeParallel — параллельная версия AdjugateFractionFree
+func AdjugateFractionFreeParallel(m *Matrix[field.Rational]) (*Matrix[field.Rational], error) {
+	return adjugateFractionFree(m, parallelRows)
+}
+
+func adjugateFractionFree(m *Matrix[field.Rational], run rowRangeRunner) (*Matrix[field.Rational], error) {
+	ints, scales := scaledIntegerRows(m)
+	adj, _, err := bareissAdjugate(ints, run)
+	if err != nil {
+		return nil, err
+	}
+	detD := big.NewInt(1)
+	for _, d := range scales {
+		detD.Mul(detD, d)
+	}
+
+	res := NewMatrix[field.Rational](m.Rows, m.Cols, field.NewRational(0, 1))
+	run(0, len(adj), func(from, to int) {
+		for i := from; i < to; i++ {
+			for j := range adj[i] {
+				num := new(big.Int).Mul(adj[i][j], scales[j])
+				res.Data[i][j] = field.NewRationalFromBig(num, detD)
+			}
+		}
+	})
+	return res, nil
+}
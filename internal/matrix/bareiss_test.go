@@ -0,0 +1,179 @@
+package matrix
+
+import (
+	"MatrixGo/internal/field"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func bigMatrix(data [][]int64) [][]*big.Int {
+	res := make([][]*big.Int, len(data))
+	for i := range data {
+		res[i] = make([]*big.Int, len(data[i]))
+		for j := range data[i] {
+			res[i][j] = big.NewInt(data[i][j])
+		}
+	}
+	return res
+}
+
+// randomIntMatrix строит рациональную матрицу n×n с целыми элементами из [-bound, bound]
+func randomIntMatrix(rng *rand.Rand, n int, bound int64) *Matrix[field.Rational] {
+	rows := make([][]field.Rational, n)
+	for i := range rows {
+		rows[i] = make([]field.Rational, n)
+		for j := range rows[i] {
+			rows[i][j] = field.NewRational(rng.Int63n(2*bound+1)-bound, 1)
+		}
+	}
+	mat, _ := FromSlice(rows)
+	return mat
+}
+
+func TestBareissDeterminant(t *testing.T) {
+	t.Run("known value", func(t *testing.T) {
+		// Нулевой угловой элемент требует перестановки строк
+		det, err := BareissDeterminant(bigMatrix([][]int64{
+			{0, 2, 1},
+			{3, 1, 4},
+			{5, 9, 2},
+		}))
+		assert.NoError(t, err)
+		assert.Equal(t, "50", det.String())
+	})
+
+	t.Run("singular", func(t *testing.T) {
+		det, err := BareissDeterminant(bigMatrix([][]int64{{1, 2}, {2, 4}}))
+		assert.NoError(t, err)
+		assert.Equal(t, 0, det.Sign())
+	})
+
+	t.Run("not square", func(t *testing.T) {
+		_, err := BareissDeterminant(bigMatrix([][]int64{{1, 2}}))
+		assert.Error(t, err)
+	})
+
+	t.Run("matches gauss", func(t *testing.T) {
+		rng := rand.New(rand.NewSource(1))
+		for n := 1; n <= 8; n++ {
+			mat := randomIntMatrix(rng, n, 20)
+			assert.True(t, mat.determinantGauss().Equal(mat.Determinant()))
+		}
+	})
+}
+
+func TestBareissRank(t *testing.T) {
+	a := bigMatrix([][]int64{
+		{1, 2, 3, 4},
+		{2, 4, 6, 8},
+		{0, 0, 1, 1},
+	})
+	assert.Equal(t, 2, BareissRank(a))
+
+	mat := ratMatrix(t, [][]int64{{1, 2, 3, 4}, {2, 4, 6, 8}, {0, 0, 1, 1}})
+	assert.Equal(t, 2, mat.Rank())
+	assert.Equal(t, 2, RankFractionFree(mat))
+}
+
+func TestBareissAdjugate(t *testing.T) {
+	t.Run("nonsingular", func(t *testing.T) {
+		adj, det, err := BareissAdjugate(bigMatrix([][]int64{
+			{0, 2, 1},
+			{3, 1, 4},
+			{5, 9, 2},
+		}))
+		assert.NoError(t, err)
+		assert.Equal(t, "50", det.String())
+		assert.Equal(t, bigMatrix([][]int64{
+			{-34, 5, 7},
+			{14, -5, 3},
+			{22, 10, -6},
+		}), adj)
+	})
+
+	t.Run("rank n-1", func(t *testing.T) {
+		adj, det, err := BareissAdjugate(bigMatrix([][]int64{
+			{1, 2, 3},
+			{4, 5, 6},
+			{7, 8, 9},
+		}))
+		assert.NoError(t, err)
+		assert.Equal(t, 0, det.Sign())
+		assert.Equal(t, bigMatrix([][]int64{
+			{-3, 6, -3},
+			{6, -12, 6},
+			{-3, 6, -3},
+		}), adj)
+	})
+
+	t.Run("rank below n-1", func(t *testing.T) {
+		adj, _, err := BareissAdjugate(bigMatrix([][]int64{
+			{1, 2, 3},
+			{2, 4, 6},
+			{3, 6, 9},
+		}))
+		assert.NoError(t, err)
+		assert.Equal(t, bigMatrix([][]int64{{0, 0, 0}, {0, 0, 0}, {0, 0, 0}}), adj)
+	})
+}
+
+func TestFractionFreeRational(t *testing.T) {
+	mat, _ := FromSlice([][]field.Rational{
+		{field.NewRational(1, 2), field.NewRational(2, 3), field.NewRational(1, 1)},
+		{field.NewRational(-1, 4), field.NewRational(3, 1), field.NewRational(5, 6)},
+		{field.NewRational(2, 1), field.NewRational(0, 1), field.NewRational(-7, 3)},
+	})
+
+	det, err := DeterminantFractionFree(mat)
+	assert.NoError(t, err)
+	assert.True(t, det.Equal(mat.Determinant()))
+
+	// adj(A)*A = det(A)*I
+	adj, err := AdjugateFractionFree(mat)
+	assert.NoError(t, err)
+	parallel, err := AdjugateFractionFreeParallel(mat)
+	assert.NoError(t, err)
+	assertMatrixEqual(t, adj, parallel)
+	prod, err := adj.Mul(mat)
+	assert.NoError(t, err)
+	zero := field.NewRational(0, 1)
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			expected := zero
+			if i == j {
+				expected = det
+			}
+			assert.True(t, prod.Data[i][j].Equal(expected))
+		}
+	}
+	assert.Equal(t, 3, RankFractionFree(mat))
+}
+
+const benchmarkDeterminantSize = 40
+
+func BenchmarkDeterminantGauss(b *testing.B) {
+	mat := randomIntMatrix(rand.New(rand.NewSource(1)), benchmarkDeterminantSize, 100)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		mat.determinantGauss()
+	}
+}
+
+func BenchmarkDeterminantParallel(b *testing.B) {
+	mat := randomIntMatrix(rand.New(rand.NewSource(1)), benchmarkDeterminantSize, 100)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		mat.DeterminantParallel()
+	}
+}
+
+func BenchmarkDeterminantBareiss(b *testing.B) {
+	mat := randomIntMatrix(rand.New(rand.NewSource(1)), benchmarkDeterminantSize, 100)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		mat.Determinant()
+	}
+}
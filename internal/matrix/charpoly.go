@@ -0,0 +1,252 @@
+package matrix
+
+import (
+	"MatrixGo/internal/field"
+	"MatrixGo/internal/polynomial"
+	"errors"
+)
+
+// fieldCharacteristic возвращает характеристику поля элемента x (0 для Float64, Complex и Rational)
+func fieldCharacteristic[T field.Field[T]](x T) int64 {
+	if g, ok := any(x).(field.GF); ok {
+		return g.Modulus().Int64()
+	}
+	return 0
+}
+
+// CharacteristicPolynomial вычисляет характеристический многочлен det(x*I - A) алгоритмом
+// Берковица. Алгоритм не использует деление, поэтому результат точен над Rational и GF(p)
+// любой характеристики.
+func CharacteristicPolynomial[T field.Field[T]](m *Matrix[T]) (*polynomial.Polynomial[T], error) {
+	if m.Rows != m.Cols {
+		return nil, errors.New("матрица должна быть квадратной")
+	}
+
+	n := m.Rows
+	zero := m.Data[0][0].Zero()
+	one := zero.One()
+
+	// p — коэффициенты характеристического многочлена ведущей подматрицы, от старшего к младшему
+	p := []T{one}
+	for r := 1; r <= n; r++ {
+		k := r - 1
+
+		// Первый столбец теплицевой матрицы: 1, -a_kk, -R*C, -R*M*C, ..., -R*M^(r-2)*C,
+		// где M — ведущая подматрица (r-1)×(r-1), R и C — ее окаймляющие строка и столбец
+		col := make([]T, r+1)
+		col[0] = one
+		col[1] = m.Data[k][k].Neg()
+		v := make([]T, k)
+		for i := 0; i < k; i++ {
+			v[i] = m.Data[i][k]
+		}
+		for i := 2; i <= r; i++ {
+			dot := zero
+			for j := 0; j < k; j++ {
+				dot = dot.Add(m.Data[k][j].Mul(v[j]))
+			}
+			col[i] = dot.Neg()
+
+			next := make([]T, k)
+			for a := 0; a < k; a++ {
+				sum := zero
+				for b := 0; b < k; b++ {
+					sum = sum.Add(m.Data[a][b].Mul(v[b]))
+				}
+				next[a] = sum
+			}
+			v = next
+		}
+
+		// p_r = T_r * p_(r-1), T_r — нижняя треугольная теплицева матрица (r+1)×r
+		next := make([]T, r+1)
+		for i := 0; i <= r; i++ {
+			sum := zero
+			for j := 0; j <= min(i, r-1); j++ {
+				sum = sum.Add(col[i-j].Mul(p[j]))
+			}
+			next[i] = sum
+		}
+		p = next
+	}
+
+	coeffs := make([]T, n+1)
+	for i := range p {
+		coeffs[n-i] = p[i]
+	}
+	return polynomial.New(coeffs, zero), nil
+}
+
+// CharacteristicPolynomialFL вычисляет характеристический многочлен методом Фаддеева–Леверье:
+// M_k = A*M_(k-1) + c_(n-k+1)*I, c_(n-k) = -tr(A*M_k)/k. Метод делит на 1, ..., n и поэтому
+// применим только в полях характеристики 0 или p > n; иначе возвращается ошибка.
+func CharacteristicPolynomialFL[T field.Field[T]](m *Matrix[T]) (*polynomial.Polynomial[T], error) {
+	if m.Rows != m.Cols {
+		return nil, errors.New("матрица должна быть квадратной")
+	}
+
+	n := m.Rows
+	zero := m.Data[0][0].Zero()
+	one := zero.One()
+	if p := fieldCharacteristic(zero); p != 0 && p <= int64(n) {
+		return nil, errors.New("метод Фаддеева–Леверье неприменим: характеристика поля не превосходит размер матрицы")
+	}
+
+	coeffs := make([]T, n+1)
+	coeffs[n] = one
+	M := NewMatrix[T](n, n, zero)
+	kElem := zero
+	for k := 1; k <= n; k++ {
+		// M_k = A*M_(k-1) + c_(n-k+1)*I
+		AM, _ := m.Mul(M)
+		for i := 0; i < n; i++ {
+			AM.Data[i][i] = AM.Data[i][i].Add(coeffs[n-k+1])
+		}
+		M = AM
+
+		AMk, _ := m.Mul(M)
+		kElem = kElem.Add(one)
+		c, err := AMk.Trace().Neg().Div(kElem)
+		if err != nil {
+			return nil, err
+		}
+		coeffs[n-k] = c
+	}
+	return polynomial.New(coeffs, zero), nil
+}
+
+// MinimalPolynomial вычисляет минимальный многочлен матрицы как НОК минимальных многочленов
+// базисных векторов. Минимальный многочлен вектора v находится по последовательности Крылова
+// v, A*v, A²*v, ... как первая линейная зависимость.
+func MinimalPolynomial[T field.Field[T]](m *Matrix[T]) (*polynomial.Polynomial[T], error) {
+	if m.Rows != m.Cols {
+		return nil, errors.New("матрица должна быть квадратной")
+	}
+
+	n := m.Rows
+	zero := m.Data[0][0].Zero()
+	result := polynomial.New([]T{zero.One()}, zero)
+	for i := 0; i < n && result.Degree() < n; i++ {
+		e := make([]T, n)
+		for j := range e {
+			e[j] = zero
+		}
+		e[i] = zero.One()
+
+		vecPoly, err := krylovMinimalPolynomial(m, e)
+		if err != nil {
+			return nil, err
+		}
+		if result, err = polynomial.LCM(result, vecPoly); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// krylovMinimalPolynomial находит нормированный многочлен q наименьшей степени с q(A)*v = 0.
+// Каждый новый вектор A^k*v приводится по уже найденным векторам w_j = q_j(A)*v,
+// одновременно с ним приводится и многочлен x^k.
+func krylovMinimalPolynomial[T field.Field[T]](m *Matrix[T], v []T) (*polynomial.Polynomial[T], error) {
+	n := m.Rows
+	zero := m.Data[0][0].Zero()
+	one := zero.One()
+
+	type krylovBasis struct {
+		w     []T
+		q     *polynomial.Polynomial[T]
+		pivot int
+	}
+	var basis []krylovBasis
+
+	u := append([]T(nil), v...)
+	for k := 0; k <= n; k++ {
+		w := append([]T(nil), u...)
+		q := polynomial.Monomial(one, k, zero)
+		for _, b := range basis {
+			c := w[b.pivot]
+			if c.Equal(zero) {
+				continue
+			}
+			for i := range w {
+				w[i] = w[i].Sub(c.Mul(b.w[i]))
+			}
+			q = q.Sub(b.q.Scale(c))
+		}
+
+		pivot := -1
+		for i := range w {
+			if !w[i].Equal(zero) {
+				pivot = i
+				break
+			}
+		}
+		if pivot < 0 {
+			return q, nil
+		}
+
+		inv, err := one.Div(w[pivot])
+		if err != nil {
+			return nil, err
+		}
+		for i := range w {
+			w[i] = w[i].Mul(inv)
+		}
+		basis = append(basis, krylovBasis{w: w, q: q.Scale(inv), pivot: pivot})
+
+		// u = A*u
+		next := make([]T, n)
+		for i := 0; i < n; i++ {
+			sum := zero
+			for j := 0; j < n; j++ {
+				sum = sum.Add(m.Data[i][j].Mul(u[j]))
+			}
+			next[i] = sum
+		}
+		u = next
+	}
+	return nil, errors.New("последовательность Крылова не стала линейно зависимой")
+}
+
+// EvaluatePolynomial вычисляет p(A) по схеме Горнера
+func EvaluatePolynomial[T field.Field[T]](p *polynomial.Polynomial[T], m *Matrix[T]) (*Matrix[T], error) {
+	if m.Rows != m.Cols {
+		return nil, errors.New("матрица должна быть квадратной")
+	}
+
+	zero := m.Data[0][0].Zero()
+	res := NewMatrix[T](m.Rows, m.Cols, zero)
+	for k := p.Degree(); k >= 0; k-- {
+		next, err := res.Mul(m)
+		if err != nil {
+			return nil, err
+		}
+		for i := 0; i < m.Rows; i++ {
+			next.Data[i][i] = next.Data[i][i].Add(p.Coeffs[k])
+		}
+		res = next
+	}
+	return res, nil
+}
+
+// VerifyCayleyHamilton проверяет теорему Гамильтона–Кэли: χ_A(A) = 0
+func VerifyCayleyHamilton[T field.Field[T]](m *Matrix[T]) (bool, error) {
+	chi, err := CharacteristicPolynomial(m)
+	if err != nil {
+		return false, err
+	}
+	val, err := EvaluatePolynomial(chi, m)
+	if err != nil {
+		return false, err
+	}
+
+	zero := m.Data[0][0].Zero()
+	for i := range val.Data {
+		for j := range val.Data[i] {
+			if !val.Data[i][j].Equal(zero) {
+				return false, nil
+			}
+		}
+	}
+	return true, nil
+}
@@ -0,0 +1,93 @@
+package matrix
+
+import (
+	"MatrixGo/internal/field"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCharacteristicPolynomial(t *testing.T) {
+	mat := ratMatrix(t, [][]int64{
+		{2, 1, 0},
+		{1, 3, 1},
+		{0, 1, 4},
+	})
+
+	chi, err := CharacteristicPolynomial(mat)
+	assert.NoError(t, err)
+	assert.Equal(t, "x^3 - 9x^2 + 24x - 18", chi.String())
+	// Свободный член равен (-1)^n * det(A)
+	assert.True(t, mat.Determinant().Neg().Equal(chi.Coefficient(0)))
+
+	fl, err := CharacteristicPolynomialFL(mat)
+	assert.NoError(t, err)
+	assert.True(t, chi.Equal(fl))
+
+	ok, err := VerifyCayleyHamilton(mat)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+}
+
+func TestCharacteristicPolynomialGF(t *testing.T) {
+	gf := func(v int64) field.GF {
+		x, _ := field.NewGF(v, 2)
+		return x
+	}
+	// Над GF(2) метод Фаддеева–Леверье неприменим (деление на 2), а алгоритм Берковица работает
+	mat, _ := FromSlice([][]field.GF{
+		{gf(1), gf(1), gf(0)},
+		{gf(0), gf(1), gf(1)},
+		{gf(1), gf(0), gf(1)},
+	})
+
+	chi, err := CharacteristicPolynomial(mat)
+	assert.NoError(t, err)
+	assert.Equal(t, "x^3 + x^2 + x (mod 2)", chi.String())
+
+	_, err = CharacteristicPolynomialFL(mat)
+	assert.Error(t, err)
+
+	ok, err := VerifyCayleyHamilton(mat)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+}
+
+func TestMinimalPolynomial(t *testing.T) {
+	t.Run("scalar matrix", func(t *testing.T) {
+		mat := ratMatrix(t, [][]int64{{2, 0, 0}, {0, 2, 0}, {0, 0, 2}})
+
+		mu, err := MinimalPolynomial(mat)
+		assert.NoError(t, err)
+		assert.Equal(t, "x - 2", mu.String())
+	})
+
+	t.Run("jordan blocks", func(t *testing.T) {
+		mat := ratMatrix(t, [][]int64{
+			{3, 1, 0, 0},
+			{0, 3, 0, 0},
+			{0, 0, 3, 0},
+			{0, 0, 0, 5},
+		})
+
+		mu, err := MinimalPolynomial(mat)
+		assert.NoError(t, err)
+		assert.Equal(t, "x^3 - 11x^2 + 39x - 45", mu.String()) // (x-3)^2 (x-5)
+
+		val, err := EvaluatePolynomial(mu, mat)
+		assert.NoError(t, err)
+		assertMatrixEqual(t, NewMatrix(4, 4, field.NewRational(0, 1)), val)
+
+		chi, _ := CharacteristicPolynomial(mat)
+		_, rem, _ := chi.DivMod(mu)
+		assert.True(t, rem.IsZero())
+	})
+
+	t.Run("float64", func(t *testing.T) {
+		mat, _ := FromSlice([][]field.Float64{{0, -1}, {1, 0}})
+
+		mu, err := MinimalPolynomial(mat)
+		assert.NoError(t, err)
+		assert.Equal(t, "x^2 + 1", mu.String())
+	})
+}
@@ -0,0 +1,206 @@
+package matrix
+
+import (
+	"MatrixGo/internal/field"
+	"MatrixGo/internal/vector"
+	"errors"
+	"math"
+)
+
+// rowRangeRunner выполняет body над диапазоном строк [start, end) — последовательно или параллельно
+type rowRangeRunner func(start, end int, body func(from, to int))
+
+// serialRows выполняет body над всем диапазоном строк в текущей горутине
+func serialRows(start, end int, body func(from, to int)) {
+	body(start, end)
+}
+
+// CholeskyFactorization хранит разложение A = L*L^H эрмитовой положительно определенной матрицы
+type CholeskyFactorization[T field.Scalar[T]] struct {
+	L *Matrix[T] // нижняя треугольная матрица с положительной вещественной диагональю
+}
+
+// LDLFactorization хранит разложение A = L*D*L^T симметричной матрицы над произвольным полем
+type LDLFactorization[T field.Field[T]] struct {
+	L *Matrix[T] // нижняя унитреугольная матрица
+	D []T        // диагональ D
+}
+
+// Cholesky вычисляет разложение Холецкого для Float64 (симметричный случай)
+// и Complex (эрмитов случай). Возвращает ошибку, если матрица не положительно определена.
+func Cholesky[T field.Scalar[T]](m *Matrix[T]) (*CholeskyFactorization[T], error) {
+	return cholesky(m, serialRows)
+}
+
+func cholesky[T field.Scalar[T]](m *Matrix[T], run rowRangeRunner) (*CholeskyFactorization[T], error) {
+	if m.Rows != m.Cols {
+		return nil, errors.New("матрица должна быть квадратной")
+	}
+	if !isHermitian(m) {
+		return nil, errors.New("матрица не является симметричной (эрмитовой)")
+	}
+	n := m.Rows
+
+	zero := m.Data[0][0].Zero()
+	L := NewMatrix[T](n, n, zero)
+
+	for j := 0; j < n; j++ {
+		d := m.Data[j][j]
+		for k := 0; k < j; k++ {
+			d = d.Sub(L.Data[j][k].Mul(L.Data[j][k].Conj()))
+		}
+		if d.Real() <= 0 || math.IsNaN(d.Real()) {
+			return nil, errors.New("матрица не является положительно определенной")
+		}
+		L.Data[j][j] = zero.FromFloat64(math.Sqrt(d.Real()))
+
+		// Элементы столбца j ниже диагонали не зависят друг от друга
+		run(j+1, n, func(from, to int) {
+			for i := from; i < to; i++ {
+				sum := m.Data[i][j]
+				for k := 0; k < j; k++ {
+					sum = sum.Sub(L.Data[i][k].Mul(L.Data[j][k].Conj()))
+				}
+				L.Data[i][j], _ = sum.Div(L.Data[j][j])
+			}
+		})
+	}
+
+	return &CholeskyFactorization[T]{L: L}, nil
+}
+
+// Solve решает систему A*x = b: L*y = b, затем L^H*x = y
+func (f *CholeskyFactorization[T]) Solve(b *vector.Vector[T]) (*vector.Vector[T], error) {
+	n := f.L.Rows
+	if b.Len() != n {
+		return nil, errors.New("размер вектора не совпадает с размером матрицы")
+	}
+
+	y := make([]T, n)
+	for i := 0; i < n; i++ {
+		sum := b.Data[i]
+		for k := 0; k < i; k++ {
+			sum = sum.Sub(f.L.Data[i][k].Mul(y[k]))
+		}
+		y[i], _ = sum.Div(f.L.Data[i][i])
+	}
+
+	x := make([]T, n)
+	for i := n - 1; i >= 0; i-- {
+		sum := y[i]
+		for k := i + 1; k < n; k++ {
+			sum = sum.Sub(f.L.Data[k][i].Conj().Mul(x[k]))
+		}
+		x[i], _ = sum.Div(f.L.Data[i][i])
+	}
+
+	return vector.NewVector(x), nil
+}
+
+// Determinant вычисляет определитель как квадрат произведения диагонали L
+func (f *CholeskyFactorization[T]) Determinant() T {
+	det := f.L.Data[0][0].One()
+	for i := 0; i < f.L.Rows; i++ {
+		det = det.Mul(f.L.Data[i][i])
+	}
+	return det.Mul(det)
+}
+
+// LDL вычисляет разложение A = L*D*L^T без извлечения корней, поэтому оно остается
+// точным над Rational и GF. Возвращает ошибку, если встречается нулевой ведущий элемент.
+// При requirePositive = true дополнительно проверяется, что все элементы D положительны
+// (только для упорядоченных полей Float64 и Rational), то есть что матрица положительно определена.
+func LDL[T field.Field[T]](m *Matrix[T], requirePositive bool) (*LDLFactorization[T], error) {
+	return ldl(m, requirePositive, serialRows)
+}
+
+func ldl[T field.Field[T]](m *Matrix[T], requirePositive bool, run rowRangeRunner) (*LDLFactorization[T], error) {
+	if m.Rows != m.Cols {
+		return nil, errors.New("матрица должна быть квадратной")
+	}
+	n := m.Rows
+	for i := 0; i < n; i++ {
+		for j := 0; j < i; j++ {
+			if !m.Data[i][j].Equal(m.Data[j][i]) {
+				return nil, errors.New("матрица не является симметричной")
+			}
+		}
+	}
+
+	zero := m.Data[0][0].Zero()
+	L := IdentityMatrix[T](n, zero, zero.One())
+	D := make([]T, n)
+
+	for j := 0; j < n; j++ {
+		d := m.Data[j][j]
+		for k := 0; k < j; k++ {
+			d = d.Sub(L.Data[j][k].Mul(L.Data[j][k]).Mul(D[k]))
+		}
+
+		if requirePositive {
+			signed, ok := any(d).(field.Signed)
+			if !ok {
+				return nil, errors.New("положительная определенность не определена для данного поля")
+			}
+			if signed.Sign() <= 0 {
+				return nil, errors.New("матрица не является положительно определенной")
+			}
+		}
+		if d.Equal(zero) {
+			return nil, errors.New("нулевой ведущий элемент: разложение LDLᵀ без перестановок не существует")
+		}
+		D[j] = d
+
+		run(j+1, n, func(from, to int) {
+			for i := from; i < to; i++ {
+				sum := m.Data[i][j]
+				for k := 0; k < j; k++ {
+					sum = sum.Sub(L.Data[i][k].Mul(L.Data[j][k]).Mul(D[k]))
+				}
+				L.Data[i][j], _ = sum.Div(D[j])
+			}
+		})
+	}
+
+	return &LDLFactorization[T]{L: L, D: D}, nil
+}
+
+// Solve решает систему A*x = b: L*y = b, D*z = y, L^T*x = z
+func (f *LDLFactorization[T]) Solve(b *vector.Vector[T]) (*vector.Vector[T], error) {
+	n := f.L.Rows
+	if b.Len() != n {
+		return nil, errors.New("размер вектора не совпадает с размером матрицы")
+	}
+
+	z := make([]T, n)
+	for i := 0; i < n; i++ {
+		sum := b.Data[i]
+		for k := 0; k < i; k++ {
+			sum = sum.Sub(f.L.Data[i][k].Mul(z[k]))
+		}
+		z[i] = sum
+	}
+	for i := 0; i < n; i++ {
+		z[i], _ = z[i].Div(f.D[i])
+	}
+
+	x := make([]T, n)
+	for i := n - 1; i >= 0; i-- {
+		sum := z[i]
+		for k := i + 1; k < n; k++ {
+			sum = sum.Sub(f.L.Data[k][i].Mul(x[k]))
+		}
+		x[i] = sum
+	}
+
+	return vector.NewVector(x), nil
+}
+
+// Determinant вычисляет определитель как произведение элементов D
+func (f *LDLFactorization[T]) Determinant() T {
+	det := f.D[0].One()
+	for _, d := range f.D {
+		det = det.Mul(d)
+	}
+	return det
+}
@@ -0,0 +1,42 @@
+package matrix
+
+import (
+	"MatrixGo/internal/field"
+	"runtime"
+	"sync"
+)
+
+// parallelRows делит диапазон строк [start, end) между горутинами и ждет их завершения
+func parallelRows(start, end int, body func(from, to int)) {
+	var wg sync.WaitGroup
+	numCPU := runtime.NumCPU()
+	rowsPerGoroutine := (end - start) / numCPU
+	if rowsPerGoroutine < 1 {
+		rowsPerGoroutine = 1
+	}
+
+	for startRow := start; startRow < end; startRow += rowsPerGoroutine {
+		endRow := startRow + rowsPerGoroutine
+		if endRow > end {
+			endRow = end
+		}
+
+		wg.Add(1)
+		go func(from, to int) {
+			defer wg.Done()
+			body(from, to)
+		}(startRow, endRow)
+	}
+	wg.Wait()
+}
+
+// CholeskyParallel вычисляет разложение Холецкого, параллельно заполняя
+// поддиагональную часть каждого столбца L
+func CholeskyParallel[T field.Scalar[T]](m *Matrix[T]) (*CholeskyFactorization[T], error) {
+	return cholesky(m, parallelRows)
+}
+
+// LDLParallel вычисляет разложение A = L*D*L^T, параллельно заполняя столбцы L
+func LDLParallel[T field.Field[T]](m *Matrix[T], requirePositive bool) (*LDLFactorization[T], error) {
+	return ldl(m, requirePositive, parallelRows)
+}
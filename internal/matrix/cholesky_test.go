@@ -0,0 +1,128 @@
+package matrix
+
+import (
+	"MatrixGo/internal/field"
+	"MatrixGo/internal/vector"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCholesky(t *testing.T) {
+	mat, _ := FromSlice([][]field.Float64{
+		{4, 12, -16},
+		{12, 37, -43},
+		{-16, -43, 98},
+	})
+
+	for name, factorize := range map[string]func(*Matrix[field.Float64]) (*CholeskyFactorization[field.Float64], error){
+		"serial":   Cholesky[field.Float64],
+		"parallel": CholeskyParallel[field.Float64],
+	} {
+		t.Run(name, func(t *testing.T) {
+			chol, err := factorize(mat)
+			assert.NoError(t, err)
+
+			expected, _ := FromSlice([][]field.Float64{{2, 0, 0}, {6, 1, 0}, {-8, 5, 3}})
+			assertMatrixEqual(t, expected, chol.L)
+			assert.InDelta(t, 36, float64(chol.Determinant()), 1e-9)
+
+			b := vector.NewVector([]field.Float64{1, 2, 3})
+			x, err := chol.Solve(b)
+			assert.NoError(t, err)
+			expectedX, _ := SolveSystem(mat, b)
+			for i := range x.Data {
+				assert.InDelta(t, float64(expectedX.Data[i]), float64(x.Data[i]), 1e-9)
+			}
+		})
+	}
+}
+
+func TestCholeskyHermitian(t *testing.T) {
+	mat, _ := FromSlice([][]field.Complex{
+		{{Re: 2}, {Re: 0, Im: -1}},
+		{{Re: 0, Im: 1}, {Re: 2}},
+	})
+
+	chol, err := Cholesky(mat)
+	assert.NoError(t, err)
+
+	lh := chol.L.Transpose()
+	for i := range lh.Data {
+		for j := range lh.Data[i] {
+			lh.Data[i][j] = lh.Data[i][j].Conj()
+		}
+	}
+	prod, _ := chol.L.Mul(lh)
+	assertMatrixEqual(t, mat, prod)
+	assert.True(t, field.Complex{Re: 3}.Equal(chol.Determinant()))
+}
+
+func TestCholeskyErrors(t *testing.T) {
+	notPD, _ := FromSlice([][]field.Float64{{1, 2}, {2, 1}})
+	_, err := Cholesky(notPD)
+	assert.EqualError(t, err, "матрица не является положительно определенной")
+
+	notSymmetric, _ := FromSlice([][]field.Float64{{1, 2}, {0, 1}})
+	_, err = CholeskyParallel(notSymmetric)
+	assert.Error(t, err)
+}
+
+func TestLDL(t *testing.T) {
+	t.Run("rational exact", func(t *testing.T) {
+		mat := ratMatrix(t, [][]int64{{4, 2, 2}, {2, 5, 3}, {2, 3, 6}})
+
+		for _, f := range []func(*Matrix[field.Rational], bool) (*LDLFactorization[field.Rational], error){LDL[field.Rational], LDLParallel[field.Rational]} {
+			ldl, err := f(mat, true)
+			assert.NoError(t, err)
+
+			D := NewMatrix[field.Rational](3, 3, field.NewRational(0, 1))
+			for i, d := range ldl.D {
+				D.Data[i][i] = d
+			}
+			ld, _ := ldl.L.Mul(D)
+			prod, _ := ld.Mul(ldl.L.Transpose())
+			assertMatrixEqual(t, mat, prod)
+			assert.True(t, mat.Determinant().Equal(ldl.Determinant()))
+
+			b := ratVector(8, 10, 11)
+			x, err := ldl.Solve(b)
+			assert.NoError(t, err)
+			for i, want := range ratVector(1, 1, 1).Data {
+				assert.True(t, want.Equal(x.Data[i]))
+			}
+		}
+	})
+
+	t.Run("indefinite rational", func(t *testing.T) {
+		mat := ratMatrix(t, [][]int64{{1, 2}, {2, 1}})
+
+		ldl, err := LDL(mat, false)
+		assert.NoError(t, err)
+		assert.True(t, field.NewRational(-3, 1).Equal(ldl.D[1]))
+
+		_, err = LDL(mat, true)
+		assert.EqualError(t, err, "матрица не является положительно определенной")
+	})
+
+	t.Run("gf", func(t *testing.T) {
+		gf := func(v int64) field.GF {
+			x, _ := field.NewGF(v, 7)
+			return x
+		}
+		mat, _ := FromSlice([][]field.GF{{gf(2), gf(3)}, {gf(3), gf(4)}})
+
+		ldl, err := LDL(mat, false)
+		assert.NoError(t, err)
+		assert.True(t, mat.Determinant().Equal(ldl.Determinant()))
+
+		_, err = LDL(mat, true)
+		assert.Error(t, err)
+	})
+
+	t.Run("zero pivot", func(t *testing.T) {
+		mat := ratMatrix(t, [][]int64{{0, 1}, {1, 0}})
+		_, err := LDL(mat, false)
+		assert.Error(t, err)
+	})
+}
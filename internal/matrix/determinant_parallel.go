@@ -1,10 +1,25 @@
 package matrix
 
 import (
+	"MatrixGo/internal/field"
 	"runtime"
 	"sync"
 )
 
+// subtractScaledRow выполняет row -= factor * pivotRow над строками непрерывного хранилища
+func subtractScaledRow[T field.Field[T]](row, pivotRow []T, factor T) {
+	if r, ok := any(row).([]field.Float64); ok {
+		p, f := any(pivotRow).([]field.Float64)[:len(r)], any(factor).(field.Float64)
+		for k := range r {
+			r[k] -= f * p[k]
+		}
+		return
+	}
+	for k := range row {
+		row[k] = row[k].Sub(factor.Mul(pivotRow[k]))
+	}
+}
+
 func (m *Matrix[T]) DeterminantParallel() T {
 	if m.Rows != m.Cols {
 		return m.Data[0][0].Zero()
@@ -48,12 +63,12 @@ func (m *Matrix[T]) DeterminantParallel() T {
 			wg.Add(1)
 			go func(start, end int) {
 				defer wg.Done()
+				pivotRow := mat.Data[i]
 				for j := start; j < end; j++ {
-					if !mat.Data[j][i].Equal(mat.Data[j][i].Zero()) {
-						factor, _ := mat.Data[j][i].Div(mat.Data[i][i])
-						for k := i; k < n; k++ {
-							mat.Data[j][k] = mat.Data[j][k].Sub(factor.Mul(mat.Data[i][k]))
-						}
+					row := mat.Data[j]
+					if !row[i].Equal(row[i].Zero()) {
+						factor, _ := row[i].Div(pivotRow[i])
+						subtractScaledRow(row[i:], pivotRow[i:], factor)
 					}
 				}
 			}(startRow, endRow)
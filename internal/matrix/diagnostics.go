@@ -0,0 +1,205 @@
+package matrix
+
+import (
+	"MatrixGo/internal/field"
+	"MatrixGo/internal/vector"
+	"errors"
+	"fmt"
+	"math"
+)
+
+// DefaultRefinementSteps — число шагов итерационного уточнения по умолчанию
+const DefaultRefinementSteps = 10
+
+// SolveDiagnostics описывает качество найденного решения x системы A*x = b
+type SolveDiagnostics struct {
+	Residual          float64 // ||b - A*x||∞
+	RelativeResidual  float64 // ||b - A*x||∞ / ||b||∞
+	BackwardError     float64 // нормированная обратная ошибка ||r||∞ / (||A||∞*||x||∞ + ||b||∞)
+	Condition         float64 // оценка κ₁(A)
+	ForwardErrorBound float64 // приближенная оценка ||x - x*||/||x||: Condition*BackwardError
+	RefinementSteps   int     // число выполненных шагов итерационного уточнения
+}
+
+// InverseDiagnostics описывает качество найденной обратной матрицы X ≈ A⁻¹
+type InverseDiagnostics struct {
+	Residual         float64 // ||A*X - I||₁
+	RelativeResidual float64 // ||A*X - I||₁ / (||A||₁*||X||₁)
+	Condition        float64 // κ₁(A) = ||A||₁*||X||₁
+}
+
+// residual вычисляет r = b - A*x в арифметике поля
+func residual[T field.Field[T]](A *Matrix[T], b, x *vector.Vector[T]) []T {
+	r := make([]T, A.Rows)
+	for i := 0; i < A.Rows; i++ {
+		sum := b.Data[i]
+		for j := 0; j < A.Cols; j++ {
+			sum = sum.Sub(A.Data[i][j].Mul(x.Data[j]))
+		}
+		r[i] = sum
+	}
+	return r
+}
+
+// DiagnoseSolution вычисляет невязку, обратную ошибку и оценку обусловленности для решения x.
+// Малая обратная ошибка (порядка машинного эпсилон) означает, что x — точное решение близкой
+// системы; граница прямой ошибки дополнительно учитывает обусловленность A.
+func DiagnoseSolution[T field.Scalar[T]](A *Matrix[T], b, x *vector.Vector[T]) (*SolveDiagnostics, error) {
+	if A.Rows != A.Cols {
+		return nil, errors.New("матрица должна быть квадратной")
+	}
+	if b.Len() != A.Rows || x.Len() != A.Cols {
+		return nil, &DimensionError{Op: "DiagnoseSolution", Expected: sizeString(A.Rows, 1), Actual: sizeString(b.Len(), 1)}
+	}
+	lu, err := A.LU(true)
+	if err != nil {
+		return nil, err
+	}
+	return diagnose(A, lu, b, x)
+}
+
+// diagnose собирает диагностику по готовому разложению A
+func diagnose[T field.Scalar[T]](A *Matrix[T], lu *LUFactorization[T], b, x *vector.Vector[T]) (*SolveDiagnostics, error) {
+	r, _ := vectorNormInf(residual(A, b, x))
+	bNorm, _ := vectorNormInf(b.Data)
+	xNorm, _ := vectorNormInf(x.Data)
+	aNorm, _ := A.Norm(NormInf)
+
+	d := &SolveDiagnostics{Residual: r, Condition: math.Inf(1)}
+	if bNorm > 0 {
+		d.RelativeResidual = r / bNorm
+	}
+	if scale := aNorm*xNorm + bNorm; scale > 0 {
+		d.BackwardError = r / scale
+	}
+	if !lu.IsSingular() {
+		cond, err := conditionFromLU(A, lu)
+		if err != nil {
+			return nil, err
+		}
+		d.Condition = cond
+	}
+	d.ForwardErrorBound = d.Condition * d.BackwardError
+	return d, nil
+}
+
+// DiagnoseInverse вычисляет невязку A*X - I для найденной обратной матрицы X
+func DiagnoseInverse[T field.Scalar[T]](A, X *Matrix[T]) (*InverseDiagnostics, error) {
+	if A.Rows != A.Cols || X.Rows != A.Rows || X.Cols != A.Cols {
+		return nil, &DimensionError{Op: "DiagnoseInverse", Expected: sizeString(A.Rows, A.Rows), Actual: sizeString(X.Rows, X.Cols)}
+	}
+	AX, err := A.Mul(X)
+	if err != nil {
+		return nil, err
+	}
+	one := A.Data[0][0].One()
+	for i := 0; i < AX.Rows; i++ {
+		AX.Data[i][i] = AX.Data[i][i].Sub(one)
+	}
+	res := norm1(AX)
+	aNorm, xNorm := norm1(A), norm1(X)
+	d := &InverseDiagnostics{Residual: res, Condition: aNorm * xNorm}
+	if d.Condition > 0 {
+		d.RelativeResidual = res / d.Condition
+	}
+	return d, nil
+}
+
+// SolveRefined решает систему с матрицей float64 LU-разложением и уточняет решение
+// итерационно (не более maxSteps шагов, при maxSteps ≤ 0 — DefaultRefinementSteps).
+// Возвращает решение и его диагностику. Для вырожденной или численно вырожденной матрицы
+// (оценка κ₁(A) не меньше 1/ε, уточнение не сходится к решению) возвращается ошибка.
+func SolveRefined(A *Matrix[field.Float64], b *vector.Vector[field.Float64], maxSteps int) (*vector.Vector[field.Float64], *SolveDiagnostics, error) {
+	if A.Rows != A.Cols {
+		return nil, nil, errors.New("матрица должна быть квадратной")
+	}
+	lu, err := A.LU(false)
+	if err != nil {
+		return nil, nil, err
+	}
+	x, err := lu.Solve(b)
+	if err != nil {
+		return nil, nil, err
+	}
+	x, steps, err := RefineSolution(A, lu, b, x, maxSteps)
+	if err != nil {
+		return nil, nil, err
+	}
+	d, err := diagnose(A, lu, b, x)
+	if err != nil {
+		return nil, nil, err
+	}
+	if d.Condition*machineEpsilon >= 1 {
+		return nil, nil, fmt.Errorf("матрица численно вырождена: оценка числа обусловленности %g", d.Condition)
+	}
+	d.RefinementSteps = steps
+	return x, d, nil
+}
+
+// RefineSolution выполняет итерационное уточнение решения x системы A*x = b: невязка
+// r = b - A*x вычисляется с удвоенной точностью (арифметика double-double), поправка d
+// находится из A*d = r по готовому разложению lu, и x заменяется на x + d. Итерации
+// прекращаются, когда поправка становится пренебрежимо малой или перестает убывать вдвое
+// (критерий Уилкинсона). Если κ(A)*ε < 1, уточненное решение имеет относительную точность
+// порядка машинного эпсилон независимо от обусловленности. Возвращает решение и число шагов.
+func RefineSolution(A *Matrix[field.Float64], lu *LUFactorization[field.Float64], b, x *vector.Vector[field.Float64], maxSteps int) (*vector.Vector[field.Float64], int, error) {
+	if b.Len() != A.Rows || x.Len() != A.Cols {
+		return nil, 0, &DimensionError{Op: "RefineSolution", Expected: sizeString(A.Rows, 1), Actual: sizeString(b.Len(), 1)}
+	}
+	if maxSteps <= 0 {
+		maxSteps = DefaultRefinementSteps
+	}
+	const eps = 0x1p-53
+
+	cur := make([]field.Float64, x.Len())
+	copy(cur, x.Data)
+	prevNorm := math.Inf(1)
+	steps := 0
+	for steps < maxSteps {
+		r := extendedResidual(A, b.Data, cur)
+		d, err := lu.Solve(vector.NewVector(r))
+		if err != nil {
+			return nil, steps, err
+		}
+		dNorm, _ := vectorNormInf(d.Data)
+		if dNorm > prevNorm/2 {
+			// Поправка перестала убывать: дальнейшие шаги не улучшают решение
+			break
+		}
+		for i := range cur {
+			cur[i] += d.Data[i]
+		}
+		steps++
+		xNorm, _ := vectorNormInf(cur)
+		if dNorm <= eps*xNorm {
+			break
+		}
+		prevNorm = dNorm
+	}
+	return vector.NewVector(cur), steps, nil
+}
+
+// extendedResidual вычисляет b - A*x, накапливая суммы в виде пары hi + lo (double-double)
+// с точными произведениями через FMA, и округляет результат до float64
+func extendedResidual(A *Matrix[field.Float64], b, x []field.Float64) []field.Float64 {
+	r := make([]field.Float64, A.Rows)
+	for i := 0; i < A.Rows; i++ {
+		hi, lo := float64(b[i]), 0.0
+		for j, a := range A.Data[i] {
+			p := -float64(a) * float64(x[j])
+			pErr := math.FMA(-float64(a), float64(x[j]), -p)
+			s, sErr := twoSum(hi, p)
+			hi, lo = s, lo+sErr+pErr
+		}
+		r[i] = field.Float64(hi + lo)
+	}
+	return r
+}
+
+// twoSum возвращает s = fl(a + b) и точную ошибку округления e: a + b = s + e
+func twoSum(a, b float64) (s, e float64) {
+	s = a + b
+	bb := s - a
+	e = (a - (s - bb)) + (b - bb)
+	return s, e
+}
@@ -0,0 +1,378 @@
+package matrix
+
+import (
+	"MatrixGo/internal/field"
+	"errors"
+	"math"
+	"sort"
+)
+
+const (
+	// machineEpsilon — относительная точность float64
+	machineEpsilon = 2.220446049250313e-16
+	// qrMaxIterations ограничивает число QR-шагов на одно собственное значение
+	qrMaxIterations = 100
+	// inverseIterationSteps — число шагов обратной итерации для каждого вектора
+	inverseIterationSteps = 3
+	// eigenChopTolerance — относительный порог, ниже которого компоненты результата считаются нулевыми
+	eigenChopTolerance = 1e-12
+)
+
+// EigenDecomposition хранит собственные значения и собственные векторы квадратной матрицы.
+// Собственные значения вещественной матрицы могут быть комплексными, поэтому результат
+// всегда возвращается над Complex.
+type EigenDecomposition struct {
+	Values    []field.Complex        // собственные значения с учетом кратности
+	Vectors   *Matrix[field.Complex] // столбец j — собственный вектор единичной нормы для Values[j]
+	Hermitian bool                   // матрица эрмитова: значения вещественны, векторы ортонормированы
+}
+
+// Eigen вычисляет собственные значения и собственные векторы матрицы над Float64 или Complex.
+// Для симметричных и эрмитовых матриц используется метод Якоби (EigenSymmetric).
+// В общем случае матрица приводится к форме Хессенберга, собственные значения находятся
+// QR-алгоритмом со сдвигами Уилкинсона, а собственные векторы — обратной итерацией.
+// Для дефектных матриц векторы кратного собственного значения могут оказаться почти коллинеарными.
+func Eigen[T field.Scalar[T]](m *Matrix[T]) (*EigenDecomposition, error) {
+	if m.Rows != m.Cols {
+		return nil, errors.New("матрица должна быть квадратной")
+	}
+
+	if isHermitian(m) {
+		sym, err := EigenSymmetric(m)
+		if err != nil {
+			return nil, err
+		}
+		values := make([]field.Complex, len(sym.Values))
+		for i, v := range sym.Values {
+			values[i] = field.Complex{Re: v}
+		}
+		return &EigenDecomposition{
+			Values:    values,
+			Vectors:   toComplexMatrix(sym.Vectors),
+			Hermitian: true,
+		}, nil
+	}
+
+	A := toComplexMatrix(m)
+	values, err := eigenvaluesHessenbergQR(A)
+	if err != nil {
+		return nil, err
+	}
+
+	scale := math.Max(frobeniusNorm(A), 1)
+	for i := range values {
+		values[i] = chopComplex(values[i], eigenChopTolerance*scale)
+	}
+	sort.SliceStable(values, func(i, j int) bool {
+		if values[i].Re != values[j].Re {
+			return values[i].Re < values[j].Re
+		}
+		return values[i].Im < values[j].Im
+	})
+
+	n := A.Rows
+	vectors := NewMatrix[field.Complex](n, n, field.Complex{})
+	for j, lambda := range values {
+		// Векторы, уже найденные для того же (кратного) собственного значения
+		var cluster [][]field.Complex
+		for k := 0; k < j; k++ {
+			if values[k].Sub(lambda).Abs() <= 1e-8*scale {
+				cluster = append(cluster, columnOf(vectors, k))
+			}
+		}
+
+		x := inverseIteration(A, lambda, cluster, scale)
+		for i := 0; i < n; i++ {
+			vectors.Data[i][j] = x[i]
+		}
+		normalizeColumnPhase(vectors, j)
+		for i := 0; i < n; i++ {
+			vectors.Data[i][j] = chopComplex(vectors.Data[i][j], eigenChopTolerance)
+		}
+	}
+
+	return &EigenDecomposition{Values: values, Vectors: vectors}, nil
+}
+
+// eigenvaluesHessenbergQR находит собственные значения QR-алгоритмом со сдвигами
+// на верхней матрице Хессенберга, отделяя сошедшиеся значения снизу вверх
+func eigenvaluesHessenbergQR(A *Matrix[field.Complex]) ([]field.Complex, error) {
+	hess, err := Hessenberg(A)
+	if err != nil {
+		return nil, err
+	}
+	return hessenbergQR(hess.H, nil)
+}
+
+// hessenbergQR выполняет QR-итерации на матрице Хессенберга H на месте.
+// Если Z не nil, вращения применяются ко всей матрице и накапливаются в Z,
+// так что в конце H становится треугольной формой Шура.
+func hessenbergQR(H, Z *Matrix[field.Complex]) ([]field.Complex, error) {
+	n := H.Rows
+	norm := math.Max(frobeniusNorm(H), machineEpsilon)
+	values := make([]field.Complex, n)
+
+	hi, iter := n-1, 0
+	for hi >= 0 {
+		// Ищем снизу пренебрежимо малый поддиагональный элемент
+		l := hi
+		for ; l > 0; l-- {
+			s := H.Data[l-1][l-1].Abs() + H.Data[l][l].Abs()
+			if s == 0 {
+				s = norm
+			}
+			if H.Data[l][l-1].Abs() <= machineEpsilon*s {
+				H.Data[l][l-1] = field.Complex{}
+				break
+			}
+		}
+
+		if l == hi {
+			values[hi] = H.Data[hi][hi]
+			hi--
+			iter = 0
+			continue
+		}
+
+		iter++
+		if iter > qrMaxIterations {
+			return nil, errors.New("QR-алгоритм не сошелся")
+		}
+
+		shift := wilkinsonShift(H, hi)
+		if iter%10 == 0 {
+			// Исключительный сдвиг разрывает возможное зацикливание
+			shift = H.Data[hi][hi].Add(field.Complex{Re: H.Data[hi][hi-1].Abs()})
+		}
+		shiftedQRStep(H, l, hi, shift, Z)
+	}
+
+	return values, nil
+}
+
+// wilkinsonShift возвращает собственное значение нижнего блока 2×2, ближайшее к H[hi][hi]
+func wilkinsonShift(H *Matrix[field.Complex], hi int) field.Complex {
+	a, b := H.Data[hi-1][hi-1], H.Data[hi-1][hi]
+	c, d := H.Data[hi][hi-1], H.Data[hi][hi]
+
+	p := a.Sub(d).Scale(0.5)
+	bc := b.Mul(c)
+	disc := p.Mul(p).Add(bc).Sqrt()
+
+	// λ = d + p ± disc = d - bc/(p ∓ disc); берем знаменатель большего модуля
+	den := p.Add(disc)
+	if alt := p.Sub(disc); alt.Abs() > den.Abs() {
+		den = alt
+	}
+	if den.Abs() == 0 {
+		return d
+	}
+	corr, _ := bc.Div(den)
+	return d.Sub(corr)
+}
+
+// shiftedQRStep выполняет явный QR-шаг со сдвигом на активном блоке [lo, hi]:
+// H - μI = QR вращениями Гивенса, затем H = RQ + μI. Если Z не nil, вращения
+// применяются и вне блока (строки до конца, столбцы с начала) и накапливаются в Z.
+func shiftedQRStep(H *Matrix[field.Complex], lo, hi int, mu field.Complex, Z *Matrix[field.Complex]) {
+	first, last := lo, hi
+	if Z != nil {
+		first, last = 0, H.Cols-1
+	}
+	for i := lo; i <= hi; i++ {
+		H.Data[i][i] = H.Data[i][i].Sub(mu)
+	}
+
+	cs := make([]field.Complex, 0, hi-lo)
+	sn := make([]field.Complex, 0, hi-lo)
+	for k := lo; k < hi; k++ {
+		a, b := H.Data[k][k], H.Data[k+1][k]
+		r := math.Hypot(a.Abs(), b.Abs())
+		c, s := field.Complex{Re: 1}, field.Complex{}
+		if r != 0 {
+			c, s = a.Scale(1/r), b.Scale(1/r)
+		}
+		cs, sn = append(cs, c), append(sn, s)
+
+		for j := k; j <= last; j++ {
+			x, y := H.Data[k][j], H.Data[k+1][j]
+			H.Data[k][j] = c.Conj().Mul(x).Add(s.Conj().Mul(y))
+			H.Data[k+1][j] = c.Mul(y).Sub(s.Mul(x))
+		}
+	}
+
+	for k := lo; k < hi; k++ {
+		c, s := cs[k-lo], sn[k-lo]
+		for i := first; i <= min(k+2, hi); i++ {
+			x, y := H.Data[i][k], H.Data[i][k+1]
+			H.Data[i][k] = x.Mul(c).Add(y.Mul(s))
+			H.Data[i][k+1] = y.Mul(c.Conj()).Sub(x.Mul(s.Conj()))
+		}
+		if Z != nil {
+			for i := 0; i < Z.Rows; i++ {
+				x, y := Z.Data[i][k], Z.Data[i][k+1]
+				Z.Data[i][k] = x.Mul(c).Add(y.Mul(s))
+				Z.Data[i][k+1] = y.Mul(c.Conj()).Sub(x.Mul(s.Conj()))
+			}
+		}
+	}
+
+	for i := lo; i <= hi; i++ {
+		H.Data[i][i] = H.Data[i][i].Add(mu)
+	}
+}
+
+// inverseIteration находит собственный вектор для приближенного собственного значения lambda,
+// решая (A - lambda*I)*x_{k+1} = x_k. Векторы из cluster (найденные для того же значения)
+// исключаются из итерации, чтобы получить линейно независимые векторы кратного значения.
+func inverseIteration(A *Matrix[field.Complex], lambda field.Complex, cluster [][]field.Complex, scale float64) []field.Complex {
+	n := A.Rows
+	lu, perm := factorShifted(A, lambda, scale)
+
+	x := make([]field.Complex, n)
+	for i := range x {
+		x[i] = field.Complex{Re: 1 / float64(i+1+len(cluster))}
+	}
+
+	for step := 0; step < inverseIterationSteps; step++ {
+		x = solveShifted(lu, perm, x)
+		orthogonalizeAgainst(x, cluster)
+		if !normalizeVector(x) {
+			// Начальный вектор лежал в линейной оболочке cluster — берем базисный
+			for i := range x {
+				x[i] = field.Complex{}
+			}
+			x[len(cluster)%n] = field.Complex{Re: 1}
+		}
+	}
+	return x
+}
+
+// factorShifted строит LU-разложение A - lambda*I с выбором ведущего элемента по модулю.
+// Почти нулевые ведущие элементы заменяются на машинный ноль, как это принято
+// в обратной итерации: вырожденность матрицы здесь ожидаема.
+func factorShifted(A *Matrix[field.Complex], lambda field.Complex, scale float64) ([][]field.Complex, []int) {
+	n := A.Rows
+	lu := make([][]field.Complex, n)
+	perm := make([]int, n)
+	for i := 0; i < n; i++ {
+		lu[i] = append([]field.Complex(nil), A.Data[i]...)
+		lu[i][i] = lu[i][i].Sub(lambda)
+		perm[i] = i
+	}
+
+	tiny := machineEpsilon * scale
+	for k := 0; k < n; k++ {
+		p := k
+		for i := k + 1; i < n; i++ {
+			if lu[i][k].Abs() > lu[p][k].Abs() {
+				p = i
+			}
+		}
+		lu[k], lu[p] = lu[p], lu[k]
+		perm[k], perm[p] = perm[p], perm[k]
+
+		if lu[k][k].Abs() < tiny {
+			lu[k][k] = field.Complex{Re: tiny}
+		}
+		for i := k + 1; i < n; i++ {
+			factor, _ := lu[i][k].Div(lu[k][k])
+			lu[i][k] = factor
+			for j := k + 1; j < n; j++ {
+				lu[i][j] = lu[i][j].Sub(factor.Mul(lu[k][j]))
+			}
+		}
+	}
+	return lu, perm
+}
+
+// solveShifted решает систему по разложению из factorShifted
+func solveShifted(lu [][]field.Complex, perm []int, b []field.Complex) []field.Complex {
+	n := len(lu)
+	x := make([]field.Complex, n)
+	for i := 0; i < n; i++ {
+		sum := b[perm[i]]
+		for j := 0; j < i; j++ {
+			sum = sum.Sub(lu[i][j].Mul(x[j]))
+		}
+		x[i] = sum
+	}
+	for i := n - 1; i >= 0; i-- {
+		sum := x[i]
+		for j := i + 1; j < n; j++ {
+			sum = sum.Sub(lu[i][j].Mul(x[j]))
+		}
+		x[i], _ = sum.Div(lu[i][i])
+	}
+	return x
+}
+
+// orthogonalizeAgainst вычитает из x проекции на ортонормированные (в пределах точности) векторы
+func orthogonalizeAgainst(x []field.Complex, basis [][]field.Complex) {
+	for _, q := range basis {
+		dot := field.Complex{}
+		for i := range x {
+			dot = dot.Add(q[i].Conj().Mul(x[i]))
+		}
+		for i := range x {
+			x[i] = x[i].Sub(dot.Mul(q[i]))
+		}
+	}
+}
+
+// normalizeVector нормирует x к единичной длине; возвращает false для нулевого вектора
+func normalizeVector(x []field.Complex) bool {
+	norm := 0.0
+	for _, v := range x {
+		norm = math.Hypot(norm, v.Abs())
+	}
+	if norm == 0 || math.IsNaN(norm) || math.IsInf(norm, 0) {
+		return false
+	}
+	for i := range x {
+		x[i] = x[i].Scale(1 / norm)
+	}
+	return true
+}
+
+// toComplexMatrix переводит матрицу над Float64 или Complex в матрицу над Complex
+func toComplexMatrix[T field.Scalar[T]](m *Matrix[T]) *Matrix[field.Complex] {
+	res := NewMatrix[field.Complex](m.Rows, m.Cols, field.Complex{})
+	for i := 0; i < m.Rows; i++ {
+		for j := 0; j < m.Cols; j++ {
+			res.Data[i][j] = field.Complex{Re: m.Data[i][j].Real(), Im: m.Data[i][j].Imag()}
+		}
+	}
+	return res
+}
+
+// frobeniusNorm вычисляет норму Фробениуса матрицы
+func frobeniusNorm[T field.Scalar[T]](m *Matrix[T]) float64 {
+	norm := 0.0
+	for i := 0; i < m.Rows; i++ {
+		for j := 0; j < m.Cols; j++ {
+			norm = math.Hypot(norm, m.Data[i][j].Abs())
+		}
+	}
+	return norm
+}
+
+// columnOf возвращает копию столбца j
+func columnOf[T field.Field[T]](m *Matrix[T], j int) []T {
+	col := make([]T, m.Rows)
+	for i := range col {
+		col[i] = m.Data[i][j]
+	}
+	return col
+}
+
+// chopComplex обнуляет действительную и мнимую части, меньшие tol по модулю
+func chopComplex(z field.Complex, tol float64) field.Complex {
+	if math.Abs(z.Re) < tol {
+		z.Re = 0
+	}
+	if math.Abs(z.Im) < tol {
+		z.Im = 0
+	}
+	return z
+}
@@ -0,0 +1,174 @@
+package matrix
+
+import (
+	"MatrixGo/internal/field"
+	"errors"
+	"math"
+	"sort"
+)
+
+// jacobiMaxSweeps ограничивает число полных проходов метода Якоби
+const jacobiMaxSweeps = 100
+
+// SymmetricEigenDecomposition хранит спектральное разложение A = V*diag(Values)*V^H
+// вещественной симметричной или комплексной эрмитовой матрицы
+type SymmetricEigenDecomposition[T field.Scalar[T]] struct {
+	Values  []float64  // вещественные собственные значения по возрастанию
+	Vectors *Matrix[T] // ортонормированные собственные векторы по столбцам
+}
+
+// isHermitian проверяет, что A = A^H (для Float64 — обычная симметричность)
+func isHermitian[T field.Scalar[T]](m *Matrix[T]) bool {
+	if m.Rows != m.Cols {
+		return false
+	}
+	for i := 0; i < m.Rows; i++ {
+		for j := 0; j <= i; j++ {
+			if !m.Data[i][j].Equal(m.Data[j][i].Conj()) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// EigenSymmetric вычисляет собственные значения и ортонормированные собственные векторы
+// симметричной (эрмитовой) матрицы циклическим методом Якоби. Собственные значения
+// такой матрицы вещественны, а метод Якоби находит их с высокой относительной точностью.
+func EigenSymmetric[T field.Scalar[T]](m *Matrix[T]) (*SymmetricEigenDecomposition[T], error) {
+	if m.Rows != m.Cols {
+		return nil, errors.New("матрица должна быть квадратной")
+	}
+	if !isHermitian(m) {
+		return nil, errors.New("матрица не является симметричной (эрмитовой)")
+	}
+
+	n := m.Rows
+	zero := m.Data[0][0].Zero()
+	A := m.Clone()
+	V := IdentityMatrix[T](n, zero, zero.One())
+
+	total := 0.0
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			a := A.Data[i][j].Abs()
+			total += a * a
+		}
+	}
+
+	converged := false
+	for sweep := 0; sweep < jacobiMaxSweeps; sweep++ {
+		off := 0.0
+		for i := 0; i < n; i++ {
+			for j := 0; j < n; j++ {
+				if i != j {
+					a := A.Data[i][j].Abs()
+					off += a * a
+				}
+			}
+		}
+		if off <= machineEpsilon*machineEpsilon*total {
+			converged = true
+			break
+		}
+
+		for p := 0; p < n-1; p++ {
+			for q := p + 1; q < n; q++ {
+				jacobiRotate(A, V, p, q)
+			}
+		}
+	}
+	if !converged {
+		return nil, errors.New("метод Якоби не сошелся")
+	}
+
+	values := make([]float64, n)
+	order := make([]int, n)
+	for i := range values {
+		values[i] = A.Data[i][i].Real()
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool { return values[order[a]] < values[order[b]] })
+
+	res := &SymmetricEigenDecomposition[T]{
+		Values:  make([]float64, n),
+		Vectors: NewMatrix[T](n, n, zero),
+	}
+	for j, k := range order {
+		res.Values[j] = values[k]
+		for i := 0; i < n; i++ {
+			res.Vectors.Data[i][j] = V.Data[i][k]
+		}
+		normalizeColumnPhase(res.Vectors, j)
+	}
+	return res, nil
+}
+
+// jacobiRotate обнуляет элементы (p, q) и (q, p). Сначала фазовый множитель делает
+// a_pq вещественным, затем применяется вещественное вращение Якоби A = J^H*A*J.
+func jacobiRotate[T field.Scalar[T]](A, V *Matrix[T], p, q int) {
+	apq := A.Data[p][q]
+	if apq.Abs() == 0 {
+		return
+	}
+	n := A.Rows
+
+	// D = diag(1, ..., conj(phase) на позиции q, ...): A = D^H*A*D, V = V*D
+	phase := apq.Scale(1 / apq.Abs())
+	conjPhase := phase.Conj()
+	for k := 0; k < n; k++ {
+		A.Data[q][k] = A.Data[q][k].Mul(phase)
+	}
+	for k := 0; k < n; k++ {
+		A.Data[k][q] = A.Data[k][q].Mul(conjPhase)
+		V.Data[k][q] = V.Data[k][q].Mul(conjPhase)
+	}
+
+	app, aqq, r := A.Data[p][p].Real(), A.Data[q][q].Real(), apq.Abs()
+	theta := (aqq - app) / (2 * r)
+	t := 1 / (math.Abs(theta) + math.Sqrt(theta*theta+1))
+	if theta < 0 {
+		t = -t
+	}
+	c := 1 / math.Sqrt(t*t+1)
+	s := t * c
+
+	for k := 0; k < n; k++ {
+		akp, akq := A.Data[k][p], A.Data[k][q]
+		A.Data[k][p] = akp.Scale(c).Sub(akq.Scale(s))
+		A.Data[k][q] = akp.Scale(s).Add(akq.Scale(c))
+
+		vkp, vkq := V.Data[k][p], V.Data[k][q]
+		V.Data[k][p] = vkp.Scale(c).Sub(vkq.Scale(s))
+		V.Data[k][q] = vkp.Scale(s).Add(vkq.Scale(c))
+	}
+	for k := 0; k < n; k++ {
+		apk, aqk := A.Data[p][k], A.Data[q][k]
+		A.Data[p][k] = apk.Scale(c).Sub(aqk.Scale(s))
+		A.Data[q][k] = apk.Scale(s).Add(aqk.Scale(c))
+	}
+
+	zero := apq.Zero()
+	A.Data[p][q], A.Data[q][p] = zero, zero
+	A.Data[p][p] = zero.FromFloat64(A.Data[p][p].Real())
+	A.Data[q][q] = zero.FromFloat64(A.Data[q][q].Real())
+}
+
+// normalizeColumnPhase умножает столбец j на фазовый множитель так, чтобы его
+// наибольшая по модулю компонента стала вещественной и положительной
+func normalizeColumnPhase[T field.Scalar[T]](m *Matrix[T], j int) {
+	best := 0
+	for i := 1; i < m.Rows; i++ {
+		if m.Data[i][j].Abs() > m.Data[best][j].Abs()+machineEpsilon {
+			best = i
+		}
+	}
+	pivot := m.Data[best][j]
+	if pivot.Abs() == 0 {
+		return
+	}
+	phase := pivot.Conj().Scale(1 / pivot.Abs())
+	for i := 0; i < m.Rows; i++ {
+		m.Data[i][j] = m.Data[i][j].Mul(phase)
+	}
+}
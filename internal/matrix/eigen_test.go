@@ -0,0 +1,160 @@
+package matrix
+
+import (
+	"MatrixGo/internal/field"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// assertEigenPairs проверяет A*v = λ*v для каждой пары и единичную норму векторов
+func assertEigenPairs(t *testing.T, A *Matrix[field.Complex], dec *EigenDecomposition) {
+	t.Helper()
+	n := A.Rows
+	assert.Len(t, dec.Values, n)
+	for j, lambda := range dec.Values {
+		v := columnOf(dec.Vectors, j)
+		norm := 0.0
+		for i := 0; i < n; i++ {
+			norm += v[i].Abs() * v[i].Abs()
+			av := field.Complex{}
+			for k := 0; k < n; k++ {
+				av = av.Add(A.Data[i][k].Mul(v[k]))
+			}
+			assert.InDelta(t, 0, av.Sub(lambda.Mul(v[i])).Abs(), 1e-8, "пара %d, строка %d", j, i)
+		}
+		assert.InDelta(t, 1, norm, 1e-9)
+	}
+}
+
+func TestHessenberg(t *testing.T) {
+	mat, _ := FromSlice([][]field.Float64{
+		{4, 1, -2, 2},
+		{1, 2, 0, 1},
+		{-2, 0, 3, -2},
+		{2, 1, -2, -1},
+	})
+
+	hess, err := Hessenberg(mat)
+	assert.NoError(t, err)
+	for i := 2; i < 4; i++ {
+		for j := 0; j < i-1; j++ {
+			assert.InDelta(t, 0, float64(hess.H.Data[i][j]), 1e-12)
+		}
+	}
+
+	qh, _ := hess.Q.Mul(hess.H)
+	back, _ := qh.Mul(hess.Q.Transpose())
+	assertMatrixEqual(t, mat, back)
+}
+
+func TestEigenSymmetric(t *testing.T) {
+	mat, _ := FromSlice([][]field.Float64{
+		{2, -1, 0},
+		{-1, 2, -1},
+		{0, -1, 2},
+	})
+
+	dec, err := EigenSymmetric(mat)
+	assert.NoError(t, err)
+	assert.InDeltaSlice(t, []float64{2 - 1.4142135623730951, 2, 2 + 1.4142135623730951}, dec.Values, 1e-12)
+
+	// V^T*V = I
+	vtv, _ := dec.Vectors.Transpose().Mul(dec.Vectors)
+	assertMatrixEqual(t, IdentityMatrix[field.Float64](3, 0, 1), vtv)
+
+	notSymmetric, _ := FromSlice([][]field.Float64{{1, 2}, {0, 1}})
+	_, err = EigenSymmetric(notSymmetric)
+	assert.Error(t, err)
+}
+
+func TestEigenHermitian(t *testing.T) {
+	mat, _ := FromSlice([][]field.Complex{
+		{{Re: 2}, {Im: -1}},
+		{{Im: 1}, {Re: 2}},
+	})
+
+	dec, err := Eigen(mat)
+	assert.NoError(t, err)
+	assert.True(t, dec.Hermitian)
+	assert.True(t, field.Complex{Re: 1}.Equal(dec.Values[0]))
+	assert.True(t, field.Complex{Re: 3}.Equal(dec.Values[1]))
+	assertEigenPairs(t, mat, dec)
+}
+
+func TestEigenGeneral(t *testing.T) {
+	t.Run("rotation has complex eigenvalues", func(t *testing.T) {
+		mat, _ := FromSlice([][]field.Float64{{0, -1}, {1, 0}})
+
+		dec, err := Eigen(mat)
+		assert.NoError(t, err)
+		assert.False(t, dec.Hermitian)
+		assert.True(t, field.Complex{Im: -1}.Equal(dec.Values[0]))
+		assert.True(t, field.Complex{Im: 1}.Equal(dec.Values[1]))
+		assertEigenPairs(t, toComplexMatrix(mat), dec)
+	})
+
+	t.Run("real nonsymmetric", func(t *testing.T) {
+		mat, _ := FromSlice([][]field.Float64{
+			{4, 1, 2},
+			{0, 3, 1},
+			{1, 0, 2},
+		})
+
+		dec, err := Eigen(mat)
+		assert.NoError(t, err)
+		assertEigenPairs(t, toComplexMatrix(mat), dec)
+
+		sum, prod := field.Complex{}, field.Complex{Re: 1}
+		for _, v := range dec.Values {
+			sum, prod = sum.Add(v), prod.Mul(v)
+		}
+		assert.InDelta(t, float64(mat.Trace()), sum.Re, 1e-9)
+		assert.InDelta(t, float64(mat.Determinant()), prod.Re, 1e-9)
+	})
+
+	t.Run("complex", func(t *testing.T) {
+		mat, _ := FromSlice([][]field.Complex{
+			{{Re: 1, Im: 1}, {Re: 2}, {Im: -1}},
+			{{Re: 0}, {Re: 3, Im: -2}, {Re: 1}},
+			{{Re: 1}, {Im: 2}, {Re: -1}},
+		})
+
+		dec, err := Eigen(mat)
+		assert.NoError(t, err)
+		assertEigenPairs(t, mat, dec)
+	})
+
+	t.Run("repeated eigenvalue", func(t *testing.T) {
+		mat, _ := FromSlice([][]field.Float64{
+			{2, 0, 0},
+			{0, 2, 0},
+			{1, 0, 3},
+		})
+
+		dec, err := Eigen(mat)
+		assert.NoError(t, err)
+		assertEigenPairs(t, toComplexMatrix(mat), dec)
+		assert.Equal(t, 3, dec.Vectors.Rank())
+	})
+
+	t.Run("larger real matrix", func(t *testing.T) {
+		mat := NewMatrix[field.Float64](8, 8, 0)
+		seed := 7
+		for i := range mat.Data {
+			for j := range mat.Data[i] {
+				seed = (seed*1103515245 + 12345) % 2147483648
+				mat.Data[i][j] = field.Float64(seed%19 - 9)
+			}
+		}
+
+		dec, err := Eigen(mat)
+		assert.NoError(t, err)
+		assertEigenPairs(t, toComplexMatrix(mat), dec)
+	})
+
+	t.Run("not square", func(t *testing.T) {
+		_, err := Eigen(NewMatrix[field.Float64](2, 3, 0))
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,55 @@
+package matrix
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Базовые ошибки операций с матрицами; конкретные ошибки оборачивают их,
+// поэтому их можно проверять через errors.Is
+var (
+	ErrDimensionMismatch = errors.New("несогласованные размеры матриц")
+	ErrIndexOutOfRange   = errors.New("индекс выходит за пределы матрицы")
+	ErrEmptyMatrix       = errors.New("пустая матрица")
+	ErrZeroScalar        = errors.New("множитель должен быть ненулевым")
+	ErrSameRow           = errors.New("строки должны различаться")
+	ErrNotConverged      = errors.New("итерационный метод не сошелся")
+	ErrNormUndefined     = errors.New("норма не определена для элементов конечного поля")
+	ErrPrimesExhausted   = errors.New("закончились простые модули")
+)
+
+// DimensionError описывает несогласованные размеры операндов операции
+type DimensionError struct {
+	Op       string // название операции
+	Expected string // требуемый размер
+	Actual   string // фактический размер
+}
+
+func (e *DimensionError) Error() string {
+	return fmt.Sprintf("%s: %v: ожидается %s, получено %s", e.Op, ErrDimensionMismatch, e.Expected, e.Actual)
+}
+
+func (e *DimensionError) Unwrap() error {
+	return ErrDimensionMismatch
+}
+
+// IndexError описывает обращение к несуществующей строке или столбцу
+type IndexError struct {
+	Op    string // название операции
+	Axis  string // "строка" или "столбец"
+	Index int    // запрошенный индекс
+	Limit int    // допустимые индексы лежат в [0, Limit)
+}
+
+func (e *IndexError) Error() string {
+	return fmt.Sprintf("%s: %v: %s %d вне диапазона [0, %d)", e.Op, ErrIndexOutOfRange, e.Axis, e.Index, e.Limit)
+}
+
+func (e *IndexError) Unwrap() error {
+	return ErrIndexOutOfRange
+}
+
+// sizeString форматирует размер матрицы
+func sizeString(rows, cols int) string {
+	return fmt.Sprintf("%d×%d", rows, cols)
+}
@@ -0,0 +1,307 @@
+package matrix
+
+import (
+	"MatrixGo/internal/field"
+	"MatrixGo/internal/polynomial"
+	"MatrixGo/internal/vector"
+	"errors"
+)
+
+// FrobeniusDecomposition хранит рациональную каноническую (фробениусову) форму P^(-1)*A*P = F
+type FrobeniusDecomposition[T field.Field[T]] struct {
+	F                *Matrix[T]                  // блочно-диагональная матрица из сопровождающих матриц
+	P                *Matrix[T]                  // матрица перехода
+	InvariantFactors []*polynomial.Polynomial[T] // нетривиальные инвариантные множители d_1 | d_2 | ... | d_k
+}
+
+// CompanionMatrix строит сопровождающую матрицу нормированного многочлена
+// p = x^d + c_(d-1)*x^(d-1) + ... + c_0: единицы под диагональю, последний столбец -c_0, ..., -c_(d-1)
+func CompanionMatrix[T field.Field[T]](p *polynomial.Polynomial[T]) *Matrix[T] {
+	d := p.Degree()
+	zero := p.Leading().Zero()
+	C := NewMatrix[T](d, d, zero)
+	for i := 1; i < d; i++ {
+		C.Data[i][i-1] = zero.One()
+	}
+	for i := 0; i < d; i++ {
+		C.Data[i][d-1] = p.Coefficient(i).Neg()
+	}
+	return C
+}
+
+// FrobeniusForm вычисляет фробениусову форму и матрицу перехода над любым полем.
+// На каждом шаге находится вектор v, минимальный многочлен которого совпадает с минимальным
+// многочленом оператора; его подпространство Крылова дает очередной блок, а инвариантное
+// дополнение строится как общее ядро функционалов f, f*A, ..., f*A^(d-1), где f отделяет
+// последний вектор Крылова. Далее процесс повторяется для ограничения оператора на дополнение.
+func FrobeniusForm[T field.Field[T]](m *Matrix[T]) (*FrobeniusDecomposition[T], error) {
+	if m.Rows != m.Cols {
+		return nil, errors.New("матрица должна быть квадратной")
+	}
+
+	n := m.Rows
+	zero := m.Data[0][0].Zero()
+
+	type cyclicBlock struct {
+		poly *polynomial.Polynomial[T]
+		cols [][]T // векторы Крылова в исходных координатах
+	}
+	var blocks []cyclicBlock
+
+	// B — ограничение оператора на текущее инвариантное подпространство в базисе столбцов Q
+	B := m.Clone()
+	Q := IdentityMatrix[T](n, zero, zero.One())
+	for {
+		k := B.Rows
+		v, mu, err := maximalVector(B)
+		if err != nil {
+			return nil, err
+		}
+		d := mu.Degree()
+
+		krylov := make([][]T, d)
+		krylov[0] = v
+		for i := 1; i < d; i++ {
+			krylov[i] = matVec(B, krylov[i-1])
+		}
+		cols := make([][]T, d)
+		for i := range krylov {
+			cols[i] = matVec(Q, krylov[i])
+		}
+		blocks = append(blocks, cyclicBlock{poly: mu, cols: cols})
+		if d == k {
+			break
+		}
+
+		// Функционал f: f(B^i*v) = 0 при i < d-1 и f(B^(d-1)*v) = 1
+		KT := NewMatrix[T](d, k, zero)
+		for i := range krylov {
+			copy(KT.Data[i], krylov[i])
+		}
+		rhs := make([]T, d)
+		for i := range rhs {
+			rhs[i] = zero
+		}
+		rhs[d-1] = zero.One()
+		sol, err := SolveGeneral(KT, vector.NewVector(rhs))
+		if err != nil {
+			return nil, err
+		}
+		if sol.Kind == Inconsistent {
+			return nil, errors.New("векторы Крылова линейно зависимы")
+		}
+
+		// Инвариантное дополнение: ядро строк f, f*B, ..., f*B^(d-1)
+		Fm := NewMatrix[T](d, k, zero)
+		row := append([]T(nil), sol.Particular.Data...)
+		for i := 0; i < d; i++ {
+			copy(Fm.Data[i], row)
+			row = vecMat(row, B)
+		}
+		rref := Fm.RREF(false)
+		basis, free := kernelFromRREF(rref.Reduced, rref.Pivots, k)
+
+		// Координаты вектора ядра — его компоненты в свободных переменных
+		newB := NewMatrix[T](len(basis), len(basis), zero)
+		U := NewMatrix[T](k, len(basis), zero)
+		for j, b := range basis {
+			y := matVec(B, b.Data)
+			for i, f := range free {
+				newB.Data[i][j] = y[f]
+			}
+			for i := 0; i < k; i++ {
+				U.Data[i][j] = b.Data[i]
+			}
+		}
+		Q, _ = Q.Mul(U)
+		B = newB
+	}
+
+	// Блоки найдены в порядке убывания: d_k, d_(k-1), ...; разворачиваем для d_1 | d_2 | ...
+	res := &FrobeniusDecomposition[T]{
+		F: NewMatrix[T](n, n, zero),
+		P: NewMatrix[T](n, n, zero),
+	}
+	offset := 0
+	for b := len(blocks) - 1; b >= 0; b-- {
+		block := blocks[b]
+		res.InvariantFactors = append(res.InvariantFactors, block.poly)
+		C := CompanionMatrix(block.poly)
+		for i := range block.cols {
+			for r := 0; r < n; r++ {
+				res.P.Data[r][offset+i] = block.cols[i][r]
+			}
+			for j := range block.cols {
+				res.F.Data[offset+i][offset+j] = C.Data[i][j]
+			}
+		}
+		offset += len(block.cols)
+	}
+	return res, nil
+}
+
+// maximalVector находит вектор, минимальный многочлен которого равен минимальному многочлену B.
+// Векторы с минимальными многочленами μ_u и μ_w объединяются в вектор с многочленом НОК(μ_u, μ_w).
+func maximalVector[T field.Field[T]](B *Matrix[T]) ([]T, *polynomial.Polynomial[T], error) {
+	k := B.Rows
+	zero := B.Data[0][0].Zero()
+	basisVector := func(i int) []T {
+		e := make([]T, k)
+		for j := range e {
+			e[j] = zero
+		}
+		e[i] = zero.One()
+		return e
+	}
+
+	v := basisVector(0)
+	muV, err := krylovMinimalPolynomial(B, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for i := 1; i < k && muV.Degree() < k; i++ {
+		w := basisVector(i)
+		muW, err := krylovMinimalPolynomial(B, w)
+		if err != nil {
+			return nil, nil, err
+		}
+		if _, rem, _ := muV.DivMod(muW); rem.IsZero() {
+			continue
+		}
+
+		a, b, err := coprimeSplit(muV, muW)
+		if err != nil {
+			return nil, nil, err
+		}
+		// (μ_v/a)(B)*v имеет минимальный многочлен a, (μ_w/b)(B)*w — многочлен b
+		qa, _, _ := muV.DivMod(a)
+		qb, _, _ := muW.DivMod(b)
+		u := applyPolynomial(qa, B, v)
+		x := applyPolynomial(qb, B, w)
+		for j := range u {
+			u[j] = u[j].Add(x[j])
+		}
+		v = u
+		if muV, err = a.Mul(b).Monic(); err != nil {
+			return nil, nil, err
+		}
+	}
+	return v, muV, nil
+}
+
+// coprimeSplit раскладывает НОК(p, q) = a*b так, что a | p, b | q и НОД(a, b) = 1
+func coprimeSplit[T field.Field[T]](p, q *polynomial.Polynomial[T]) (a, b *polynomial.Polynomial[T], err error) {
+	g, err := polynomial.GCD(p, q)
+	if err != nil {
+		return nil, nil, err
+	}
+	a = p
+	if b, _, err = q.DivMod(g); err != nil {
+		return nil, nil, err
+	}
+	for {
+		h, err := polynomial.GCD(a, b)
+		if err != nil {
+			return nil, nil, err
+		}
+		if h.Degree() == 0 {
+			break
+		}
+		a, _, _ = a.DivMod(h)
+		b = b.Mul(h)
+	}
+	if a, err = a.Monic(); err != nil {
+		return nil, nil, err
+	}
+	if b, err = b.Monic(); err != nil {
+		return nil, nil, err
+	}
+	return a, b, nil
+}
+
+// applyPolynomial вычисляет p(B)*v по схеме Горнера
+func applyPolynomial[T field.Field[T]](p *polynomial.Polynomial[T], B *Matrix[T], v []T) []T {
+	zero := B.Data[0][0].Zero()
+	res := make([]T, len(v))
+	for i := range res {
+		res[i] = zero
+	}
+	for k := p.Degree(); k >= 0; k-- {
+		res = matVec(B, res)
+		for i := range res {
+			res[i] = res[i].Add(p.Coeffs[k].Mul(v[i]))
+		}
+	}
+	return res
+}
+
+// matVec вычисляет произведение матрицы на вектор-столбец
+func matVec[T field.Field[T]](m *Matrix[T], v []T) []T {
+	res := make([]T, m.Rows)
+	for i := 0; i < m.Rows; i++ {
+		sum := m.Data[i][0].Zero()
+		for j := 0; j < m.Cols; j++ {
+			sum = sum.Add(m.Data[i][j].Mul(v[j]))
+		}
+		res[i] = sum
+	}
+	return res
+}
+
+// vecMat вычисляет произведение вектора-строки на матрицу
+func vecMat[T field.Field[T]](v []T, m *Matrix[T]) []T {
+	res := make([]T, m.Cols)
+	for j := 0; j < m.Cols; j++ {
+		sum := m.Data[0][j].Zero()
+		for i := 0; i < m.Rows; i++ {
+			sum = sum.Add(v[i].Mul(m.Data[i][j]))
+		}
+		res[j] = sum
+	}
+	return res
+}
+
+// Similar проверяет подобие матриц над их полем: матрицы подобны тогда и только тогда,
+// когда совпадают их инвариантные множители (фробениусовы формы). Для подобных матриц
+// возвращается S, такая что S^(-1)*A*S = B.
+func Similar[T field.Field[T]](a, b *Matrix[T]) (bool, *Matrix[T], error) {
+	if a.Rows != a.Cols || b.Rows != b.Cols {
+		return false, nil, errors.New("матрицы должны быть квадратными")
+	}
+	if a.Rows != b.Rows {
+		return false, nil, nil
+	}
+
+	fa, err := FrobeniusForm(a)
+	if err != nil {
+		return false, nil, err
+	}
+	fb, err := FrobeniusForm(b)
+	if err != nil {
+		return false, nil, err
+	}
+	if len(fa.InvariantFactors) != len(fb.InvariantFactors) {
+		return false, nil, nil
+	}
+	for i := range fa.InvariantFactors {
+		if !fa.InvariantFactors[i].Equal(fb.InvariantFactors[i]) {
+			return false, nil, nil
+		}
+	}
+
+	// P_A^(-1)*A*P_A = F = P_B^(-1)*B*P_B  =>  S = P_A*P_B^(-1)
+	lu, err := fb.P.LU(false)
+	if err != nil {
+		return false, nil, err
+	}
+	pbInv, err := lu.Inverse()
+	if err != nil {
+		return false, nil, err
+	}
+	S, err := fa.P.Mul(pbInv)
+	if err != nil {
+		return false, nil, err
+	}
+	return true, S, nil
+}
@@ -0,0 +1,142 @@
+package matrix
+
+import (
+	"MatrixGo/internal/field"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// assertSimilarity проверяет A*P = P*X и невырожденность P, т.е. P^(-1)*A*P = X
+func assertSimilarity[T field.Field[T]](t *testing.T, a, p, x *Matrix[T]) {
+	t.Helper()
+	ap, err := a.Mul(p)
+	assert.NoError(t, err)
+	px, err := p.Mul(x)
+	assert.NoError(t, err)
+	assertMatrixEqual(t, ap, px)
+	assert.False(t, p.Determinant().Equal(p.Data[0][0].Zero()), "матрица перехода вырождена")
+}
+
+func TestCompanionMatrix(t *testing.T) {
+	mat := ratMatrix(t, [][]int64{{1, 2}, {3, 4}})
+	chi, err := CharacteristicPolynomial(mat)
+	assert.NoError(t, err)
+
+	C := CompanionMatrix(chi)
+	expected := ratMatrix(t, [][]int64{{0, 2}, {1, 5}})
+	assertMatrixEqual(t, expected, C)
+
+	chiC, err := CharacteristicPolynomial(C)
+	assert.NoError(t, err)
+	assert.True(t, chi.Equal(chiC))
+}
+
+func TestFrobeniusForm(t *testing.T) {
+	t.Run("cyclic matrix", func(t *testing.T) {
+		mat := ratMatrix(t, [][]int64{
+			{2, 1, 0},
+			{1, 3, 1},
+			{0, 1, 4},
+		})
+
+		res, err := FrobeniusForm(mat)
+		assert.NoError(t, err)
+		assert.Len(t, res.InvariantFactors, 1)
+		assert.Equal(t, "x^3 - 9x^2 + 24x - 18", res.InvariantFactors[0].String())
+		assertSimilarity(t, mat, res.P, res.F)
+	})
+
+	t.Run("several invariant factors", func(t *testing.T) {
+		// diag(2, 2, 3): инвариантные множители x - 2 и (x - 2)(x - 3)
+		mat := ratMatrix(t, [][]int64{
+			{2, 0, 0},
+			{0, 2, 0},
+			{0, 0, 3},
+		})
+
+		res, err := FrobeniusForm(mat)
+		assert.NoError(t, err)
+		assert.Len(t, res.InvariantFactors, 2)
+		assert.Equal(t, "x - 2", res.InvariantFactors[0].String())
+		assert.Equal(t, "x^2 - 5x + 6", res.InvariantFactors[1].String())
+		assertSimilarity(t, mat, res.P, res.F)
+	})
+
+	t.Run("scalar matrix", func(t *testing.T) {
+		mat := ratMatrix(t, [][]int64{{5, 0}, {0, 5}})
+
+		res, err := FrobeniusForm(mat)
+		assert.NoError(t, err)
+		assert.Len(t, res.InvariantFactors, 2)
+		assertMatrixEqual(t, mat, res.F)
+		assertSimilarity(t, mat, res.P, res.F)
+	})
+
+	t.Run("finite field", func(t *testing.T) {
+		gf := func(v int64) field.GF {
+			x, _ := field.NewGF(v, 3)
+			return x
+		}
+		mat, _ := FromSlice([][]field.GF{
+			{gf(1), gf(2), gf(0), gf(1)},
+			{gf(0), gf(1), gf(0), gf(0)},
+			{gf(2), gf(1), gf(1), gf(0)},
+			{gf(0), gf(0), gf(0), gf(1)},
+		})
+
+		res, err := FrobeniusForm(mat)
+		assert.NoError(t, err)
+		assertSimilarity(t, mat, res.P, res.F)
+
+		mu, err := MinimalPolynomial(mat)
+		assert.NoError(t, err)
+		assert.True(t, mu.Equal(res.InvariantFactors[len(res.InvariantFactors)-1]))
+		for i := 1; i < len(res.InvariantFactors); i++ {
+			_, rem, _ := res.InvariantFactors[i].DivMod(res.InvariantFactors[i-1])
+			assert.True(t, rem.IsZero())
+		}
+	})
+}
+
+func TestSimilar(t *testing.T) {
+	a := ratMatrix(t, [][]int64{{1, 1}, {0, 1}})
+
+	t.Run("similar", func(t *testing.T) {
+		b := ratMatrix(t, [][]int64{{2, 1}, {-1, 0}})
+
+		ok, S, err := Similar(a, b)
+		assert.NoError(t, err)
+		assert.True(t, ok)
+		assertSimilarity(t, a, S, b)
+	})
+
+	t.Run("same characteristic polynomial", func(t *testing.T) {
+		// Единичная матрица имеет тот же характеристический многочлен, но не подобна жордановой клетке
+		ok, S, err := Similar(a, ratMatrix(t, [][]int64{{1, 0}, {0, 1}}))
+		assert.NoError(t, err)
+		assert.False(t, ok)
+		assert.Nil(t, S)
+	})
+
+	t.Run("singular float64", func(t *testing.T) {
+		// Матрица перехода обращается через LU; вырожденность самих A и B ему не мешает
+		singular, _ := FromSlice([][]field.Float64{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}})
+		ok, S, err := Similar(singular, singular.Transpose())
+		assert.NoError(t, err)
+		assert.True(t, ok)
+		assertSimilarity(t, singular, S, singular.Transpose())
+
+		// Нильпотентная клетка и нулевая матрица: общий характеристический многочлен x^2
+		ok, S, err = Similar(nilpotentBlock(), NewMatrix[field.Float64](2, 2, 0))
+		assert.NoError(t, err)
+		assert.False(t, ok)
+		assert.Nil(t, S)
+	})
+
+	t.Run("different size", func(t *testing.T) {
+		ok, _, err := Similar(a, ratMatrix(t, [][]int64{{1}}))
+		assert.NoError(t, err)
+		assert.False(t, ok)
+	})
+}
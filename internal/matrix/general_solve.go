@@ -0,0 +1,177 @@
+package matrix
+
+import (
+	"MatrixGo/internal/field"
+	"MatrixGo/internal/vector"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// SolutionKind описывает тип множества решений системы линейных уравнений
+type SolutionKind int
+
+const (
+	Inconsistent      SolutionKind = iota // система несовместна
+	UniqueSolution                        // единственное решение
+	InfiniteSolutions                     // бесконечно много решений
+)
+
+func (k SolutionKind) String() string {
+	switch k {
+	case Inconsistent:
+		return "inconsistent"
+	case UniqueSolution:
+		return "unique"
+	case InfiniteSolutions:
+		return "infinite"
+	default:
+		return "unknown"
+	}
+}
+
+// GeneralSolution описывает общее решение системы A*x = b в виде x = Particular + Σ t_k*Kernel[k]
+type GeneralSolution[T field.Field[T]] struct {
+	Kind       SolutionKind
+	Rank       int                 // ранг матрицы системы
+	Particular *vector.Vector[T]   // частное решение (nil, если система несовместна)
+	Kernel     []*vector.Vector[T] // базис ядра матрицы системы
+	FreeVars   []int               // номера свободных переменных, Kernel[k] соответствует FreeVars[k]
+}
+
+// SolveGeneral решает произвольную (в том числе прямоугольную или вырожденную) систему A*x = b
+// и классифицирует множество ее решений
+func SolveGeneral[T field.Field[T]](mat *Matrix[T], vec *vector.Vector[T]) (*GeneralSolution[T], error) {
+	augmented, err := augment(mat, vec)
+	if err != nil {
+		return nil, err
+	}
+	rref := augmented.RREF(false)
+	return generalSolutionFromRREF(rref, mat.Cols), nil
+}
+
+// augment строит расширенную матрицу [A|b]
+func augment[T field.Field[T]](mat *Matrix[T], vec *vector.Vector[T]) (*Matrix[T], error) {
+	if mat.Rows != vec.Len() {
+		return nil, errors.New("размер вектора не совпадает с количеством строк матрицы")
+	}
+
+	augmented := NewMatrix[T](mat.Rows, mat.Cols+1, mat.Data[0][0].Zero())
+	for i := 0; i < mat.Rows; i++ {
+		copy(augmented.Data[i], mat.Data[i])
+		augmented.Data[i][mat.Cols] = vec.Data[i]
+	}
+	return augmented, nil
+}
+
+// generalSolutionFromRREF извлекает общее решение из приведенного ступенчатого вида матрицы [A|b]
+func generalSolutionFromRREF[T field.Field[T]](rref *RREFResult[T], cols int) *GeneralSolution[T] {
+	// Ведущий элемент в столбце правой части означает уравнение 0 = 1
+	if len(rref.Pivots) > 0 && rref.Pivots[len(rref.Pivots)-1] == cols {
+		return &GeneralSolution[T]{Kind: Inconsistent, Rank: rref.Rank - 1}
+	}
+
+	zero := rref.Reduced.Data[0][0].Zero()
+	x := make([]T, cols)
+	for j := range x {
+		x[j] = zero
+	}
+	for row, p := range rref.Pivots {
+		x[p] = rref.Reduced.Data[row][cols]
+	}
+
+	kernel, freeVars := kernelFromRREF(rref.Reduced, rref.Pivots, cols)
+	kind := UniqueSolution
+	if len(kernel) > 0 {
+		kind = InfiniteSolutions
+	}
+
+	return &GeneralSolution[T]{
+		Kind:       kind,
+		Rank:       rref.Rank,
+		Particular: vector.NewVector(x),
+		Kernel:     kernel,
+		FreeVars:   freeVars,
+	}
+}
+
+// VariableName возвращает имя переменной с номером i (x1, x2, ...)
+func VariableName(i int) string {
+	return fmt.Sprintf("x%d", i+1)
+}
+
+// FreeVariableNames возвращает имена свободных переменных, играющих роль параметров
+func (s *GeneralSolution[T]) FreeVariableNames() []string {
+	names := make([]string, len(s.FreeVars))
+	for k, v := range s.FreeVars {
+		names[k] = VariableName(v)
+	}
+	return names
+}
+
+// Parametric возвращает решение в параметрическом виде: по одному равенству на переменную,
+// например "x1 = 2 - 3*x3". Свободные переменные записываются как "x3 = x3".
+func (s *GeneralSolution[T]) Parametric() []string {
+	if s.Kind == Inconsistent {
+		return nil
+	}
+
+	names := s.FreeVariableNames()
+	n := s.Particular.Len()
+	zero := s.Particular.Data[0].Zero()
+	one := s.Particular.Data[0].One()
+
+	result := make([]string, n)
+	for i := 0; i < n; i++ {
+		var sb strings.Builder
+		if !s.Particular.Data[i].Equal(zero) {
+			sb.WriteString(fmt.Sprintf("%v", s.Particular.Data[i]))
+		}
+		for k, basis := range s.Kernel {
+			coeff := basis.Data[i]
+			if coeff.Equal(zero) {
+				continue
+			}
+			writeTerm(&sb, coeff, one, names[k])
+		}
+		if sb.Len() == 0 {
+			sb.WriteString(fmt.Sprintf("%v", zero))
+		}
+		result[i] = fmt.Sprintf("%s = %s", VariableName(i), sb.String())
+	}
+	return result
+}
+
+// writeTerm дописывает слагаемое coeff*name с корректным знаком
+func writeTerm[T field.Field[T]](sb *strings.Builder, coeff, one T, name string) {
+	first := sb.Len() == 0
+	negative := false
+	text := fmt.Sprintf("%v", coeff)
+
+	switch {
+	case coeff.Equal(one):
+		text = ""
+	case coeff.Equal(one.Neg()):
+		text = ""
+		negative = true
+	case strings.HasPrefix(text, "-") && !strings.ContainsAny(text[1:], "+- "):
+		text = text[1:]
+		negative = true
+	case strings.ContainsAny(text, "+- "):
+		text = "(" + text + ")"
+	}
+
+	switch {
+	case first && negative:
+		sb.WriteString("-")
+	case negative:
+		sb.WriteString(" - ")
+	case !first:
+		sb.WriteString(" + ")
+	}
+	if text != "" {
+		sb.WriteString(text)
+		sb.WriteString("*")
+	}
+	sb.WriteString(name)
+}
@@ -0,0 +1,108 @@
+package matrix
+
+import (
+	"MatrixGo/internal/field"
+	"MatrixGo/internal/vector"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func ratVector(data ...int64) *vector.Vector[field.Rational] {
+	v := make([]field.Rational, len(data))
+	for i, x := range data {
+		v[i] = field.NewRational(x, 1)
+	}
+	return vector.NewVector(v)
+}
+
+func TestSolveGeneral(t *testing.T) {
+	tests := []struct {
+		name       string
+		matrix     [][]int64
+		vector     []int64
+		kind       SolutionKind
+		rank       int
+		kernelDim  int
+		parametric []string
+	}{
+		{
+			name:       "unique square",
+			matrix:     [][]int64{{2, 1}, {1, 3}},
+			vector:     []int64{3, 4},
+			kind:       UniqueSolution,
+			rank:       2,
+			parametric: []string{"x1 = 1", "x2 = 1"},
+		},
+		{
+			name:       "underdetermined",
+			matrix:     [][]int64{{1, 2, 1}, {2, 4, 0}},
+			vector:     []int64{3, 2},
+			kind:       InfiniteSolutions,
+			rank:       2,
+			kernelDim:  1,
+			parametric: []string{"x1 = 1 - 2*x2", "x2 = x2", "x3 = 2"},
+		},
+		{
+			name:   "inconsistent singular",
+			matrix: [][]int64{{1, 1}, {1, 1}},
+			vector: []int64{1, 2},
+			kind:   Inconsistent,
+			rank:   1,
+		},
+		{
+			name:       "overdetermined consistent",
+			matrix:     [][]int64{{1, 0}, {0, 1}, {1, 1}},
+			vector:     []int64{1, 2, 3},
+			kind:       UniqueSolution,
+			rank:       2,
+			parametric: []string{"x1 = 1", "x2 = 2"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mat := ratMatrix(t, tt.matrix)
+			b := ratVector(tt.vector...)
+
+			sol1, err1 := SolveGeneral(mat, b)
+			sol2, err2 := SolveGeneralParallel(mat, b)
+			assert.NoError(t, err1)
+			assert.NoError(t, err2)
+
+			for _, sol := range []*GeneralSolution[field.Rational]{sol1, sol2} {
+				assert.Equal(t, tt.kind, sol.Kind)
+				assert.Equal(t, tt.rank, sol.Rank)
+				assert.Len(t, sol.Kernel, tt.kernelDim)
+				assert.Equal(t, tt.parametric, sol.Parametric())
+
+				if sol.Kind == Inconsistent {
+					assert.Nil(t, sol.Particular)
+					continue
+				}
+
+				// Частное решение удовлетворяет системе, векторы ядра — однородной системе
+				for i := 0; i < mat.Rows; i++ {
+					sum := field.NewRational(0, 1)
+					for j := 0; j < mat.Cols; j++ {
+						sum = sum.Add(mat.Data[i][j].Mul(sol.Particular.Data[j]))
+					}
+					assert.True(t, sum.Equal(b.Data[i]))
+					for _, k := range sol.Kernel {
+						sum := field.NewRational(0, 1)
+						for j := 0; j < mat.Cols; j++ {
+							sum = sum.Add(mat.Data[i][j].Mul(k.Data[j]))
+						}
+						assert.True(t, sum.Equal(field.NewRational(0, 1)))
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestSolveGeneralDimensionMismatch(t *testing.T) {
+	mat := ratMatrix(t, [][]int64{{1, 2}, {3, 4}})
+	_, err := SolveGeneral(mat, ratVector(1, 2, 3))
+	assert.Error(t, err)
+}
@@ -0,0 +1,53 @@
+package matrix
+
+import "MatrixGo/internal/field"
+
+// RationalQR хранит точное разложение A = Q*R над рациональными числами.
+// Столбцы Q попарно ортогональны, но не нормированы: Q^T*Q = diag(D).
+// R — верхняя унитреугольная матрица. Ортонормированное разложение получается
+// как (Q*D^(-1/2)) * (D^(1/2)*R), но требует иррациональных корней.
+type RationalQR struct {
+	Q    *Matrix[field.Rational] // ортогональные (ненормированные) столбцы, Rows×Cols
+	R    *Matrix[field.Rational] // верхняя унитреугольная матрица Cols×Cols
+	D    []field.Rational        // D[j] = <q_j, q_j>; ноль для линейно зависимых столбцов
+	Rank int                     // количество ненулевых столбцов Q
+}
+
+// dotColumns вычисляет скалярное произведение столбцов i и j двух матриц
+func dotColumns[T field.Field[T]](a *Matrix[T], i int, b *Matrix[T], j int) T {
+	sum := a.Data[0][i].Zero()
+	for r := 0; r < a.Rows; r++ {
+		sum = sum.Add(a.Data[r][i].Mul(b.Data[r][j]))
+	}
+	return sum
+}
+
+// GramSchmidtQR вычисляет точное QR-разложение процессом Грама–Шмидта без нормировки.
+// Линейно зависимые столбцы дают нулевые столбцы Q и нулевые D[j].
+func GramSchmidtQR(m *Matrix[field.Rational]) *RationalQR {
+	zero := m.Data[0][0].Zero()
+	Q := m.Clone()
+	R := IdentityMatrix[field.Rational](m.Cols, zero, zero.One())
+	D := make([]field.Rational, m.Cols)
+	rank := 0
+
+	for j := 0; j < m.Cols; j++ {
+		for i := 0; i < j; i++ {
+			if D[i].Equal(zero) {
+				continue
+			}
+			mu, _ := dotColumns(m, j, Q, i).Div(D[i])
+			R.Data[i][j] = mu
+			for r := 0; r < m.Rows; r++ {
+				Q.Data[r][j] = Q.Data[r][j].Sub(mu.Mul(Q.Data[r][i]))
+			}
+		}
+
+		D[j] = dotColumns(Q, j, Q, j)
+		if !D[j].Equal(zero) {
+			rank++
+		}
+	}
+
+	return &RationalQR{Q: Q, R: R, D: D, Rank: rank}
+}
@@ -0,0 +1,44 @@
+package matrix
+
+import (
+	"MatrixGo/internal/field"
+	"errors"
+)
+
+// HessenbergDecomposition хранит приведение A = Q*H*Q^H к верхней форме Хессенберга
+type HessenbergDecomposition[T field.Scalar[T]] struct {
+	H *Matrix[T] // верхняя матрица Хессенберга: H[i][j] = 0 при i > j+1
+	Q *Matrix[T] // унитарная (ортогональная) матрица преобразования
+}
+
+// Hessenberg приводит квадратную матрицу к верхней форме Хессенберга отражениями Хаусхолдера.
+// Для эрмитовой матрицы H получается трехдиагональной.
+func Hessenberg[T field.Scalar[T]](m *Matrix[T]) (*HessenbergDecomposition[T], error) {
+	if m.Rows != m.Cols {
+		return nil, errors.New("матрица должна быть квадратной")
+	}
+
+	n := m.Rows
+	zero := m.Data[0][0].Zero()
+	H := m.Clone()
+	Q := IdentityMatrix[T](n, zero, zero.One())
+
+	for k := 0; k+2 < n; k++ {
+		v, alpha, vNorm2, ok := householderVector(H, k+1, k)
+		if !ok {
+			continue
+		}
+
+		// Преобразование подобия H = P*H*P, где P — отражение по строкам/столбцам [k+1, n)
+		applyHouseholderLeft(H, v, k+1, k, vNorm2)
+		applyHouseholderRight(H, v, k+1, vNorm2)
+		applyHouseholderRight(Q, v, k+1, vNorm2)
+
+		H.Data[k+1][k] = alpha
+		for i := k + 2; i < n; i++ {
+			H.Data[i][k] = zero
+		}
+	}
+
+	return &HessenbergDecomposition[T]{H: H, Q: Q}, nil
+}
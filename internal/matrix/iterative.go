@@ -0,0 +1,706 @@
+package matrix
+
+import (
+	"MatrixGo/internal/field"
+	"MatrixGo/internal/vector"
+	"errors"
+	"fmt"
+	"math"
+)
+
+// Итерационные методы решают вещественные системы A*x = b, обращаясь к матрице только
+// через произведение на вектор. Одна итерация стоит O(nnz) операций, поэтому методы
+// подходят для больших разреженных систем, для которых прямое исключение (O(n³)) непрактично.
+
+// LinearOperator — линейный оператор, заданный действием на вектор. Ему удовлетворяют
+// Matrix и SparseMatrix, а также любые матрично-свободные операторы.
+type LinearOperator[T field.Field[T]] interface {
+	Dims() (rows, cols int)
+	MulVec(v *vector.Vector[T]) (*vector.Vector[T], error)
+}
+
+// Preconditioner приближает A^{-1}: Solve возвращает z ≈ решение M*z = r
+type Preconditioner interface {
+	Solve(r *vector.Vector[field.Float64]) (*vector.Vector[field.Float64], error)
+}
+
+// IterativeOptions задает параметры итерационного решения; нулевые значения заменяются
+// значениями по умолчанию
+type IterativeOptions struct {
+	Tol            float64                          // допустимая относительная невязка ||b - A*x|| / ||b|| (по умолчанию 1e-10)
+	MaxIter        int                              // предельное число итераций (по умолчанию 10*n)
+	Restart        int                              // число шагов GMRES до перезапуска (по умолчанию min(n, 30))
+	Omega          float64                          // параметр релаксации SOR из (0, 2) (по умолчанию 1)
+	X0             *vector.Vector[field.Float64]    // начальное приближение (по умолчанию нулевое)
+	Preconditioner Preconditioner                   // предобусловливатель методов Крылова (по умолчанию нет)
+	OnIteration    func(iter int, residual float64) // вызывается после каждой итерации с относительной невязкой
+}
+
+// IterativeResult — результат итерационного решения
+type IterativeResult struct {
+	X          *vector.Vector[field.Float64]
+	Iterations int
+	Residual   float64   // итоговая относительная невязка
+	Converged  bool      // достигнута ли точность Tol
+	History    []float64 // относительные невязки: History[0] — начальная, History[k] — после итерации k
+}
+
+// floatApply применяет оператор к срезу; явные матрицы обрабатываются без промежуточных векторов
+type floatApply func(dst, x []field.Float64) error
+
+// iterationMonitor проверяет сходимость и ведет историю невязок
+type iterationMonitor struct {
+	tol      float64
+	maxIter  int
+	bnorm    float64
+	callback func(iter int, residual float64)
+	res      *IterativeResult
+}
+
+// record фиксирует невязку после итерации iter и сообщает, достигнута ли точность
+func (m *iterationMonitor) record(iter int, rnorm float64) bool {
+	rel := rnorm / m.bnorm
+	m.res.Iterations = iter
+	m.res.Residual = rel
+	m.res.History = append(m.res.History, rel)
+	if iter > 0 && m.callback != nil {
+		m.callback(iter, rel)
+	}
+	m.res.Converged = rel <= m.tol
+	return m.res.Converged
+}
+
+// finish возвращает результат и ErrNotConverged, если точность не достигнута
+func (m *iterationMonitor) finish(op string, x []field.Float64) (*IterativeResult, error) {
+	m.res.X = vector.NewVector(x)
+	if !m.res.Converged {
+		return m.res, fmt.Errorf("%s: %w за %d итераций (невязка %g)", op, ErrNotConverged, m.res.Iterations, m.res.Residual)
+	}
+	return m.res, nil
+}
+
+// operatorApply строит функцию применения квадратного оператора A
+func operatorApply(op string, A LinearOperator[field.Float64]) (int, floatApply, error) {
+	rows, cols := A.Dims()
+	if rows != cols {
+		return 0, nil, &DimensionError{Op: op, Expected: "квадратная матрица", Actual: sizeString(rows, cols)}
+	}
+
+	switch a := A.(type) {
+	case *SparseMatrix[field.Float64]:
+		csr := a.ToCSR()
+		return rows, func(dst, x []field.Float64) error {
+			csrMulVec(csr, dst, x)
+			return nil
+		}, nil
+	case *Matrix[field.Float64]:
+		return rows, func(dst, x []field.Float64) error {
+			for i, row := range a.Data {
+				var sum field.Float64
+				for j, v := range row {
+					sum += v * x[j]
+				}
+				dst[i] = sum
+			}
+			return nil
+		}, nil
+	default:
+		return rows, func(dst, x []field.Float64) error {
+			y, err := A.MulVec(vector.NewVector(x))
+			if err != nil {
+				return err
+			}
+			copy(dst, y.Data)
+			return nil
+		}, nil
+	}
+}
+
+// explicitCSR возвращает явную матрицу оператора в формате CSR. Стационарным методам
+// и предобусловливателям нужны сами элементы, а не только произведение на вектор.
+func explicitCSR(op string, A LinearOperator[field.Float64]) (*SparseMatrix[field.Float64], error) {
+	rows, cols := A.Dims()
+	if rows != cols {
+		return nil, &DimensionError{Op: op, Expected: "квадратная матрица", Actual: sizeString(rows, cols)}
+	}
+	switch a := A.(type) {
+	case *SparseMatrix[field.Float64]:
+		return a.ToCSR(), nil
+	case *Matrix[field.Float64]:
+		return SparseFromDense(a), nil
+	}
+	return nil, fmt.Errorf("%s: требуется явно заданная матрица (Matrix или SparseMatrix)", op)
+}
+
+// csrMulVec вычисляет dst = A*x для матрицы в формате CSR
+func csrMulVec(a *SparseMatrix[field.Float64], dst, x []field.Float64) {
+	for i := 0; i < a.Rows; i++ {
+		var sum field.Float64
+		for p := a.Ptr[i]; p < a.Ptr[i+1]; p++ {
+			sum += a.Values[p] * x[a.Indices[p]]
+		}
+		dst[i] = sum
+	}
+}
+
+// csrDiagonal возвращает позиции диагональных элементов в Values (ошибка при нулевой диагонали)
+func csrDiagonal(op string, a *SparseMatrix[field.Float64]) ([]int, error) {
+	diag := make([]int, a.Rows)
+	for i := 0; i < a.Rows; i++ {
+		diag[i] = -1
+		for p := a.Ptr[i]; p < a.Ptr[i+1]; p++ {
+			if a.Indices[p] == i {
+				diag[i] = p
+				break
+			}
+		}
+		if diag[i] < 0 || a.Values[diag[i]] == 0 {
+			return nil, fmt.Errorf("%s: нулевой диагональный элемент в строке %d", op, i)
+		}
+	}
+	return diag, nil
+}
+
+func dotFloat(x, y []field.Float64) float64 {
+	var sum field.Float64
+	for i := range x {
+		sum += x[i] * y[i]
+	}
+	return float64(sum)
+}
+
+func normFloat(x []field.Float64) float64 {
+	return math.Sqrt(dotFloat(x, x))
+}
+
+// axpyFloat вычисляет y += alpha*x
+func axpyFloat(alpha float64, x, y []field.Float64) {
+	a := field.Float64(alpha)
+	for i := range y {
+		y[i] += a * x[i]
+	}
+}
+
+// iterativeSetup проверяет размеры, заполняет значения по умолчанию и вычисляет начальную невязку r = b - A*x0
+func iterativeSetup(op string, A LinearOperator[field.Float64], b *vector.Vector[field.Float64], opts IterativeOptions) (floatApply, []field.Float64, []field.Float64, *iterationMonitor, error) {
+	n, apply, err := operatorApply(op, A)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+	if b.Len() != n {
+		return nil, nil, nil, nil, &DimensionError{Op: op, Expected: fmt.Sprintf("вектор размера %d", n), Actual: fmt.Sprint(b.Len())}
+	}
+
+	x := make([]field.Float64, n)
+	if opts.X0 != nil {
+		if opts.X0.Len() != n {
+			return nil, nil, nil, nil, &DimensionError{Op: op, Expected: fmt.Sprintf("начальное приближение размера %d", n), Actual: fmt.Sprint(opts.X0.Len())}
+		}
+		copy(x, opts.X0.Data)
+	}
+	r := make([]field.Float64, n)
+	if err := apply(r, x); err != nil {
+		return nil, nil, nil, nil, err
+	}
+	for i := range r {
+		r[i] = b.Data[i] - r[i]
+	}
+
+	mon := &iterationMonitor{tol: opts.Tol, maxIter: opts.MaxIter, bnorm: normFloat(b.Data), callback: opts.OnIteration, res: &IterativeResult{}}
+	if mon.tol <= 0 {
+		mon.tol = 1e-10
+	}
+	if mon.maxIter <= 0 {
+		mon.maxIter = 10 * n
+	}
+	// При b = 0 невязка измеряется в абсолютной величине
+	if mon.bnorm == 0 {
+		mon.bnorm = 1
+	}
+	return apply, x, r, mon, nil
+}
+
+// preconditionFunc возвращает применение предобусловливателя (копирование при его отсутствии)
+func preconditionFunc(p Preconditioner) func(dst, r []field.Float64) error {
+	if p == nil {
+		return func(dst, r []field.Float64) error {
+			copy(dst, r)
+			return nil
+		}
+	}
+	return func(dst, r []field.Float64) error {
+		z, err := p.Solve(vector.NewVector(r))
+		if err != nil {
+			return err
+		}
+		copy(dst, z.Data)
+		return nil
+	}
+}
+
+// SolveCG решает систему с симметричной положительно определенной матрицей методом
+// сопряженных градиентов (с предобусловливанием, если оно задано; предобусловливатель
+// также должен быть симметричным положительно определенным)
+func SolveCG(A LinearOperator[field.Float64], b *vector.Vector[field.Float64], opts IterativeOptions) (*IterativeResult, error) {
+	apply, x, r, mon, err := iterativeSetup("SolveCG", A, b, opts)
+	if err != nil {
+		return nil, err
+	}
+	if mon.record(0, normFloat(r)) {
+		return mon.finish("SolveCG", x)
+	}
+	precond := preconditionFunc(opts.Preconditioner)
+
+	n := len(x)
+	z, q := make([]field.Float64, n), make([]field.Float64, n)
+	if err := precond(z, r); err != nil {
+		return nil, err
+	}
+	p := append([]field.Float64(nil), z...)
+	rz := dotFloat(r, z)
+	for k := 1; k <= mon.maxIter; k++ {
+		if err := apply(q, p); err != nil {
+			return nil, err
+		}
+		pq := dotFloat(p, q)
+		if pq <= 0 {
+			return nil, errors.New("SolveCG: матрица не является положительно определенной")
+		}
+		alpha := rz / pq
+		axpyFloat(alpha, p, x)
+		axpyFloat(-alpha, q, r)
+		if mon.record(k, normFloat(r)) {
+			break
+		}
+
+		if err := precond(z, r); err != nil {
+			return nil, err
+		}
+		rzNew := dotFloat(r, z)
+		beta := field.Float64(rzNew / rz)
+		rz = rzNew
+		for i := range p {
+			p[i] = z[i] + beta*p[i]
+		}
+	}
+	return mon.finish("SolveCG", x)
+}
+
+// SolveBiCGSTAB решает систему с произвольной невырожденной матрицей стабилизированным
+// методом бисопряженных градиентов с правым предобусловливанием. Сходимость подтверждается
+// истинной невязкой; если она расходится с рекуррентной, процесс перезапускается.
+func SolveBiCGSTAB(A LinearOperator[field.Float64], b *vector.Vector[field.Float64], opts IterativeOptions) (*IterativeResult, error) {
+	apply, x, r, mon, err := iterativeSetup("SolveBiCGSTAB", A, b, opts)
+	if err != nil {
+		return nil, err
+	}
+	if mon.record(0, normFloat(r)) {
+		return mon.finish("SolveBiCGSTAB", x)
+	}
+	precond := preconditionFunc(opts.Preconditioner)
+
+	n := len(x)
+	rHat := make([]field.Float64, n)
+	p, v := make([]field.Float64, n), make([]field.Float64, n)
+	pHat, sHat, s, t := make([]field.Float64, n), make([]field.Float64, n), make([]field.Float64, n), make([]field.Float64, n)
+	var rho, alpha, omega float64
+	// reset начинает процесс заново от текущей невязки r
+	reset := func() {
+		copy(rHat, r)
+		for i := range p {
+			p[i], v[i] = 0, 0
+		}
+		rho, alpha, omega = 1, 1, 1
+	}
+	// converged проверяет сходимость по истинной невязке: рекуррентная невязка
+	// для несимметричных матриц может заметно отклоняться от b - A*x
+	converged := func(k int) (bool, error) {
+		if normFloat(r)/mon.bnorm <= mon.tol {
+			if err := apply(r, x); err != nil {
+				return false, err
+			}
+			for i := range r {
+				r[i] = b.Data[i] - r[i]
+			}
+			if !mon.record(k, normFloat(r)) {
+				reset()
+				return false, nil
+			}
+			return true, nil
+		}
+		return mon.record(k, normFloat(r)), nil
+	}
+
+	reset()
+	for k := 1; k <= mon.maxIter; k++ {
+		rhoNew := dotFloat(rHat, r)
+		if rhoNew == 0 {
+			return nil, errors.New("SolveBiCGSTAB: вырождение метода (rho = 0)")
+		}
+		beta := field.Float64((rhoNew / rho) * (alpha / omega))
+		rho = rhoNew
+		for i := range p {
+			p[i] = r[i] + beta*(p[i]-field.Float64(omega)*v[i])
+		}
+		if err := precond(pHat, p); err != nil {
+			return nil, err
+		}
+		if err := apply(v, pHat); err != nil {
+			return nil, err
+		}
+		alpha = rho / dotFloat(rHat, v)
+		for i := range s {
+			s[i] = r[i] - field.Float64(alpha)*v[i]
+		}
+		if normFloat(s)/mon.bnorm <= mon.tol {
+			axpyFloat(alpha, pHat, x)
+			copy(r, s)
+			if ok, err := converged(k); err != nil {
+				return nil, err
+			} else if ok {
+				break
+			}
+			continue
+		}
+
+		if err := precond(sHat, s); err != nil {
+			return nil, err
+		}
+		if err := apply(t, sHat); err != nil {
+			return nil, err
+		}
+		tt := dotFloat(t, t)
+		if tt == 0 {
+			return nil, errors.New("SolveBiCGSTAB: вырождение метода (t = 0)")
+		}
+		omega = dotFloat(t, s) / tt
+		axpyFloat(alpha, pHat, x)
+		axpyFloat(omega, sHat, x)
+		for i := range r {
+			r[i] = s[i] - field.Float64(omega)*t[i]
+		}
+		if ok, err := converged(k); err != nil {
+			return nil, err
+		} else if ok {
+			break
+		}
+		if omega == 0 {
+			return nil, errors.New("SolveBiCGSTAB: вырождение метода (omega = 0)")
+		}
+	}
+	return mon.finish("SolveBiCGSTAB", x)
+}
+
+// SolveGMRES решает систему с произвольной невырожденной матрицей методом обобщенных
+// минимальных невязок с перезапуском через Restart шагов и правым предобусловливанием.
+// Базис Крылова ортогонализуется модифицированным методом Грама–Шмидта, а верхняя
+// хессенбергова матрица приводится к треугольной вращениями Гивенса, что дает невязку
+// на каждом шаге без вычисления приближения.
+func SolveGMRES(A LinearOperator[field.Float64], b *vector.Vector[field.Float64], opts IterativeOptions) (*IterativeResult, error) {
+	apply, x, r, mon, err := iterativeSetup("SolveGMRES", A, b, opts)
+	if err != nil {
+		return nil, err
+	}
+	n := len(x)
+	restart := opts.Restart
+	if restart <= 0 {
+		restart = min(n, 30)
+	}
+	restart = min(restart, n)
+	precond := preconditionFunc(opts.Preconditioner)
+
+	V := make([][]field.Float64, restart+1)
+	Z := make([][]field.Float64, restart)
+	for i := range V {
+		V[i] = make([]field.Float64, n)
+	}
+	for i := range Z {
+		Z[i] = make([]field.Float64, n)
+	}
+	H := make([][]float64, restart+1)
+	for i := range H {
+		H[i] = make([]float64, restart)
+	}
+	cs, sn, g := make([]float64, restart), make([]float64, restart), make([]float64, restart+1)
+
+	iter := 0
+	beta := normFloat(r)
+	if mon.record(0, beta) {
+		return mon.finish("SolveGMRES", x)
+	}
+	for iter < mon.maxIter {
+		for i := range V[0] {
+			V[0][i] = r[i] / field.Float64(beta)
+		}
+		for i := range g {
+			g[i] = 0
+		}
+		g[0] = beta
+
+		steps := 0
+		for j := 0; j < restart && iter < mon.maxIter; j++ {
+			if err := precond(Z[j], V[j]); err != nil {
+				return nil, err
+			}
+			w := V[j+1]
+			if err := apply(w, Z[j]); err != nil {
+				return nil, err
+			}
+			for i := 0; i <= j; i++ {
+				H[i][j] = dotFloat(w, V[i])
+				axpyFloat(-H[i][j], V[i], w)
+			}
+			H[j+1][j] = normFloat(w)
+
+			// Ранее найденные вращения применяются к новому столбцу, новое зануляет H[j+1][j]
+			for i := 0; i < j; i++ {
+				H[i][j], H[i+1][j] = cs[i]*H[i][j]+sn[i]*H[i+1][j], -sn[i]*H[i][j]+cs[i]*H[i+1][j]
+			}
+			h := math.Hypot(H[j][j], H[j+1][j])
+			breakdown := H[j+1][j] == 0
+			if h == 0 {
+				return nil, errors.New("SolveGMRES: вырожденная матрица")
+			}
+			cs[j], sn[j] = H[j][j]/h, H[j+1][j]/h
+			if !breakdown {
+				for i := range w {
+					w[i] /= field.Float64(H[j+1][j])
+				}
+			}
+			H[j][j], H[j+1][j] = h, 0
+			g[j], g[j+1] = cs[j]*g[j], -sn[j]*g[j]
+
+			iter++
+			steps = j + 1
+			// Оценка невязки |g[j+1]|; при обрыве (breakdown) решение точное
+			if mon.record(iter, math.Abs(g[j+1])) || breakdown {
+				break
+			}
+		}
+
+		// Решение треугольной системы H*y = g и поправка x += Z*y
+		y := make([]float64, steps)
+		for i := steps - 1; i >= 0; i-- {
+			sum := g[i]
+			for k := i + 1; k < steps; k++ {
+				sum -= H[i][k] * y[k]
+			}
+			y[i] = sum / H[i][i]
+		}
+		for i := 0; i < steps; i++ {
+			axpyFloat(y[i], Z[i], x)
+		}
+
+		// Истинная невязка после цикла: оценка может отличаться из-за округлений
+		if err := apply(r, x); err != nil {
+			return nil, err
+		}
+		for i := range r {
+			r[i] = b.Data[i] - r[i]
+		}
+		beta = normFloat(r)
+		mon.res.Residual = beta / mon.bnorm
+		mon.res.History[len(mon.res.History)-1] = mon.res.Residual
+		mon.res.Converged = mon.res.Residual <= mon.tol
+		if mon.res.Converged || beta == 0 {
+			break
+		}
+	}
+	return mon.finish("SolveGMRES", x)
+}
+
+// SolveJacobi решает систему методом Якоби: x_i ← (b_i - Σ_{j≠i} a_ij x_j) / a_ii.
+// Сходится, например, для матриц со строгим диагональным преобладанием. Требует явной матрицы.
+func SolveJacobi(A LinearOperator[field.Float64], b *vector.Vector[field.Float64], opts IterativeOptions) (*IterativeResult, error) {
+	a, diag, x, r, mon, err := stationarySetup("SolveJacobi", A, b, opts)
+	if err != nil {
+		return nil, err
+	}
+	next := make([]field.Float64, len(x))
+	for k := 1; k <= mon.maxIter && !mon.res.Converged; k++ {
+		for i := 0; i < a.Rows; i++ {
+			sum := b.Data[i]
+			for p := a.Ptr[i]; p < a.Ptr[i+1]; p++ {
+				if p != diag[i] {
+					sum -= a.Values[p] * x[a.Indices[p]]
+				}
+			}
+			next[i] = sum / a.Values[diag[i]]
+		}
+		x, next = next, x
+		mon.record(k, csrResidual(a, b.Data, x, r))
+	}
+	return mon.finish("SolveJacobi", x)
+}
+
+// SolveGaussSeidel решает систему методом Гаусса–Зейделя (SOR с параметром 1)
+func SolveGaussSeidel(A LinearOperator[field.Float64], b *vector.Vector[field.Float64], opts IterativeOptions) (*IterativeResult, error) {
+	opts.Omega = 1
+	return solveSOR("SolveGaussSeidel", A, b, opts)
+}
+
+// SolveSOR решает систему методом последовательной верхней релаксации с параметром
+// opts.Omega из (0, 2): x_i ← (1-ω) x_i + ω (b_i - Σ_{j≠i} a_ij x_j) / a_ii, где
+// для j < i используются уже обновленные значения. Требует явной матрицы.
+func SolveSOR(A LinearOperator[field.Float64], b *vector.Vector[field.Float64], opts IterativeOptions) (*IterativeResult, error) {
+	return solveSOR("SolveSOR", A, b, opts)
+}
+
+func solveSOR(op string, A LinearOperator[field.Float64], b *vector.Vector[field.Float64], opts IterativeOptions) (*IterativeResult, error) {
+	omega := opts.Omega
+	if omega == 0 {
+		omega = 1
+	}
+	if omega <= 0 || omega >= 2 {
+		return nil, fmt.Errorf("%s: параметр релаксации %g вне интервала (0, 2)", op, omega)
+	}
+	a, diag, x, r, mon, err := stationarySetup(op, A, b, opts)
+	if err != nil {
+		return nil, err
+	}
+	w := field.Float64(omega)
+	for k := 1; k <= mon.maxIter && !mon.res.Converged; k++ {
+		for i := 0; i < a.Rows; i++ {
+			sum := b.Data[i]
+			for p := a.Ptr[i]; p < a.Ptr[i+1]; p++ {
+				if p != diag[i] {
+					sum -= a.Values[p] * x[a.Indices[p]]
+				}
+			}
+			x[i] = (1-w)*x[i] + w*sum/a.Values[diag[i]]
+		}
+		mon.record(k, csrResidual(a, b.Data, x, r))
+	}
+	return mon.finish(op, x)
+}
+
+// stationarySetup готовит явную матрицу и начальную невязку для стационарных методов
+func stationarySetup(op string, A LinearOperator[field.Float64], b *vector.Vector[field.Float64], opts IterativeOptions) (*SparseMatrix[field.Float64], []int, []field.Float64, []field.Float64, *iterationMonitor, error) {
+	a, err := explicitCSR(op, A)
+	if err != nil {
+		return nil, nil, nil, nil, nil, err
+	}
+	diag, err := csrDiagonal(op, a)
+	if err != nil {
+		return nil, nil, nil, nil, nil, err
+	}
+	_, x, r, mon, err := iterativeSetup(op, a, b, opts)
+	if err != nil {
+		return nil, nil, nil, nil, nil, err
+	}
+	mon.record(0, normFloat(r))
+	return a, diag, x, r, mon, nil
+}
+
+// csrResidual вычисляет r = b - A*x и возвращает ||r||
+func csrResidual(a *SparseMatrix[field.Float64], b, x, r []field.Float64) float64 {
+	csrMulVec(a, r, x)
+	for i := range r {
+		r[i] = b[i] - r[i]
+	}
+	return normFloat(r)
+}
+
+// JacobiPreconditioner — диагональный предобусловливатель M = diag(A)
+type JacobiPreconditioner struct {
+	invDiag []field.Float64
+}
+
+// NewJacobiPreconditioner строит предобусловливатель Якоби для явной матрицы с ненулевой диагональю
+func NewJacobiPreconditioner(A LinearOperator[field.Float64]) (*JacobiPreconditioner, error) {
+	a, err := explicitCSR("NewJacobiPreconditioner", A)
+	if err != nil {
+		return nil, err
+	}
+	diag, err := csrDiagonal("NewJacobiPreconditioner", a)
+	if err != nil {
+		return nil, err
+	}
+	inv := make([]field.Float64, a.Rows)
+	for i, p := range diag {
+		inv[i] = 1 / a.Values[p]
+	}
+	return &JacobiPreconditioner{invDiag: inv}, nil
+}
+
+// Solve возвращает z = diag(A)^{-1} r
+func (p *JacobiPreconditioner) Solve(r *vector.Vector[field.Float64]) (*vector.Vector[field.Float64], error) {
+	if r.Len() != len(p.invDiag) {
+		return nil, &DimensionError{Op: "JacobiPreconditioner.Solve", Expected: fmt.Sprintf("вектор размера %d", len(p.invDiag)), Actual: fmt.Sprint(r.Len())}
+	}
+	z := make([]field.Float64, len(p.invDiag))
+	for i, d := range p.invDiag {
+		z[i] = r.Data[i] * d
+	}
+	return vector.NewVector(z), nil
+}
+
+// ILU0Preconditioner — неполное LU-разложение без заполнения: множители L и U
+// имеют тот же портрет, что и A, и хранятся вместе в одной матрице CSR
+type ILU0Preconditioner struct {
+	lu   *SparseMatrix[field.Float64]
+	diag []int
+}
+
+// NewILU0Preconditioner строит ILU(0) для явной матрицы. Исключение (вариант IKJ)
+// выполняется только на позициях ненулевых элементов A; вне портрета заполнение отбрасывается.
+func NewILU0Preconditioner(A LinearOperator[field.Float64]) (*ILU0Preconditioner, error) {
+	a, err := explicitCSR("NewILU0Preconditioner", A)
+	if err != nil {
+		return nil, err
+	}
+	lu := a.Clone()
+	diag, err := csrDiagonal("NewILU0Preconditioner", lu)
+	if err != nil {
+		return nil, err
+	}
+
+	// pos[j] — позиция элемента (i, j) текущей строки в Values или -1
+	pos := make([]int, lu.Cols)
+	for j := range pos {
+		pos[j] = -1
+	}
+	for i := 0; i < lu.Rows; i++ {
+		for p := lu.Ptr[i]; p < lu.Ptr[i+1]; p++ {
+			pos[lu.Indices[p]] = p
+		}
+		for p := lu.Ptr[i]; p < diag[i]; p++ {
+			k := lu.Indices[p]
+			lu.Values[p] /= lu.Values[diag[k]]
+			lik := lu.Values[p]
+			for q := diag[k] + 1; q < lu.Ptr[k+1]; q++ {
+				if t := pos[lu.Indices[q]]; t >= 0 {
+					lu.Values[t] -= lik * lu.Values[q]
+				}
+			}
+		}
+		if lu.Values[diag[i]] == 0 {
+			return nil, fmt.Errorf("NewILU0Preconditioner: нулевой ведущий элемент в строке %d", i)
+		}
+		for p := lu.Ptr[i]; p < lu.Ptr[i+1]; p++ {
+			pos[lu.Indices[p]] = -1
+		}
+	}
+	return &ILU0Preconditioner{lu: lu, diag: diag}, nil
+}
+
+// Solve возвращает z = U^{-1} L^{-1} r
+func (p *ILU0Preconditioner) Solve(r *vector.Vector[field.Float64]) (*vector.Vector[field.Float64], error) {
+	lu := p.lu
+	if r.Len() != lu.Rows {
+		return nil, &DimensionError{Op: "ILU0Preconditioner.Solve", Expected: fmt.Sprintf("вектор размера %d", lu.Rows), Actual: fmt.Sprint(r.Len())}
+	}
+	z := append([]field.Float64(nil), r.Data...)
+	for i := 0; i < lu.Rows; i++ {
+		for q := lu.Ptr[i]; q < p.diag[i]; q++ {
+			z[i] -= lu.Values[q] * z[lu.Indices[q]]
+		}
+	}
+	for i := lu.Rows - 1; i >= 0; i-- {
+		for q := p.diag[i] + 1; q < lu.Ptr[i+1]; q++ {
+			z[i] -= lu.Values[q] * z[lu.Indices[q]]
+		}
+		z[i] /= lu.Values[p.diag[i]]
+	}
+	return vector.NewVector(z), nil
+}
@@ -0,0 +1,184 @@
+package matrix
+
+import (
+	"MatrixGo/internal/field"
+	"MatrixGo/internal/vector"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// tridiagonalOperator — матрично-свободный оператор tridiag(-1, 2, -1)
+type tridiagonalOperator struct {
+	n int
+}
+
+func (o tridiagonalOperator) Dims() (int, int) {
+	return o.n, o.n
+}
+
+func (o tridiagonalOperator) MulVec(v *vector.Vector[field.Float64]) (*vector.Vector[field.Float64], error) {
+	res := make([]field.Float64, o.n)
+	for i := range res {
+		res[i] = 2 * v.Data[i]
+		if i > 0 {
+			res[i] -= v.Data[i-1]
+		}
+		if i < o.n-1 {
+			res[i] -= v.Data[i+1]
+		}
+	}
+	return vector.NewVector(res), nil
+}
+
+// relativeResidual вычисляет ||b - A*x|| / ||b||
+func relativeResidual(t *testing.T, A LinearOperator[field.Float64], b, x *vector.Vector[field.Float64]) float64 {
+	ax, err := A.MulVec(x)
+	assert.NoError(t, err)
+	num, den := 0.0, 0.0
+	for i := range b.Data {
+		d := float64(b.Data[i] - ax.Data[i])
+		num += d * d
+		den += float64(b.Data[i] * b.Data[i])
+	}
+	return math.Sqrt(num / den)
+}
+
+func onesRHS(n int) *vector.Vector[field.Float64] {
+	b := make([]field.Float64, n)
+	for i := range b {
+		b[i] = 1
+	}
+	return vector.NewVector(b)
+}
+
+func TestKrylovSolvers(t *testing.T) {
+	a := gridLaplacian(20)
+	b := onesRHS(a.Rows)
+	jacobi, err := NewJacobiPreconditioner(a)
+	assert.NoError(t, err)
+	ilu, err := NewILU0Preconditioner(a)
+	assert.NoError(t, err)
+
+	solvers := map[string]func(LinearOperator[field.Float64], *vector.Vector[field.Float64], IterativeOptions) (*IterativeResult, error){
+		"CG":       SolveCG,
+		"GMRES":    SolveGMRES,
+		"BiCGSTAB": SolveBiCGSTAB,
+	}
+	for name, solve := range solvers {
+		iterations := map[string]int{}
+		for pname, p := range map[string]Preconditioner{"none": nil, "jacobi": jacobi, "ilu0": ilu} {
+			res, err := solve(a, b, IterativeOptions{Tol: 1e-10, MaxIter: 2000, Preconditioner: p})
+			assert.NoError(t, err, name+"/"+pname)
+			assert.True(t, res.Converged)
+			assert.Less(t, relativeResidual(t, a, b, res.X), 1e-8, name+"/"+pname)
+			assert.Len(t, res.History, res.Iterations+1)
+			iterations[pname] = res.Iterations
+		}
+		// ILU(0) существенно ускоряет сходимость на разностном лапласиане
+		assert.Less(t, iterations["ilu0"], iterations["none"], name)
+	}
+
+	t.Run("nonsymmetric", func(t *testing.T) {
+		// Конвекция–диффузия: несимметричная, но невырожденная матрица
+		n := 200
+		coo := NewCOO(n, n, field.Float64(0))
+		for i := 0; i < n; i++ {
+			coo.Append(i, i, 2)
+			if i > 0 {
+				coo.Append(i, i-1, -1.4)
+			}
+			if i < n-1 {
+				coo.Append(i, i+1, -0.6)
+			}
+		}
+		a := coo.ToCSR()
+		b := onesRHS(n)
+		for _, solve := range []func(LinearOperator[field.Float64], *vector.Vector[field.Float64], IterativeOptions) (*IterativeResult, error){SolveGMRES, SolveBiCGSTAB} {
+			res, err := solve(a, b, IterativeOptions{Restart: 20, MaxIter: 5000})
+			assert.NoError(t, err)
+			assert.Less(t, relativeResidual(t, a, b, res.X), 1e-8)
+		}
+	})
+
+	t.Run("matrix-free operator", func(t *testing.T) {
+		op := tridiagonalOperator{n: 50}
+		b := onesRHS(50)
+		res, err := SolveCG(op, b, IterativeOptions{})
+		assert.NoError(t, err)
+		// x_i = (i+1)(n-i)/2 — решение задачи -x'' = 1 с нулевыми краевыми условиями
+		for i, xi := range res.X.Data {
+			assert.InDelta(t, float64((i+1)*(50-i))/2, float64(xi), 1e-6)
+		}
+		_, err = SolveGaussSeidel(op, b, IterativeOptions{})
+		assert.Error(t, err)
+		_, err = NewILU0Preconditioner(op)
+		assert.Error(t, err)
+	})
+}
+
+func TestStationarySolvers(t *testing.T) {
+	dense, err := FromSlice([][]field.Float64{
+		{10, -1, 2, 0},
+		{-1, 11, -1, 3},
+		{2, -1, 10, -1},
+		{0, 3, -1, 8},
+	})
+	assert.NoError(t, err)
+	b := vector.NewVector([]field.Float64{6, 25, -11, 15})
+	expected := []float64{1, 2, -1, 1}
+
+	iterations := map[string]int{}
+	for name, solve := range map[string]func() (*IterativeResult, error){
+		"jacobi": func() (*IterativeResult, error) { return SolveJacobi(dense, b, IterativeOptions{Tol: 1e-12}) },
+		"gauss-seidel": func() (*IterativeResult, error) {
+			return SolveGaussSeidel(SparseFromDense(dense), b, IterativeOptions{Tol: 1e-12})
+		},
+		"sor": func() (*IterativeResult, error) {
+			return SolveSOR(dense, b, IterativeOptions{Tol: 1e-12, Omega: 1.1})
+		},
+	} {
+		res, err := solve()
+		assert.NoError(t, err, name)
+		for i, x := range res.X.Data {
+			assert.InDelta(t, expected[i], float64(x), 1e-9, name)
+		}
+		iterations[name] = res.Iterations
+	}
+	assert.Less(t, iterations["gauss-seidel"], iterations["jacobi"])
+
+	_, err = SolveSOR(dense, b, IterativeOptions{Omega: 2.5})
+	assert.Error(t, err)
+	singular, _ := FromSlice([][]field.Float64{{0, 1}, {1, 0}})
+	_, err = SolveJacobi(singular, vector.NewVector([]field.Float64{1, 1}), IterativeOptions{})
+	assert.Error(t, err)
+}
+
+func TestIterativeHistoryAndLimits(t *testing.T) {
+	a := gridLaplacian(10)
+	b := onesRHS(a.Rows)
+
+	var calls []float64
+	res, err := SolveCG(a, b, IterativeOptions{
+		MaxIter:     3,
+		OnIteration: func(iter int, residual float64) { calls = append(calls, residual) },
+	})
+	assert.ErrorIs(t, err, ErrNotConverged)
+	assert.False(t, res.Converged)
+	assert.Equal(t, 3, res.Iterations)
+	assert.Equal(t, res.History[1:], calls)
+	assert.Equal(t, 1.0, res.History[0])
+
+	// Начальное приближение, уже являющееся решением, не требует итераций
+	exact, err := SolveCG(a, b, IterativeOptions{})
+	assert.NoError(t, err)
+	res, err = SolveBiCGSTAB(a, b, IterativeOptions{X0: exact.X, Tol: 1e-8})
+	assert.NoError(t, err)
+	assert.Equal(t, 0, res.Iterations)
+
+	_, err = SolveGMRES(a, onesRHS(3), IterativeOptions{})
+	assert.ErrorIs(t, err, ErrDimensionMismatch)
+	_, err = SolveCG(NewMatrix(2, 3, field.Float64(1)), onesRHS(2), IterativeOptions{})
+	assert.ErrorIs(t, err, ErrDimensionMismatch)
+}
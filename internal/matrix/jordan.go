@@ -0,0 +1,303 @@
+package matrix
+
+import (
+	"MatrixGo/internal/field"
+	"MatrixGo/internal/polynomial"
+	"errors"
+	"math/big"
+	"sort"
+)
+
+const (
+	// maxRootSearchModulus ограничивает перебор корней многочлена над GF(p)
+	maxRootSearchModulus = 1 << 20
+	// maxRootSearchCoefficient ограничивает перебор делителей при поиске рациональных корней
+	maxRootSearchCoefficient = 1 << 40
+)
+
+// JordanBlock описывает жорданову клетку
+type JordanBlock[T field.Field[T]] struct {
+	Eigenvalue T   // собственное значение
+	Size       int // размер клетки
+}
+
+// JordanDecomposition хранит жорданову нормальную форму P^(-1)*A*P = J
+type JordanDecomposition[T field.Field[T]] struct {
+	J      *Matrix[T]       // жорданова матрица: λ на диагонали, единицы над диагональю внутри клеток
+	P      *Matrix[T]       // матрица перехода, столбцы — жордановы цепочки
+	Blocks []JordanBlock[T] // клетки в порядке следования по диагонали J
+}
+
+// eigenvalueMultiplicity — корень характеристического многочлена с алгебраической кратностью
+type eigenvalueMultiplicity[T field.Field[T]] struct {
+	value        T
+	multiplicity int
+}
+
+// JordanForm вычисляет жорданову форму над Rational или GF(p). Форма существует, только если
+// характеристический многочлен раскладывается на линейные множители над полем; иначе
+// возвращается ошибка (в этом случае используйте FrobeniusForm).
+func JordanForm[T field.Field[T]](m *Matrix[T]) (*JordanDecomposition[T], error) {
+	chi, err := CharacteristicPolynomial(m)
+	if err != nil {
+		return nil, err
+	}
+	roots, err := splitRoots(chi)
+	if err != nil {
+		return nil, err
+	}
+
+	n := m.Rows
+	zero := m.Data[0][0].Zero()
+	var cols [][]T
+	var blocks []JordanBlock[T]
+
+	for _, root := range roots {
+		N := m.Clone()
+		for i := 0; i < n; i++ {
+			N.Data[i][i] = N.Data[i][i].Sub(root.value)
+		}
+
+		// kernels[k] — базис ker N^k; рост останавливается на алгебраической кратности
+		kernels := [][][]T{nil}
+		Nk := N
+		for {
+			basis := NullSpace(Nk)
+			level := make([][]T, len(basis))
+			for i, b := range basis {
+				level[i] = b.Data
+			}
+			kernels = append(kernels, level)
+			if len(level) >= root.multiplicity {
+				break
+			}
+			Nk, _ = Nk.Mul(N)
+		}
+
+		// Начала цепочек выбираем от самых длинных: вектор из ker N^k, независимый
+		// от ker N^(k-1) и от образов уже выбранных более длинных цепочек
+		type chainHead struct {
+			x      []T
+			length int
+		}
+		var heads []chainHead
+		for k := len(kernels) - 1; k >= 1; k-- {
+			span := newEchelonSpan(zero)
+			for _, v := range kernels[k-1] {
+				span.add(v)
+			}
+			for _, h := range heads {
+				y := h.x
+				for j := 0; j < h.length-k; j++ {
+					y = matVec(N, y)
+				}
+				span.add(y)
+			}
+			for _, v := range kernels[k] {
+				if span.add(v) {
+					heads = append(heads, chainHead{x: v, length: k})
+				}
+			}
+		}
+
+		// Цепочка N^(k-1)*x, ..., N*x, x дает клетку с единицами над диагональю
+		for _, h := range heads {
+			chain := make([][]T, h.length)
+			chain[h.length-1] = h.x
+			for j := h.length - 2; j >= 0; j-- {
+				chain[j] = matVec(N, chain[j+1])
+			}
+			cols = append(cols, chain...)
+			blocks = append(blocks, JordanBlock[T]{Eigenvalue: root.value, Size: h.length})
+		}
+	}
+
+	if len(cols) != n {
+		return nil, errors.New("не удалось построить жорданов базис")
+	}
+
+	res := &JordanDecomposition[T]{
+		J:      NewMatrix[T](n, n, zero),
+		P:      NewMatrix[T](n, n, zero),
+		Blocks: blocks,
+	}
+	for j, col := range cols {
+		for i := 0; i < n; i++ {
+			res.P.Data[i][j] = col[i]
+		}
+	}
+	offset := 0
+	for _, b := range blocks {
+		for i := 0; i < b.Size; i++ {
+			res.J.Data[offset+i][offset+i] = b.Eigenvalue
+			if i+1 < b.Size {
+				res.J.Data[offset+i][offset+i+1] = zero.One()
+			}
+		}
+		offset += b.Size
+	}
+	return res, nil
+}
+
+// echelonSpan поддерживает ступенчатый базис линейной оболочки для проверки независимости
+type echelonSpan[T field.Field[T]] struct {
+	rows   [][]T
+	pivots []int
+	zero   T
+}
+
+func newEchelonSpan[T field.Field[T]](zero T) *echelonSpan[T] {
+	return &echelonSpan[T]{zero: zero}
+}
+
+// add добавляет вектор в оболочку и сообщает, был ли он линейно независим от нее
+func (s *echelonSpan[T]) add(v []T) bool {
+	w := append([]T(nil), v...)
+	for r, row := range s.rows {
+		c := w[s.pivots[r]]
+		if c.Equal(s.zero) {
+			continue
+		}
+		for i := range w {
+			w[i] = w[i].Sub(c.Mul(row[i]))
+		}
+	}
+
+	for i := range w {
+		if w[i].Equal(s.zero) {
+			continue
+		}
+		inv, err := s.zero.One().Div(w[i])
+		if err != nil {
+			return false
+		}
+		for j := range w {
+			w[j] = w[j].Mul(inv)
+		}
+		s.rows = append(s.rows, w)
+		s.pivots = append(s.pivots, i)
+		return true
+	}
+	return false
+}
+
+// splitRoots находит корни многочлена с кратностями над Rational или GF(p).
+// Возвращает ошибку, если многочлен не раскладывается на линейные множители.
+func splitRoots[T field.Field[T]](p *polynomial.Polynomial[T]) ([]eigenvalueMultiplicity[T], error) {
+	var candidates []T
+	switch q := any(p).(type) {
+	case *polynomial.Polynomial[field.Rational]:
+		c, err := rationalRootCandidates(q)
+		if err != nil {
+			return nil, err
+		}
+		candidates = any(c).([]T)
+	case *polynomial.Polynomial[field.GF]:
+		c, err := gfElements(q.Leading())
+		if err != nil {
+			return nil, err
+		}
+		candidates = any(c).([]T)
+	default:
+		return nil, errors.New("жорданова форма вычисляется точно только над rational и GF(p)")
+	}
+
+	zero := p.Leading().Zero()
+	one := zero.One()
+	rest := p
+	var roots []eigenvalueMultiplicity[T]
+	for _, c := range candidates {
+		mult := 0
+		for rest.Degree() > 0 && rest.Eval(c).Equal(zero) {
+			rest, _, _ = rest.DivMod(polynomial.New([]T{c.Neg(), one}, zero))
+			mult++
+		}
+		if mult > 0 {
+			roots = append(roots, eigenvalueMultiplicity[T]{value: c, multiplicity: mult})
+		}
+	}
+	if rest.Degree() > 0 {
+		return nil, errors.New("характеристический многочлен не раскладывается на линейные множители над полем")
+	}
+	return roots, nil
+}
+
+// rationalRootCandidates перечисляет по возрастанию все числа ±a/b, где a делит младший,
+// а b — старший ненулевой коэффициент многочлена, приведенного к целым коэффициентам
+func rationalRootCandidates(p *polynomial.Polynomial[field.Rational]) ([]field.Rational, error) {
+	denLCM := big.NewInt(1)
+	for _, c := range p.Coeffs {
+		g := new(big.Int).GCD(nil, nil, denLCM, c.Den())
+		denLCM.Mul(denLCM, new(big.Int).Quo(c.Den(), g))
+	}
+	ints := make([]*big.Int, len(p.Coeffs))
+	for i, c := range p.Coeffs {
+		ints[i] = new(big.Int).Mul(c.Num(), new(big.Int).Quo(denLCM, c.Den()))
+	}
+
+	candidates := []field.Rational{}
+	low := 0
+	for low < len(ints) && ints[low].Sign() == 0 {
+		low++
+	}
+	if low > 0 {
+		candidates = append(candidates, field.NewRational(0, 1))
+	}
+	if low >= len(ints)-1 {
+		return candidates, nil
+	}
+
+	limit := big.NewInt(maxRootSearchCoefficient)
+	a0 := new(big.Int).Abs(ints[low])
+	an := new(big.Int).Abs(ints[len(ints)-1])
+	if a0.Cmp(limit) > 0 || an.Cmp(limit) > 0 {
+		return nil, errors.New("коэффициенты характеристического многочлена слишком велики для поиска рациональных корней")
+	}
+
+	for _, num := range divisors(a0.Int64()) {
+		for _, den := range divisors(an.Int64()) {
+			candidates = append(candidates, field.NewRational(num, den), field.NewRational(-num, den))
+		}
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].Sub(candidates[j]).Sign() < 0
+	})
+	unique := candidates[:0]
+	for i, c := range candidates {
+		if i == 0 || !c.Equal(unique[len(unique)-1]) {
+			unique = append(unique, c)
+		}
+	}
+	return unique, nil
+}
+
+// divisors возвращает все положительные делители числа
+func divisors(n int64) []int64 {
+	var small, large []int64
+	for d := int64(1); d*d <= n; d++ {
+		if n%d == 0 {
+			small = append(small, d)
+			if d*d != n {
+				large = append(large, n/d)
+			}
+		}
+	}
+	for i := len(large) - 1; i >= 0; i-- {
+		small = append(small, large[i])
+	}
+	return small
+}
+
+// gfElements перечисляет все элементы поля GF(p), которому принадлежит x
+func gfElements(x field.GF) ([]field.GF, error) {
+	p := x.Modulus()
+	if p.Cmp(big.NewInt(maxRootSearchModulus)) > 0 {
+		return nil, errors.New("поле слишком велико для перебора корней")
+	}
+	elems := make([]field.GF, p.Int64())
+	for i := range elems {
+		elems[i], _ = field.NewGF(int64(i), p.Int64())
+	}
+	return elems, nil
+}
@@ -0,0 +1,87 @@
+package matrix
+
+import (
+	"MatrixGo/internal/field"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJordanForm(t *testing.T) {
+	t.Run("nontrivial blocks", func(t *testing.T) {
+		// χ = (x - 2)^3 (x - 1), ker(A - 2I) одномерно по двум цепочкам: клетки 2 и 1 для λ = 2
+		mat := ratMatrix(t, [][]int64{
+			{2, 1, 0, 0},
+			{0, 2, 0, 0},
+			{0, 0, 2, 0},
+			{1, 0, 0, 1},
+		})
+
+		res, err := JordanForm(mat)
+		assert.NoError(t, err)
+		assertSimilarity(t, mat, res.P, res.J)
+
+		sizes := map[string][]int{}
+		for _, b := range res.Blocks {
+			sizes[b.Eigenvalue.String()] = append(sizes[b.Eigenvalue.String()], b.Size)
+		}
+		assert.Equal(t, map[string][]int{"1": {1}, "2": {2, 1}}, sizes)
+	})
+
+	t.Run("single block", func(t *testing.T) {
+		mat := ratMatrix(t, [][]int64{{2, 1}, {-1, 0}})
+
+		res, err := JordanForm(mat)
+		assert.NoError(t, err)
+		assert.Len(t, res.Blocks, 1)
+		assert.Equal(t, 2, res.Blocks[0].Size)
+		assertMatrixEqual(t, ratMatrix(t, [][]int64{{1, 1}, {0, 1}}), res.J)
+		assertSimilarity(t, mat, res.P, res.J)
+	})
+
+	t.Run("rational eigenvalues", func(t *testing.T) {
+		// χ = (2x - 1)(x - 3) / 2
+		mat, _ := FromSlice([][]field.Rational{
+			{field.NewRational(1, 2), field.NewRational(1, 1)},
+			{field.NewRational(0, 1), field.NewRational(3, 1)},
+		})
+
+		res, err := JordanForm(mat)
+		assert.NoError(t, err)
+		assert.Len(t, res.Blocks, 2)
+		assert.True(t, res.Blocks[0].Eigenvalue.Equal(field.NewRational(1, 2)))
+		assert.True(t, res.Blocks[1].Eigenvalue.Equal(field.NewRational(3, 1)))
+		assertSimilarity(t, mat, res.P, res.J)
+	})
+
+	t.Run("finite field", func(t *testing.T) {
+		gf := func(v int64) field.GF {
+			x, _ := field.NewGF(v, 5)
+			return x
+		}
+		// Над Q корни x^2 + 1 не существуют, а над GF(5) это 2 и 3
+		mat, _ := FromSlice([][]field.GF{
+			{gf(0), gf(4)},
+			{gf(1), gf(0)},
+		})
+
+		res, err := JordanForm(mat)
+		assert.NoError(t, err)
+		assert.Len(t, res.Blocks, 2)
+		assertSimilarity(t, mat, res.P, res.J)
+	})
+
+	t.Run("not split", func(t *testing.T) {
+		mat := ratMatrix(t, [][]int64{{0, -1}, {1, 0}})
+
+		_, err := JordanForm(mat)
+		assert.Error(t, err)
+	})
+
+	t.Run("unsupported field", func(t *testing.T) {
+		mat, _ := FromSlice([][]field.Float64{{1, 0}, {0, 2}})
+
+		_, err := JordanForm(mat)
+		assert.Error(t, err)
+	})
+}
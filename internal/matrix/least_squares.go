@@ -0,0 +1,118 @@
+package matrix
+
+import (
+	"MatrixGo/internal/field"
+	"MatrixGo/internal/vector"
+	"errors"
+)
+
+// LeastSquaresResult содержит решение задачи наименьших квадратов min ||A*x - b||
+type LeastSquaresResult[T field.Field[T]] struct {
+	Solution *vector.Vector[T] // базисное решение (свободные переменные равны нулю)
+	Residual *vector.Vector[T] // невязка b - A*x
+	Rank     int               // ранг матрицы A
+}
+
+// LeastSquares решает переопределенную (или вырожденную) систему A*x = b в смысле
+// наименьших квадратов. Для Float64 и Complex используется QR-разложение Хаусхолдера
+// с выбором столбцов, для Rational — точное разложение Грама–Шмидта.
+func LeastSquares[T field.Field[T]](A *Matrix[T], b *vector.Vector[T]) (*LeastSquaresResult[T], error) {
+	if A.Rows != b.Len() {
+		return nil, errors.New("размер вектора не совпадает с количеством строк матрицы")
+	}
+
+	var res any
+	switch a := any(A).(type) {
+	case *Matrix[field.Float64]:
+		res = leastSquaresHouseholder(a, any(b).(*vector.Vector[field.Float64]))
+	case *Matrix[field.Complex]:
+		res = leastSquaresHouseholder(a, any(b).(*vector.Vector[field.Complex]))
+	case *Matrix[field.Rational]:
+		res = leastSquaresRational(a, any(b).(*vector.Vector[field.Rational]))
+	default:
+		return nil, errors.New("метод наименьших квадратов не поддерживается для данного типа поля")
+	}
+	return res.(*LeastSquaresResult[T]), nil
+}
+
+// leastSquaresHouseholder решает задачу через A*P = Q*R: R11*y = (Q^H*b)[:rank]
+func leastSquaresHouseholder[T field.Scalar[T]](A *Matrix[T], b *vector.Vector[T]) *LeastSquaresResult[T] {
+	qr := HouseholderQR(A, true)
+	zero := A.Data[0][0].Zero()
+
+	// c = Q^H * b
+	c := make([]T, A.Rows)
+	for i := 0; i < A.Rows; i++ {
+		sum := zero
+		for r := 0; r < A.Rows; r++ {
+			sum = sum.Add(qr.Q.Data[r][i].Conj().Mul(b.Data[r]))
+		}
+		c[i] = sum
+	}
+
+	// Обратная подстановка в ведущем блоке R размера rank×rank
+	y := make([]T, qr.Rank)
+	for i := qr.Rank - 1; i >= 0; i-- {
+		sum := c[i]
+		for j := i + 1; j < qr.Rank; j++ {
+			sum = sum.Sub(qr.R.Data[i][j].Mul(y[j]))
+		}
+		y[i], _ = sum.Div(qr.R.Data[i][i])
+	}
+
+	x := make([]T, A.Cols)
+	for j := range x {
+		x[j] = zero
+	}
+	for j := 0; j < qr.Rank; j++ {
+		x[qr.Perm[j]] = y[j]
+	}
+
+	return newLeastSquaresResult(A, b, x, qr.Rank)
+}
+
+// leastSquaresRational решает задачу точно: проекция b на линейно независимые столбцы Q
+// дает коэффициенты c_j = <b, q_j>/D[j], после чего решается унитреугольная система R*x = c
+func leastSquaresRational(A *Matrix[field.Rational], b *vector.Vector[field.Rational]) *LeastSquaresResult[field.Rational] {
+	qr := GramSchmidtQR(A)
+	zero := A.Data[0][0].Zero()
+	bMat := NewMatrix[field.Rational](b.Len(), 1, zero)
+	for i := 0; i < b.Len(); i++ {
+		bMat.Data[i][0] = b.Data[i]
+	}
+
+	x := make([]field.Rational, A.Cols)
+	for j := range x {
+		x[j] = zero
+	}
+	for j := A.Cols - 1; j >= 0; j-- {
+		if qr.D[j].Equal(zero) {
+			continue
+		}
+		c, _ := dotColumns(bMat, 0, qr.Q, j).Div(qr.D[j])
+		for l := j + 1; l < A.Cols; l++ {
+			c = c.Sub(qr.R.Data[j][l].Mul(x[l]))
+		}
+		x[j] = c
+	}
+
+	return newLeastSquaresResult(A, b, x, qr.Rank)
+}
+
+// newLeastSquaresResult вычисляет невязку и собирает результат
+func newLeastSquaresResult[T field.Field[T]](A *Matrix[T], b *vector.Vector[T], x []T, rank int) *LeastSquaresResult[T] {
+	residual := make([]T, A.Rows)
+	for i := 0; i < A.Rows; i++ {
+		sum := b.Data[i]
+		for j := 0; j < A.Cols; j++ {
+			sum = sum.Sub(A.Data[i][j].Mul(x[j]))
+		}
+		residual[i] = sum
+	}
+
+	return &LeastSquaresResult[T]{
+		Solution: vector.NewVector(x),
+		Residual: vector.NewVector(residual),
+		Rank:     rank,
+	}
+}
@@ -0,0 +1,230 @@
+package matrix
+
+import (
+	"MatrixGo/internal/field"
+	"errors"
+	"math"
+	"math/big"
+)
+
+// LatticeField — поля, над которыми выполняется LLL-приведение: упорядоченные и допускающие
+// округление до целого (точный field.Rational и приближенный field.Float64)
+type LatticeField[T any] interface {
+	field.Field[T]
+	field.Rational | field.Float64
+}
+
+// LLLResult хранит LLL-приведенный базис решетки. Векторы базиса — строки матриц.
+type LLLResult[T LatticeField[T]] struct {
+	Basis       *Matrix[T] // приведенный базис
+	U           *Matrix[T] // унимодулярная матрица: Basis = U * исходный базис
+	GramSchmidt *Matrix[T] // ортогонализованные векторы b*_i приведенного базиса
+	Mu          *Matrix[T] // коэффициенты Грама–Шмидта μ_ij = <b_i, b*_j> / <b*_j, b*_j>, единицы на диагонали
+	Norms       []T        // квадраты норм ||b*_i||²
+	Delta       T          // параметр δ условия Ловаса
+	Swaps       int        // количество перестановок
+}
+
+// ShortestVectorEstimate содержит оценки длины кратчайшего ненулевого вектора решетки λ_1
+type ShortestVectorEstimate struct {
+	Vector            []float64 // первый вектор приведенного базиса — найденный короткий вектор
+	Length            float64   // его длина ||b_1|| ≥ λ_1
+	LowerBound        float64   // гарантированная нижняя оценка λ_1
+	ApproxFactor      float64   // гарантия LLL: ||b_1|| ≤ ApproxFactor * λ_1, ApproxFactor = α^((n-1)/2), α = 1/(δ - 1/4)
+	GaussianHeuristic float64   // эвристика Гаусса sqrt(n / (2πe)) * det(L)^(1/n)
+	MinkowskiBound    float64   // теорема Минковского: λ_1 ≤ sqrt(n) * det(L)^(1/n)
+}
+
+// LLL выполняет LLL-приведение базиса в точной рациональной арифметике.
+// Строки m — линейно независимые векторы базиса; δ должно лежать в (1/4, 1].
+func LLL(m *Matrix[field.Rational], delta field.Rational) (*LLLResult[field.Rational], error) {
+	quarter := field.NewRational(1, 4)
+	if delta.Sub(quarter).Sign() <= 0 || delta.Sub(quarter.One()).Sign() > 0 {
+		return nil, errors.New("параметр delta должен лежать в интервале (1/4, 1]")
+	}
+	return lll(m, delta)
+}
+
+// LLLFloat выполняет LLL-приведение в арифметике с плавающей точкой. Это заметно быстрее
+// точного варианта, но при больших коэффициентах возможна потеря точности.
+func LLLFloat(m *Matrix[field.Float64], delta float64) (*LLLResult[field.Float64], error) {
+	if delta <= 0.25 || delta > 1 {
+		return nil, errors.New("параметр delta должен лежать в интервале (1/4, 1]")
+	}
+	return lll(m, field.Float64(delta))
+}
+
+// roundNearest округляет элемент поля до ближайшего целого
+func roundNearest[T LatticeField[T]](x T) T {
+	if v, ok := any(x).(field.Rational); ok {
+		// floor(x + 1/2)
+		num := new(big.Int).Mul(v.Num(), big.NewInt(2))
+		num.Add(num, v.Den())
+		den := new(big.Int).Mul(v.Den(), big.NewInt(2))
+		return any(field.NewRationalFromBig(new(big.Int).Div(num, den), big.NewInt(1))).(T)
+	}
+	return any(field.Float64(math.Round(toFloat64(x)))).(T)
+}
+
+// fieldLess сравнивает элементы упорядоченного поля
+func fieldLess[T LatticeField[T]](a, b T) bool {
+	return any(a.Sub(b)).(field.Signed).Sign() < 0
+}
+
+// toFloat64 переводит элемент упорядоченного поля в число с плавающей точкой
+func toFloat64[T LatticeField[T]](x T) float64 {
+	if v, ok := any(x).(field.Rational); ok {
+		return v.Float64()
+	}
+	return float64(any(x).(field.Float64))
+}
+
+// gramSchmidtRows ортогонализует строки матрицы: b*_i = b_i - Σ μ_ij b*_j
+func gramSchmidtRows[T field.Field[T]](b *Matrix[T]) (bstar, mu *Matrix[T], norms []T) {
+	n := b.Rows
+	zero := b.Data[0][0].Zero()
+	bstar = b.Clone()
+	mu = IdentityMatrix[T](n, zero, zero.One())
+	norms = make([]T, n)
+	dot := func(x, y []T) T {
+		sum := zero
+		for k := range x {
+			sum = sum.Add(x[k].Mul(y[k]))
+		}
+		return sum
+	}
+
+	for i := 0; i < n; i++ {
+		for j := 0; j < i; j++ {
+			if norms[j].Equal(zero) {
+				continue
+			}
+			mu.Data[i][j], _ = dot(b.Data[i], bstar.Data[j]).Div(norms[j])
+			for k := range bstar.Data[i] {
+				bstar.Data[i][k] = bstar.Data[i][k].Sub(mu.Data[i][j].Mul(bstar.Data[j][k]))
+			}
+		}
+		norms[i] = dot(bstar.Data[i], bstar.Data[i])
+	}
+	return bstar, mu, norms
+}
+
+// lll реализует алгоритм LLL с пересчетом коэффициентов Грама–Шмидта при перестановках
+// (алгоритм 2.6.3 из книги Коэна). Поле должно быть упорядоченным и допускать округление.
+func lll[T LatticeField[T]](m *Matrix[T], delta T) (*LLLResult[T], error) {
+	n := m.Rows
+	zero := m.Data[0][0].Zero()
+	one := zero.One()
+	half, _ := one.Div(one.Add(one))
+
+	B := m.Clone()
+	U := IdentityMatrix[T](n, zero, one)
+	_, mu, norms := gramSchmidtRows(B)
+	for _, d := range norms {
+		if d.Equal(zero) {
+			return nil, errors.New("векторы базиса линейно зависимы")
+		}
+	}
+
+	// sizeReduce добивается |μ_kl| ≤ 1/2 вычитанием из b_k кратного b_l
+	sizeReduce := func(k, l int) {
+		absMu := mu.Data[k][l]
+		if fieldLess(absMu, zero) {
+			absMu = absMu.Neg()
+		}
+		if !fieldLess(half, absMu) {
+			return
+		}
+		q := roundNearest(mu.Data[k][l])
+		for j := 0; j < B.Cols; j++ {
+			B.Data[k][j] = B.Data[k][j].Sub(q.Mul(B.Data[l][j]))
+		}
+		for j := 0; j < n; j++ {
+			U.Data[k][j] = U.Data[k][j].Sub(q.Mul(U.Data[l][j]))
+		}
+		mu.Data[k][l] = mu.Data[k][l].Sub(q)
+		for j := 0; j < l; j++ {
+			mu.Data[k][j] = mu.Data[k][j].Sub(q.Mul(mu.Data[l][j]))
+		}
+	}
+
+	// swap меняет местами b_k и b_(k-1) и обновляет μ и ||b*||²
+	swaps := 0
+	swap := func(k int) {
+		B.Data[k], B.Data[k-1] = B.Data[k-1], B.Data[k]
+		U.Data[k], U.Data[k-1] = U.Data[k-1], U.Data[k]
+		for j := 0; j < k-1; j++ {
+			mu.Data[k][j], mu.Data[k-1][j] = mu.Data[k-1][j], mu.Data[k][j]
+		}
+
+		c := mu.Data[k][k-1]
+		bNew := norms[k].Add(c.Mul(c).Mul(norms[k-1]))
+		mu.Data[k][k-1], _ = c.Mul(norms[k-1]).Div(bNew)
+		norms[k], _ = norms[k-1].Mul(norms[k]).Div(bNew)
+		norms[k-1] = bNew
+		for i := k + 1; i < n; i++ {
+			t := mu.Data[i][k]
+			mu.Data[i][k] = mu.Data[i][k-1].Sub(c.Mul(t))
+			mu.Data[i][k-1] = t.Add(mu.Data[k][k-1].Mul(mu.Data[i][k]))
+		}
+		swaps++
+	}
+
+	for k := 1; k < n; {
+		sizeReduce(k, k-1)
+		// Условие Ловаса: ||b*_k||² ≥ (δ - μ²_k,k-1) * ||b*_(k-1)||²
+		c := mu.Data[k][k-1]
+		if fieldLess(norms[k], delta.Sub(c.Mul(c)).Mul(norms[k-1])) {
+			swap(k)
+			k = max(k-1, 1)
+			continue
+		}
+		for l := k - 2; l >= 0; l-- {
+			sizeReduce(k, l)
+		}
+		k++
+	}
+
+	bstar, mu, norms := gramSchmidtRows(B)
+	return &LLLResult[T]{
+		Basis:       B,
+		U:           U,
+		GramSchmidt: bstar,
+		Mu:          mu,
+		Norms:       norms,
+		Delta:       delta,
+		Swaps:       swaps,
+	}, nil
+}
+
+// ShortestVectorEstimate оценивает длину кратчайшего вектора решетки по приведенному базису.
+// Нижняя оценка — максимум из min ||b*_i|| и ||b_1|| / α^((n-1)/2); в оценках через
+// определитель используется объем решетки det(L) = Π ||b*_i||.
+func (r *LLLResult[T]) ShortestVectorEstimate() ShortestVectorEstimate {
+	n := r.Basis.Rows
+	res := ShortestVectorEstimate{Vector: make([]float64, r.Basis.Cols)}
+	norm2 := 0.0
+	for j, x := range r.Basis.Data[0] {
+		res.Vector[j] = toFloat64(x)
+		norm2 += res.Vector[j] * res.Vector[j]
+	}
+	res.Length = math.Sqrt(norm2)
+
+	// log det(L) = Σ log ||b*_i||, чтобы не переполниться при больших решетках
+	logDet := 0.0
+	minStar := math.Inf(1)
+	for _, d := range r.Norms {
+		s := math.Sqrt(toFloat64(d))
+		logDet += math.Log(s)
+		minStar = math.Min(minStar, s)
+	}
+
+	alpha := 1 / (toFloat64(r.Delta) - 0.25)
+	res.ApproxFactor = math.Pow(alpha, float64(n-1)/2)
+	res.LowerBound = math.Max(minStar, res.Length/res.ApproxFactor)
+
+	root := math.Exp(logDet / float64(n))
+	res.GaussianHeuristic = math.Sqrt(float64(n)/(2*math.Pi*math.E)) * root
+	res.MinkowskiBound = math.Sqrt(float64(n)) * root
+	return res
+}
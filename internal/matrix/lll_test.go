@@ -0,0 +1,131 @@
+package matrix
+
+import (
+	"MatrixGo/internal/field"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// assertLLLReduced проверяет условия размерной приведенности и Ловаса
+func assertLLLReduced(t *testing.T, res *LLLResult[field.Rational]) {
+	t.Helper()
+	half := field.NewRational(1, 2)
+	for i := 1; i < res.Basis.Rows; i++ {
+		for j := 0; j < i; j++ {
+			mu := res.Mu.Data[i][j]
+			if mu.Sign() < 0 {
+				mu = mu.Neg()
+			}
+			assert.True(t, mu.Sub(half).Sign() <= 0, "|μ_%d%d| > 1/2", i, j)
+		}
+		c := res.Mu.Data[i][i-1]
+		rhs := res.Delta.Sub(c.Mul(c)).Mul(res.Norms[i-1])
+		assert.True(t, res.Norms[i].Sub(rhs).Sign() >= 0, "нарушено условие Ловаса для k = %d", i)
+	}
+}
+
+func TestLLL(t *testing.T) {
+	t.Run("known basis", func(t *testing.T) {
+		mat := ratMatrix(t, [][]int64{
+			{1, 1, 1},
+			{-1, 0, 2},
+			{3, 5, 6},
+		})
+
+		res, err := LLL(mat, field.NewRational(3, 4))
+		assert.NoError(t, err)
+		assertMatrixEqual(t, ratMatrix(t, [][]int64{{0, 1, 0}, {1, 0, 1}, {-1, 0, 2}}), res.Basis)
+		assertLLLReduced(t, res)
+
+		assertUnimodular(t, res.U)
+		UB, _ := res.U.Mul(mat)
+		assertMatrixEqual(t, res.Basis, UB)
+	})
+
+	t.Run("random lattice", func(t *testing.T) {
+		rng := rand.New(rand.NewSource(6))
+		rows := make([][]int64, 6)
+		for i := range rows {
+			rows[i] = make([]int64, 6)
+			for j := range rows[i] {
+				rows[i][j] = rng.Int63n(2001) - 1000
+			}
+		}
+		mat := ratMatrix(t, rows)
+
+		res, err := LLL(mat, field.NewRational(99, 100))
+		assert.NoError(t, err)
+		assertLLLReduced(t, res)
+		assertUnimodular(t, res.U)
+		UB, _ := res.U.Mul(mat)
+		assertMatrixEqual(t, res.Basis, UB)
+
+		// Объем решетки не меняется
+		assert.True(t, mat.Determinant().Mul(mat.Determinant()).Equal(res.Basis.Determinant().Mul(res.Basis.Determinant())))
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		_, err := LLL(ratMatrix(t, [][]int64{{1, 0}, {0, 1}}), field.NewRational(1, 4))
+		assert.Error(t, err)
+
+		_, err = LLL(ratMatrix(t, [][]int64{{1, 2}, {2, 4}}), field.NewRational(3, 4))
+		assert.Error(t, err)
+	})
+}
+
+func TestLLLFloat(t *testing.T) {
+	rows := [][]int64{
+		{1, 1, 1},
+		{-1, 0, 2},
+		{3, 5, 6},
+	}
+	data := make([][]field.Float64, len(rows))
+	for i := range rows {
+		data[i] = make([]field.Float64, len(rows[i]))
+		for j := range rows[i] {
+			data[i][j] = field.Float64(rows[i][j])
+		}
+	}
+	mat, _ := FromSlice(data)
+
+	res, err := LLLFloat(mat, 0.75)
+	assert.NoError(t, err)
+	exact, err := LLL(ratMatrix(t, rows), field.NewRational(3, 4))
+	assert.NoError(t, err)
+	for i := range rows {
+		for j := range rows[i] {
+			assert.InDelta(t, exact.Basis.Data[i][j].Float64(), float64(res.Basis.Data[i][j]), 1e-9)
+		}
+	}
+}
+
+func TestShortestVectorEstimate(t *testing.T) {
+	// Решетка из задачи о рюкзаке: кратчайший вектор соответствует решению 3 + 5 + 9 = 17
+	mat := ratMatrix(t, [][]int64{
+		{1, 0, 0, 0, 100 * 3},
+		{0, 1, 0, 0, 100 * 5},
+		{0, 0, 1, 0, 100 * 7},
+		{0, 0, 0, 1, 100 * 9},
+		{0, 0, 0, 0, 100 * 17},
+	})
+
+	res, err := LLL(mat, field.NewRational(99, 100))
+	assert.NoError(t, err)
+	est := res.ShortestVectorEstimate()
+
+	assert.InDelta(t, math.Sqrt(3), est.Length, 1e-9)
+	assert.LessOrEqual(t, est.LowerBound, est.Length)
+	assert.LessOrEqual(t, est.Length, est.ApproxFactor*est.LowerBound+1e-9)
+	assert.Greater(t, est.MinkowskiBound, est.GaussianHeuristic)
+	nonzero := 0
+	for _, x := range est.Vector {
+		assert.Equal(t, math.Round(x), x)
+		if x != 0 && math.Abs(x) < 100 {
+			nonzero++
+		}
+	}
+	assert.Equal(t, 3, nonzero)
+}
@@ -0,0 +1,267 @@
+package matrix
+
+import (
+	"MatrixGo/internal/field"
+	"MatrixGo/internal/vector"
+	"errors"
+)
+
+// LUFactorization хранит разложение PA = LU, которое можно многократно использовать
+// для решения систем, вычисления определителя и обратной матрицы без повторного исключения
+type LUFactorization[T field.Field[T]] struct {
+	L      *Matrix[T] // нижняя унитреугольная матрица Rows×Rows
+	U      *Matrix[T] // верхняя треугольная (ступенчатая) матрица Rows×Cols
+	Perm   []int      // строка i матрицы PA — это строка Perm[i] матрицы A
+	Swaps  int        // количество перестановок строк (определяет знак определителя)
+	Rank   int        // ранг матрицы
+	Pivots []int      // столбцы ведущих элементов U
+}
+
+// luRowEliminator обнуляет элементы столбца k в строках ниже ведущей строки h,
+// записывая множители в L
+type luRowEliminator[T field.Field[T]] func(A, L *Matrix[T], h, k int)
+
+// eliminateLURows последовательно исключает строки [start, end) с помощью ведущей строки h
+func eliminateLURows[T field.Field[T]](A, L *Matrix[T], h, k, start, end int) {
+	for i := start; i < end; i++ {
+		if A.Data[i][k].Equal(A.Data[i][k].Zero()) {
+			continue
+		}
+		factor, _ := A.Data[i][k].Div(A.Data[h][k])
+		L.Data[i][h] = factor
+		for j := k; j < A.Cols; j++ {
+			A.Data[i][j] = A.Data[i][j].Sub(factor.Mul(A.Data[h][j]))
+		}
+	}
+}
+
+// luPivotTolerance возвращает порог, ниже которого ведущий элемент числовой матрицы считается
+// нулем ошибки округления: n*eps*max|a_ij|. Для точных полей порог не нужен.
+func luPivotTolerance[T field.Field[T]](m *Matrix[T]) float64 {
+	if _, ok := pivotMagnitude(m.Data[0][0]); !ok {
+		return 0
+	}
+	norm, err := m.Norm(NormMax)
+	if err != nil {
+		return 0
+	}
+	return float64(max(m.Rows, m.Cols)) * machineEpsilon * norm
+}
+
+// findLUPivotRow выбирает ведущую строку в столбце k среди строк h и ниже. Для числовых полей
+// (Float64, Complex) берется элемент наибольшего модуля — частичный выбор ведущего элемента,
+// ограничивающий рост множителей L; элементы, равные нулю в смысле Equal или не больше tol,
+// считаются нулевыми, так что численно вырожденная матрица распознается как вырожденная
+// (так же, как в Rank). Для точных полей достаточно первого ненулевого.
+func findLUPivotRow[T field.Field[T]](A *Matrix[T], h, k int, tol float64) int {
+	if _, ok := pivotMagnitude(A.Data[h][k]); !ok {
+		return findPivotRow(A, h, k)
+	}
+	zero := A.Data[h][k].Zero()
+	p, best := -1, tol
+	for i := h; i < A.Rows; i++ {
+		if A.Data[i][k].Equal(zero) {
+			continue
+		}
+		if v, _ := pivotMagnitude(A.Data[i][k]); v > best {
+			p, best = i, v
+		}
+	}
+	return p
+}
+
+// luFactorize выполняет разложение с частичным выбором ведущего элемента.
+// В режиме rankRevealing нулевые столбцы пропускаются, и U получается ступенчатой;
+// иначе матрица должна быть квадратной и невырожденной.
+func luFactorize[T field.Field[T]](m *Matrix[T], rankRevealing bool, eliminate luRowEliminator[T]) (*LUFactorization[T], error) {
+	if !rankRevealing && m.Rows != m.Cols {
+		return nil, errors.New("матрица должна быть квадратной")
+	}
+
+	A := m.Clone()
+	L := IdentityMatrix[T](m.Rows, m.Data[0][0].Zero(), m.Data[0][0].One())
+	perm := make([]int, m.Rows)
+	for i := range perm {
+		perm[i] = i
+	}
+
+	swaps := 0
+	pivots := make([]int, 0)
+	tol := luPivotTolerance(m)
+	h := 0
+	for k := 0; k < m.Cols && h < m.Rows; k++ {
+		p := findLUPivotRow(A, h, k, tol)
+		if p < 0 {
+			if !rankRevealing {
+				return nil, errors.New("матрица вырождена")
+			}
+			continue
+		}
+
+		if p != h {
+			A.Data[h], A.Data[p] = A.Data[p], A.Data[h]
+			perm[h], perm[p] = perm[p], perm[h]
+			// Переставляем уже вычисленные множители L
+			for j := 0; j < h; j++ {
+				L.Data[h][j], L.Data[p][j] = L.Data[p][j], L.Data[h][j]
+			}
+			swaps++
+		}
+
+		eliminate(A, L, h, k)
+
+		pivots = append(pivots, k)
+		h++
+	}
+
+	return &LUFactorization[T]{
+		L:      L,
+		U:      A,
+		Perm:   perm,
+		Swaps:  swaps,
+		Rank:   len(pivots),
+		Pivots: pivots,
+	}, nil
+}
+
+// LU вычисляет разложение PA = LU.
+// При rankRevealing = true допускаются вырожденные и прямоугольные матрицы:
+// U получается ступенчатой, а ранг и ведущие столбцы сохраняются в результате.
+func (m *Matrix[T]) LU(rankRevealing bool) (*LUFactorization[T], error) {
+	return luFactorize(m, rankRevealing, func(A, L *Matrix[T], h, k int) {
+		eliminateLURows(A, L, h, k, h+1, A.Rows)
+	})
+}
+
+// IsSingular сообщает, является ли разложенная матрица вырожденной (или неквадратной)
+func (f *LUFactorization[T]) IsSingular() bool {
+	return f.U.Rows != f.U.Cols || f.Rank < f.U.Rows
+}
+
+// Solve решает систему A*x = b прямой и обратной подстановкой
+func (f *LUFactorization[T]) Solve(b *vector.Vector[T]) (*vector.Vector[T], error) {
+	if f.IsSingular() {
+		return nil, errors.New("матрица вырождена, решение невозможно")
+	}
+	n := f.U.Rows
+	if b.Len() != n {
+		return nil, errors.New("размер вектора не совпадает с размером матрицы")
+	}
+
+	// Прямая подстановка: L*y = P*b
+	y := make([]T, n)
+	for i := 0; i < n; i++ {
+		sum := b.Data[f.Perm[i]]
+		for j := 0; j < i; j++ {
+			sum = sum.Sub(f.L.Data[i][j].Mul(y[j]))
+		}
+		y[i] = sum
+	}
+
+	// Обратная подстановка: U*x = y
+	x := make([]T, n)
+	for i := n - 1; i >= 0; i-- {
+		sum := y[i]
+		for j := i + 1; j < n; j++ {
+			sum = sum.Sub(f.U.Data[i][j].Mul(x[j]))
+		}
+		x[i], _ = sum.Div(f.U.Data[i][i])
+	}
+
+	return vector.NewVector(x), nil
+}
+
+// SolveTranspose решает систему Aᵀ*x = b с теми же множителями: Uᵀ*z = b, Lᵀ*w = z, x = Pᵀ*w
+func (f *LUFactorization[T]) SolveTranspose(b *vector.Vector[T]) (*vector.Vector[T], error) {
+	if f.IsSingular() {
+		return nil, errors.New("матрица вырождена, решение невозможно")
+	}
+	n := f.U.Rows
+	if b.Len() != n {
+		return nil, errors.New("размер вектора не совпадает с размером матрицы")
+	}
+
+	// Прямая подстановка с Uᵀ (нижняя треугольная)
+	z := make([]T, n)
+	for i := 0; i < n; i++ {
+		sum := b.Data[i]
+		for j := 0; j < i; j++ {
+			sum = sum.Sub(f.U.Data[j][i].Mul(z[j]))
+		}
+		z[i], _ = sum.Div(f.U.Data[i][i])
+	}
+
+	// Обратная подстановка с Lᵀ (верхняя унитреугольная) и обратная перестановка
+	w := make([]T, n)
+	for i := n - 1; i >= 0; i-- {
+		sum := z[i]
+		for j := i + 1; j < n; j++ {
+			sum = sum.Sub(f.L.Data[j][i].Mul(w[j]))
+		}
+		w[i] = sum
+	}
+	x := make([]T, n)
+	for i, p := range f.Perm {
+		x[p] = w[i]
+	}
+	return vector.NewVector(x), nil
+}
+
+// SolveMany решает систему A*X = B для матрицы правых частей B (по столбцам)
+func (f *LUFactorization[T]) SolveMany(B *Matrix[T]) (*Matrix[T], error) {
+	if B.Rows != f.U.Rows {
+		return nil, errors.New("количество строк правой части не совпадает с размером матрицы")
+	}
+
+	X := NewMatrix[T](f.U.Cols, B.Cols, B.Data[0][0].Zero())
+	column := make([]T, B.Rows)
+	for j := 0; j < B.Cols; j++ {
+		for i := 0; i < B.Rows; i++ {
+			column[i] = B.Data[i][j]
+		}
+		x, err := f.Solve(vector.NewVector(column))
+		if err != nil {
+			return nil, err
+		}
+		for i := 0; i < X.Rows; i++ {
+			X.Data[i][j] = x.Data[i]
+		}
+	}
+	return X, nil
+}
+
+// Determinant вычисляет определитель как произведение диагонали U с учетом знака перестановки
+func (f *LUFactorization[T]) Determinant() T {
+	zero := f.U.Data[0][0].Zero()
+	if f.IsSingular() {
+		return zero
+	}
+
+	det := zero.One()
+	for i := 0; i < f.U.Rows; i++ {
+		det = det.Mul(f.U.Data[i][i])
+	}
+	if f.Swaps%2 == 1 {
+		det = det.Neg()
+	}
+	return det
+}
+
+// Inverse вычисляет обратную матрицу, решая систему с единичной правой частью
+func (f *LUFactorization[T]) Inverse() (*Matrix[T], error) {
+	if f.IsSingular() {
+		return nil, errors.New("матрица вырождена")
+	}
+	n := f.U.Rows
+	return f.SolveMany(IdentityMatrix[T](n, f.U.Data[0][0].Zero(), f.U.Data[0][0].One()))
+}
+
+// P возвращает матрицу перестановки P, такую что P*A = L*U
+func (f *LUFactorization[T]) P() *Matrix[T] {
+	zero := f.U.Data[0][0].Zero()
+	P := NewMatrix[T](len(f.Perm), len(f.Perm), zero)
+	for i, p := range f.Perm {
+		P.Data[i][p] = zero.One()
+	}
+	return P
+}
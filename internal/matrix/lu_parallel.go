@@ -0,0 +1,34 @@
+package matrix
+
+import (
+	"runtime"
+	"sync"
+)
+
+// LUParallel вычисляет разложение PA = LU, распределяя исключение строк между горутинами
+func (m *Matrix[T]) LUParallel(rankRevealing bool) (*LUFactorization[T], error) {
+	return luFactorize(m, rankRevealing, func(A, L *Matrix[T], h, k int) {
+		rowCount := A.Rows
+
+		var wg sync.WaitGroup
+		numCPU := runtime.NumCPU()
+		rowsPerGoroutine := (rowCount - h - 1) / numCPU
+		if rowsPerGoroutine < 1 {
+			rowsPerGoroutine = 1
+		}
+
+		for startRow := h + 1; startRow < rowCount; startRow += rowsPerGoroutine {
+			endRow := startRow + rowsPerGoroutine
+			if endRow > rowCount {
+				endRow = rowCount
+			}
+
+			wg.Add(1)
+			go func(start, end int) {
+				defer wg.Done()
+				eliminateLURows(A, L, h, k, start, end)
+			}(startRow, endRow)
+		}
+		wg.Wait()
+	})
+}
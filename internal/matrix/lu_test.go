@@ -0,0 +1,141 @@
+package matrix
+
+import (
+	"MatrixGo/internal/field"
+	"MatrixGo/internal/vector"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLU(t *testing.T) {
+	mat := ratMatrix(t, [][]int64{
+		{0, 2, 1},
+		{1, 1, 1},
+		{2, 1, 3},
+	})
+
+	for name, factorize := range map[string]func(bool) (*LUFactorization[field.Rational], error){
+		"serial":   mat.LU,
+		"parallel": mat.LUParallel,
+	} {
+		t.Run(name, func(t *testing.T) {
+			lu, err := factorize(false)
+			assert.NoError(t, err)
+			assert.Equal(t, 3, lu.Rank)
+
+			// Проверяем PA = LU
+			pa, _ := lu.P().Mul(mat)
+			prod, _ := lu.L.Mul(lu.U)
+			assertMatrixEqual(t, pa, prod)
+
+			assert.True(t, mat.Determinant().Equal(lu.Determinant()))
+
+			inv, err := lu.Inverse()
+			assert.NoError(t, err)
+			expectedInv, _ := mat.InverseParallel()
+			assertMatrixEqual(t, expectedInv, inv)
+
+			b := ratVector(3, 3, 6)
+			x, err := lu.Solve(b)
+			assert.NoError(t, err)
+			expected, _ := SolveSystem(mat, b)
+			for i := range x.Data {
+				assert.True(t, expected.Data[i].Equal(x.Data[i]))
+			}
+
+			B := ratMatrix(t, [][]int64{{3, 1}, {3, 0}, {6, 2}})
+			X, err := lu.SolveMany(B)
+			assert.NoError(t, err)
+			AX, _ := mat.Mul(X)
+			assertMatrixEqual(t, B, AX)
+		})
+	}
+}
+
+func TestLURankRevealing(t *testing.T) {
+	mat := ratMatrix(t, [][]int64{
+		{1, 2, 3, 4},
+		{2, 4, 6, 8},
+		{1, 0, 1, 0},
+	})
+
+	_, err := mat.LU(false)
+	assert.Error(t, err)
+
+	lu, err := mat.LU(true)
+	assert.NoError(t, err)
+	assert.Equal(t, mat.Rank(), lu.Rank)
+	assert.Equal(t, []int{0, 1}, lu.Pivots)
+	assert.True(t, lu.IsSingular())
+
+	pa, _ := lu.P().Mul(mat)
+	prod, _ := lu.L.Mul(lu.U)
+	assertMatrixEqual(t, pa, prod)
+
+	_, err = lu.Solve(ratVector(1, 2, 3))
+	assert.Error(t, err)
+	assert.True(t, lu.Determinant().Equal(field.NewRational(0, 1)))
+}
+
+func TestLUPartialPivoting(t *testing.T) {
+	// Без выбора наибольшего по модулю элемента множитель 1e20 уничтожает решение (1, 1)
+	tiny, _ := FromSlice([][]field.Float64{{1e-20, 1}, {1, 1}})
+	lu, err := tiny.LU(false)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 0}, lu.Perm)
+	x, err := lu.Solve(vector.NewVector([]field.Float64{1, 2}))
+	assert.NoError(t, err)
+	assert.InDelta(t, 1, float64(x.Data[0]), 1e-15)
+	assert.InDelta(t, 1, float64(x.Data[1]), 1e-15)
+
+	// Хорошо обусловленная матрица с малым ведущим элементом: обратная ошибка порядка eps
+	rng := rand.New(rand.NewSource(30))
+	mat := randomFloatMatrix(rng, 20)
+	mat.Data[0][0] = 1e-8
+	b := vector.NewVector(make([]field.Float64, 20))
+	for i := range b.Data {
+		b.Data[i] = field.Float64(rng.Float64())
+	}
+	for name, factorize := range map[string]func(bool) (*LUFactorization[field.Float64], error){
+		"serial":   mat.LU,
+		"parallel": mat.LUParallel,
+	} {
+		t.Run(name, func(t *testing.T) {
+			lu, err := factorize(false)
+			assert.NoError(t, err)
+			for _, row := range lu.L.Data {
+				for _, l := range row {
+					assert.LessOrEqual(t, math.Abs(float64(l)), 1.0)
+				}
+			}
+			x, err := lu.Solve(b)
+			assert.NoError(t, err)
+			diag, err := DiagnoseSolution(mat, b, x)
+			assert.NoError(t, err)
+			assert.Less(t, diag.BackwardError, 1e-14)
+		})
+	}
+}
+
+func TestLUSingularFloat64(t *testing.T) {
+	// Ведущий элемент последнего шага равен ошибке округления, а не точному нулю
+	mat, _ := FromSlice([][]field.Float64{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}})
+	for name, factorize := range map[string]func(bool) (*LUFactorization[field.Float64], error){
+		"serial":   mat.LU,
+		"parallel": mat.LUParallel,
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := factorize(false)
+			assert.Error(t, err)
+
+			lu, err := factorize(true)
+			assert.NoError(t, err)
+			assert.True(t, lu.IsSingular())
+			assert.Equal(t, 2, lu.Rank)
+			assert.Equal(t, mat.Rank(), lu.Rank)
+		})
+	}
+}
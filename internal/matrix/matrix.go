@@ -2,9 +2,11 @@ package matrix
 
 import (
 	"MatrixGo/internal/field"
+	"MatrixGo/internal/vector"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math/big"
 	"os"
 	"strings"
 )
@@ -12,20 +14,18 @@ import (
 type Matrix[T field.Field[T]] struct {
 	Rows int
 	Cols int
-	Data [][]T
+	Data [][]T // строки — срезы непрерывного хранилища elems (доступ для совместимости)
+
+	elems  []T // элементы подряд по строкам
+	stride int // расстояние между началами соседних строк в elems
 }
 
 func NewMatrix[T field.Field[T]](rows int, cols int, initVal T) *Matrix[T] {
-	data := make([][]T, rows)
-
-	for i := 0; i < rows; i++ {
-		data[i] = make([]T, cols)
-		for j := 0; j < cols; j++ {
-			data[i][j] = initVal
-		}
+	elems := make([]T, rows*cols)
+	for i := range elems {
+		elems[i] = initVal
 	}
-
-	return &Matrix[T]{Rows: rows, Cols: cols, Data: data}
+	return newMatrixFromStorage(rows, cols, elems, cols)
 }
 
 func (mat *Matrix[T]) Get(i, j int) (T, error) {
@@ -89,18 +89,47 @@ func (m1 *Matrix[T]) Mul(m2 *Matrix[T]) (*Matrix[T], error) {
 	res := NewMatrix(m1.Rows, m2.Cols, zero)
 
 	for i := 0; i < m1.Rows; i++ {
-		for j := 0; j < m2.Cols; j++ {
-			elem := m1.Data[i][0].Mul(m2.Data[0][j])
-			for k := 1; k < m1.Cols; k++ {
-				elem = elem.Add(m1.Data[i][k].Mul(m2.Data[k][j]))
-			}
-			res.Data[i][j] = elem
-		}
+		mulRow(res.Data[i], m1.Data[i], m2)
 	}
 
 	return res, nil
 }
 
+// mulRow вычисляет строку произведения dst = a * B. Строки B проходятся подряд (порядок i-k-j),
+// что при непрерывном хранилище дает последовательный доступ к памяти; порядок суммирования
+// по k для каждого элемента тот же, что и в классической формуле.
+func mulRow[T field.Field[T]](dst, a []T, B *Matrix[T]) {
+	if d, ok := any(dst).([]field.Float64); ok {
+		// Для Float64 обходим обобщенные вызовы методов и работаем с числами напрямую
+		mulRowFloat64(d, any(a).([]field.Float64), any(B).(*Matrix[field.Float64]))
+		return
+	}
+	b0 := B.Data[0]
+	for j := range dst {
+		dst[j] = a[0].Mul(b0[j])
+	}
+	for k := 1; k < len(a); k++ {
+		ak, bk := a[k], B.Data[k]
+		for j := range dst {
+			dst[j] = dst[j].Add(ak.Mul(bk[j]))
+		}
+	}
+}
+
+// mulRowFloat64 — вариант mulRow для Float64 без обобщенных вызовов
+func mulRowFloat64(dst, a []field.Float64, B *Matrix[field.Float64]) {
+	b0 := B.Data[0]
+	for j := range dst {
+		dst[j] = a[0] * b0[j]
+	}
+	for k := 1; k < len(a); k++ {
+		ak, bk := a[k], B.Data[k][:len(dst)]
+		for j := range dst {
+			dst[j] += ak * bk[j]
+		}
+	}
+}
+
 func (m *Matrix[T]) Transpose() *Matrix[T] {
 	var zero T
 	res := NewMatrix(m.Cols, m.Rows, zero)
@@ -120,6 +149,19 @@ func (m *Matrix[T]) Trace() T {
 	return sum
 }
 
+// Dims возвращает размеры матрицы
+func (m *Matrix[T]) Dims() (rows, cols int) {
+	return m.Rows, m.Cols
+}
+
+// MulVec вычисляет произведение матрицы на вектор
+func (m *Matrix[T]) MulVec(v *vector.Vector[T]) (*vector.Vector[T], error) {
+	if m.Cols != v.Len() {
+		return nil, &DimensionError{Op: "MulVec", Expected: fmt.Sprintf("вектор размера %d", m.Cols), Actual: fmt.Sprint(v.Len())}
+	}
+	return vector.NewVector(matVec(m, v.Data)), nil
+}
+
 func IdentityMatrix[T field.Field[T]](size int, zero, one T) *Matrix[T] {
 	identity := NewMatrix[T](size, size, zero)
 	for i := 0; i < size; i++ {
@@ -129,13 +171,16 @@ func IdentityMatrix[T field.Field[T]](size int, zero, one T) *Matrix[T] {
 }
 
 func (m *Matrix[T]) Clone() *Matrix[T] {
-	cloned := NewMatrix[T](m.Rows, m.Cols, m.Data[0][0].Zero())
-	for i := 0; i < m.Rows; i++ {
-		for j := 0; j < m.Cols; j++ {
-			cloned.Data[i][j] = m.Data[i][j]
+	elems := make([]T, m.Rows*m.Cols)
+	if src, stride, ok := m.storage(); ok && stride == m.Cols {
+		// Хранилище без промежутков копируется целиком
+		copy(elems, src[:m.Rows*m.Cols])
+	} else {
+		for i := 0; i < m.Rows; i++ {
+			copy(elems[i*m.Cols:(i+1)*m.Cols], m.Data[i])
 		}
 	}
-	return cloned
+	return newMatrixFromStorage(m.Rows, m.Cols, elems, m.Cols)
 }
 
 // String возвращает строковое представление матрицы в удобочитаемом формате
@@ -262,12 +307,24 @@ func (mat *Matrix[T]) SaveToJSON(filename string) error {
 	return nil
 }
 
-// Determinant вычисляет определитель матрицы методом Гаусса
+// Determinant вычисляет определитель матрицы методом Гаусса.
+// Для рациональных матриц с целыми элементами используется бесдробный алгоритм Бареисса.
 func (m *Matrix[T]) Determinant() T {
 	if m.Rows != m.Cols {
 		return m.Data[0][0].Zero()
 	}
 
+	if r, ok := any(m).(*Matrix[field.Rational]); ok {
+		if ints, ok := integerEntries(r); ok {
+			det, _ := BareissDeterminant(ints)
+			return any(field.NewRationalFromBig(det, big.NewInt(1))).(T)
+		}
+	}
+	return m.determinantGauss()
+}
+
+// determinantGauss вычисляет определитель методом Гаусса с делениями
+func (m *Matrix[T]) determinantGauss() T {
 	// Клонируем матрицу, чтобы не изменять исходную
 	mat := m.Clone()
 	n := mat.Rows
@@ -308,8 +365,15 @@ func (m *Matrix[T]) Determinant() T {
 	return det
 }
 
-// Rank вычисляет ранг матрицы методом Гаусса
+// Rank вычисляет ранг матрицы методом Гаусса.
+// Для рациональных матриц с целыми элементами используется бесдробный алгоритм Бареисса.
 func (m *Matrix[T]) Rank() int {
+	if r, ok := any(m).(*Matrix[field.Rational]); ok {
+		if ints, ok := integerEntries(r); ok {
+			return BareissRank(ints)
+		}
+	}
+
 	// Клонируем матрицу, чтобы не изменять исходную
 	mat := m.Clone()
 	rank := 0
@@ -365,8 +429,8 @@ func (m *Matrix[T]) Inverse() (*Matrix[T], error) {
 
 	n := m.Rows
 	// Создаем расширенную матрицу [A|E]
-	var zero T
-	var one T = zero.One()
+	zero := m.Data[0][0].Zero()
+	one := zero.One()
 	augmented := NewMatrix[T](n, 2*n, zero)
 
 	// Копируем исходную матрицу в левую часть
@@ -0,0 +1,390 @@
+package matrix
+
+import (
+	"MatrixGo/internal/field"
+	"errors"
+	"math"
+)
+
+// Коэффициенты аппроксимаций Паде r_m(x) = p_m(x) / p_m(-x) для exp(x) и границы θ_m для нормы ||A||_1,
+// при которых погрешность аппроксимации не превышает единицы округления (Higham, 2005)
+var (
+	padeDegrees = []int{3, 5, 7, 9}
+	padeTheta   = map[int]float64{
+		3:  1.495585217958292e-2,
+		5:  2.539398330063230e-1,
+		7:  9.504178996162932e-1,
+		9:  2.097847961257068,
+		13: 5.371920351148152,
+	}
+	padeCoefficients = map[int][]float64{
+		3: {120, 60, 12, 1},
+		5: {30240, 15120, 3360, 420, 30, 1},
+		7: {17297280, 8648640, 1995840, 277200, 25200, 1512, 56, 1},
+		9: {17643225600, 8821612800, 2075673600, 302702400, 30270240, 2162160, 110880, 3960, 90, 1},
+		13: {64764752532480000, 32382376266240000, 7771770303897600, 1187353796428800,
+			129060195264000, 10559470521600, 670442572800, 33522128640, 1323241920,
+			40840800, 960960, 16380, 182, 1},
+	}
+)
+
+const (
+	// logSqrtThreshold — при ||T - I||_1 ≤ 0.25 ряд Тейлора для log(I + X) сходится быстро
+	logSqrtThreshold = 0.25
+	// maxLogSquareRoots ограничивает число извлечений корня в обратном масштабировании
+	maxLogSquareRoots = 64
+	// maxLogSeriesTerms ограничивает число членов ряда log(I + X)
+	maxLogSeriesTerms = 200
+	// matrixFunctionTol — относительный порог совпадения собственных значений и малых невязок
+	matrixFunctionTol = 1e-10
+)
+
+// norm1 вычисляет 1-норму матрицы (максимальную сумму модулей по столбцам)
+func norm1[T field.Scalar[T]](m *Matrix[T]) float64 {
+	norm := 0.0
+	for j := 0; j < m.Cols; j++ {
+		sum := 0.0
+		for i := 0; i < m.Rows; i++ {
+			sum += m.Data[i][j].Abs()
+		}
+		norm = math.Max(norm, sum)
+	}
+	return norm
+}
+
+// linearCombination вычисляет c_0*I + c_1*M_1 + ... + c_k*M_k для квадратных матриц
+func linearCombination[T field.Scalar[T]](n int, zero T, identity float64, coeffs []float64, mats []*Matrix[T]) *Matrix[T] {
+	res := NewMatrix[T](n, n, zero)
+	for i := 0; i < n; i++ {
+		res.Data[i][i] = zero.FromFloat64(identity)
+	}
+	for k, m := range mats {
+		for i := 0; i < n; i++ {
+			for j := 0; j < n; j++ {
+				res.Data[i][j] = res.Data[i][j].Add(m.Data[i][j].Scale(coeffs[k]))
+			}
+		}
+	}
+	return res
+}
+
+// Exp вычисляет матричную экспоненту методом масштабирования и возведения в квадрат
+// с диагональной аппроксимацией Паде (алгоритм expm Хайэма, 2005)
+func Exp[T field.Scalar[T]](m *Matrix[T]) (*Matrix[T], error) {
+	return matrixExp(m, serialMul[T])
+}
+
+// ExpParallel — параллельная версия Exp на основе MultiplyParallel
+func ExpParallel[T field.Scalar[T]](m *Matrix[T]) (*Matrix[T], error) {
+	return matrixExp(m, parallelMul[T])
+}
+
+func matrixExp[T field.Scalar[T]](m *Matrix[T], mul matrixMultiplier[T]) (*Matrix[T], error) {
+	if m.Rows != m.Cols {
+		return nil, errors.New("матрица должна быть квадратной")
+	}
+
+	// Для малых норм достаточно аппроксимации низкой степени без масштабирования
+	norm := norm1(m)
+	for _, deg := range padeDegrees {
+		if norm <= padeTheta[deg] {
+			return padeExp(m, deg, mul)
+		}
+	}
+
+	// A / 2^s попадает в область точности r_13, затем exp(A) = r_13(A / 2^s)^(2^s)
+	s := 0
+	if norm > padeTheta[13] {
+		s = int(math.Ceil(math.Log2(norm / padeTheta[13])))
+	}
+	scaled := m.Clone()
+	factor := math.Ldexp(1, -s)
+	for i := range scaled.Data {
+		for j := range scaled.Data[i] {
+			scaled.Data[i][j] = scaled.Data[i][j].Scale(factor)
+		}
+	}
+
+	res, err := padeExp(scaled, 13, mul)
+	if err != nil {
+		return nil, err
+	}
+	for ; s > 0; s-- {
+		if res, err = mul(res, res); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+// padeExp вычисляет аппроксимацию Паде r_m(A) = (V - U)^(-1) * (V + U), где U и V — нечетная
+// и четная части числителя. Для m = 13 используется схема Патерсона–Стокмейера с A^2, A^4, A^6.
+func padeExp[T field.Scalar[T]](A *Matrix[T], deg int, mul matrixMultiplier[T]) (*Matrix[T], error) {
+	n := A.Rows
+	zero := A.Data[0][0].Zero()
+	b := padeCoefficients[deg]
+
+	// Четные степени A^2, A^4, ...
+	A2, err := mul(A, A)
+	if err != nil {
+		return nil, err
+	}
+	powers := []*Matrix[T]{A2}
+	count := deg / 2
+	if deg == 13 {
+		count = 3
+	}
+	for len(powers) < count {
+		next, err := mul(powers[len(powers)-1], A2)
+		if err != nil {
+			return nil, err
+		}
+		powers = append(powers, next)
+	}
+
+	var U, V *Matrix[T]
+	if deg == 13 {
+		A4, A6 := powers[1], powers[2]
+		u := linearCombination(n, zero, 0, []float64{b[13], b[11], b[9]}, []*Matrix[T]{A6, A4, A2})
+		if u, err = mul(A6, u); err != nil {
+			return nil, err
+		}
+		u, _ = u.Add(linearCombination(n, zero, b[1], []float64{b[7], b[5], b[3]}, []*Matrix[T]{A6, A4, A2}))
+		if U, err = mul(A, u); err != nil {
+			return nil, err
+		}
+
+		v := linearCombination(n, zero, 0, []float64{b[12], b[10], b[8]}, []*Matrix[T]{A6, A4, A2})
+		if v, err = mul(A6, v); err != nil {
+			return nil, err
+		}
+		V, _ = v.Add(linearCombination(n, zero, b[0], []float64{b[6], b[4], b[2]}, []*Matrix[T]{A6, A4, A2}))
+	} else {
+		odd := make([]float64, len(powers))
+		even := make([]float64, len(powers))
+		for k := range powers {
+			odd[k] = b[2*k+3]
+			even[k] = b[2*k+2]
+		}
+		if U, err = mul(A, linearCombination(n, zero, b[1], odd, powers)); err != nil {
+			return nil, err
+		}
+		V = linearCombination(n, zero, b[0], even, powers)
+	}
+
+	P, _ := V.Add(U)
+	Q, _ := V.Sub(U)
+	lu, err := Q.LU(false)
+	if err != nil {
+		return nil, errors.New("знаменатель аппроксимации Паде вырожден")
+	}
+	return lu.SolveMany(P)
+}
+
+// fromComplexMatrix переводит комплексный результат в поле исходной матрицы.
+// Для Float64 мнимые части должны быть пренебрежимо малы относительно нормы результата.
+func fromComplexMatrix[T field.Scalar[T]](c *Matrix[field.Complex], zero T) (*Matrix[T], error) {
+	switch any(zero).(type) {
+	case field.Complex:
+		return any(c).(*Matrix[T]), nil
+	case field.Float64:
+		tol := matrixFunctionTol * math.Max(1, frobeniusNorm(c))
+		res := NewMatrix[T](c.Rows, c.Cols, zero)
+		for i := range c.Data {
+			for j, z := range c.Data[i] {
+				if math.Abs(z.Im) > tol {
+					return nil, errors.New("результат не является вещественной матрицей")
+				}
+				res.Data[i][j] = zero.FromFloat64(z.Re)
+			}
+		}
+		return res, nil
+	}
+	return nil, errors.New("функции матриц поддерживаются только для float64 и complex")
+}
+
+// schurFunction вычисляет f(A) = Q * f(T) * Q^H по форме Шура A = Q*T*Q^H,
+// где triangular вычисляет функцию от верхней треугольной матрицы T
+func schurFunction[T field.Scalar[T]](m *Matrix[T], mul matrixMultiplier[field.Complex], triangular func(*Matrix[field.Complex]) (*Matrix[field.Complex], error)) (*Matrix[T], error) {
+	schur, err := Schur(m)
+	if err != nil {
+		return nil, err
+	}
+	F, err := triangular(schur.T)
+	if err != nil {
+		return nil, err
+	}
+
+	QH := NewMatrix[field.Complex](schur.Q.Cols, schur.Q.Rows, field.Complex{})
+	for i := range schur.Q.Data {
+		for j, z := range schur.Q.Data[i] {
+			QH.Data[j][i] = z.Conj()
+		}
+	}
+	if F, err = mul(schur.Q, F); err != nil {
+		return nil, err
+	}
+	if F, err = mul(F, QH); err != nil {
+		return nil, err
+	}
+	return fromComplexMatrix(F, m.Data[0][0].Zero())
+}
+
+// sqrtTriangular вычисляет главный квадратный корень верхней треугольной матрицы
+// рекуррентностью Бьорка–Хаммарлинга: R_ii = sqrt(T_ii),
+// R_ij = (T_ij - Σ R_ik*R_kj) / (R_ii + R_jj), k = i+1..j-1
+func sqrtTriangular(T *Matrix[field.Complex]) (*Matrix[field.Complex], error) {
+	n := T.Rows
+	tol := matrixFunctionTol * math.Max(1, frobeniusNorm(T))
+	R := NewMatrix[field.Complex](n, n, field.Complex{})
+	for i := 0; i < n; i++ {
+		R.Data[i][i] = T.Data[i][i].Sqrt()
+	}
+	for j := 1; j < n; j++ {
+		for i := j - 1; i >= 0; i-- {
+			sum := T.Data[i][j]
+			for k := i + 1; k < j; k++ {
+				sum = sum.Sub(R.Data[i][k].Mul(R.Data[k][j]))
+			}
+			den := R.Data[i][i].Add(R.Data[j][j])
+			if den.Abs() <= tol {
+				// Нулевые собственные значения допустимы, только если соответствующий элемент тоже нулевой
+				if sum.Abs() > tol {
+					return nil, errors.New("квадратный корень матрицы не существует или не является главным")
+				}
+				continue
+			}
+			R.Data[i][j], _ = sum.Div(den)
+		}
+	}
+	return R, nil
+}
+
+// logTriangular вычисляет главный логарифм верхней треугольной матрицы обратным
+// масштабированием и возведением в квадрат: T^(1/2^k) приближается к I, после чего
+// log(T) = 2^k * log(I + X), X = T^(1/2^k) - I, где логарифм считается рядом Тейлора
+func logTriangular(T *Matrix[field.Complex]) (*Matrix[field.Complex], error) {
+	n := T.Rows
+	scale := math.Max(1, frobeniusNorm(T))
+	for i := 0; i < n; i++ {
+		if T.Data[i][i].Abs() <= matrixFunctionTol*scale {
+			return nil, errors.New("логарифм вырожденной матрицы не определен")
+		}
+	}
+
+	identity := IdentityMatrix[field.Complex](n, field.Complex{}, field.Complex{}.One())
+	X := T
+	k := 0
+	for ; k < maxLogSquareRoots; k++ {
+		D, _ := X.Sub(identity)
+		if norm1(D) <= logSqrtThreshold {
+			break
+		}
+		root, err := sqrtTriangular(X)
+		if err != nil {
+			return nil, err
+		}
+		X = root
+	}
+	if k == maxLogSquareRoots {
+		return nil, errors.New("обратное масштабирование для логарифма не сошлось")
+	}
+
+	// log(I + X) = X - X^2/2 + X^3/3 - ...
+	X, _ = X.Sub(identity)
+	res := X.Clone()
+	term := X
+	for p := 2; p <= maxLogSeriesTerms; p++ {
+		term, _ = term.Mul(X)
+		c := 1 / float64(p)
+		if p%2 == 0 {
+			c = -c
+		}
+		converged := norm1(term)*math.Abs(c) <= 1e-17*math.Max(1, norm1(res))
+		for i := range res.Data {
+			for j := range res.Data[i] {
+				res.Data[i][j] = res.Data[i][j].Add(term.Data[i][j].Scale(c))
+			}
+		}
+		if converged {
+			break
+		}
+	}
+
+	factor := math.Ldexp(1, k)
+	for i := range res.Data {
+		for j := range res.Data[i] {
+			res.Data[i][j] = res.Data[i][j].Scale(factor)
+		}
+	}
+	return res, nil
+}
+
+// parlettTriangular вычисляет f(T) верхней треугольной матрицы рекуррентностью Парлетта:
+// F_ii = f(T_ii), F_ij = (T_ij*(F_jj - F_ii) + Σ (T_ik*F_kj - F_ik*T_kj)) / (T_jj - T_ii).
+// Рекуррентность требует различных собственных значений; совпадающие допустимы, только если
+// соответствующая часть T диагональна (например, для нормальных матриц).
+func parlettTriangular(T *Matrix[field.Complex], f func(field.Complex) field.Complex) (*Matrix[field.Complex], error) {
+	n := T.Rows
+	tol := matrixFunctionTol * math.Max(1, frobeniusNorm(T))
+	F := NewMatrix[field.Complex](n, n, field.Complex{})
+	for i := 0; i < n; i++ {
+		F.Data[i][i] = f(T.Data[i][i])
+	}
+	// Элементы вычисляются по наддиагоналям: F_ij зависит только от более близких к диагонали
+	for p := 1; p < n; p++ {
+		for i := 0; i+p < n; i++ {
+			j := i + p
+			sum := T.Data[i][j].Mul(F.Data[j][j].Sub(F.Data[i][i]))
+			for k := i + 1; k < j; k++ {
+				sum = sum.Add(T.Data[i][k].Mul(F.Data[k][j])).Sub(F.Data[i][k].Mul(T.Data[k][j]))
+			}
+			den := T.Data[j][j].Sub(T.Data[i][i])
+			if den.Abs() <= tol {
+				if T.Data[i][j].Abs() > tol || sum.Abs() > tol {
+					return nil, errors.New("собственные значения слишком близки для рекуррентности Парлетта")
+				}
+				continue
+			}
+			F.Data[i][j], _ = sum.Div(den)
+		}
+	}
+	return F, nil
+}
+
+// Sqrt вычисляет главный квадратный корень матрицы через комплексную форму Шура.
+// Для вещественной матрицы возвращается ошибка, если корень не является вещественным.
+func Sqrt[T field.Scalar[T]](m *Matrix[T]) (*Matrix[T], error) {
+	return schurFunction(m, serialMul[field.Complex], sqrtTriangular)
+}
+
+// SqrtParallel — параллельная версия Sqrt на основе MultiplyParallel
+func SqrtParallel[T field.Scalar[T]](m *Matrix[T]) (*Matrix[T], error) {
+	return schurFunction(m, parallelMul[field.Complex], sqrtTriangular)
+}
+
+// Log вычисляет главный логарифм невырожденной матрицы через комплексную форму Шура.
+// Для вещественной матрицы возвращается ошибка, если логарифм не является вещественным.
+func Log[T field.Scalar[T]](m *Matrix[T]) (*Matrix[T], error) {
+	return schurFunction(m, serialMul[field.Complex], logTriangular)
+}
+
+// LogParallel — параллельная версия Log на основе MultiplyParallel
+func LogParallel[T field.Scalar[T]](m *Matrix[T]) (*Matrix[T], error) {
+	return schurFunction(m, parallelMul[field.Complex], logTriangular)
+}
+
+// Function вычисляет f(A) для аналитической функции f методом Шура–Парлетта.
+// f применяется к собственным значениям; собственные значения должны быть различными.
+func Function[T field.Scalar[T]](m *Matrix[T], f func(field.Complex) field.Complex) (*Matrix[T], error) {
+	return schurFunction(m, serialMul[field.Complex], func(T *Matrix[field.Complex]) (*Matrix[field.Complex], error) {
+		return parlettTriangular(T, f)
+	})
+}
+
+// FunctionParallel — параллельная версия Function на основе MultiplyParallel
+func FunctionParallel[T field.Scalar[T]](m *Matrix[T], f func(field.Complex) field.Complex) (*Matrix[T], error) {
+	return schurFunction(m, parallelMul[field.Complex], func(T *Matrix[field.Complex]) (*Matrix[field.Complex], error) {
+		return parlettTriangular(T, f)
+	})
+}
@@ -0,0 +1,225 @@
+package matrix
+
+import (
+	"MatrixGo/internal/field"
+	"math"
+	"math/cmplx"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// assertMatrixNear проверяет поэлементную близость матриц с заданной точностью
+func assertMatrixNear[T field.Scalar[T]](t *testing.T, expected, actual *Matrix[T], delta float64) {
+	t.Helper()
+	assert.Equal(t, expected.Rows, actual.Rows)
+	assert.Equal(t, expected.Cols, actual.Cols)
+	for i := 0; i < expected.Rows; i++ {
+		for j := 0; j < expected.Cols; j++ {
+			assert.InDelta(t, 0, expected.Data[i][j].Sub(actual.Data[i][j]).Abs(), delta, "элемент (%d, %d)", i, j)
+		}
+	}
+}
+
+func TestSchur(t *testing.T) {
+	mat, _ := FromSlice([][]field.Float64{
+		{1, 2, 0},
+		{-3, 1, 4},
+		{0, 2, -1},
+	})
+
+	schur, err := Schur(mat)
+	assert.NoError(t, err)
+	for i := 0; i < 3; i++ {
+		for j := 0; j < i; j++ {
+			assert.Equal(t, field.Complex{}, schur.T.Data[i][j])
+		}
+	}
+
+	qh := NewMatrix[field.Complex](3, 3, field.Complex{})
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			qh.Data[j][i] = schur.Q.Data[i][j].Conj()
+		}
+	}
+	qt, _ := schur.Q.Mul(schur.T)
+	back, _ := qt.Mul(qh)
+	assertMatrixNear(t, toComplexMatrix(mat), back, 1e-10)
+
+	unit, _ := qh.Mul(schur.Q)
+	assertMatrixNear(t, IdentityMatrix[field.Complex](3, field.Complex{}, field.Complex{}.One()), unit, 1e-12)
+}
+
+func TestExp(t *testing.T) {
+	// Нильпотентная матрица: exp(N) = I + N + N^2/2
+	nilpotent, _ := FromSlice([][]field.Float64{{0, 1, 2}, {0, 0, 3}, {0, 0, 0}})
+	e, err := Exp(nilpotent)
+	assert.NoError(t, err)
+	expected, _ := FromSlice([][]field.Float64{{1, 1, 3.5}, {0, 1, 3}, {0, 0, 1}})
+	assertMatrixNear(t, expected, e, 1e-12)
+
+	// Генератор вращения: exp(θ*[[0,-1],[1,0]]) — поворот на угол θ.
+	// Каждое θ попадает в свою ветку выбора степени Паде или масштабирования.
+	for _, theta := range []float64{0.01, 0.2, 0.9, 2, 5, 30} {
+		gen, _ := FromSlice([][]field.Float64{{0, field.Float64(-theta)}, {field.Float64(theta), 0}})
+		rot, _ := FromSlice([][]field.Float64{
+			{field.Float64(math.Cos(theta)), field.Float64(-math.Sin(theta))},
+			{field.Float64(math.Sin(theta)), field.Float64(math.Cos(theta))},
+		})
+		e, err := Exp(gen)
+		assert.NoError(t, err)
+		assertMatrixNear(t, rot, e, 1e-12)
+
+		par, err := ExpParallel(gen)
+		assert.NoError(t, err)
+		assertMatrixNear(t, e, par, 1e-14)
+	}
+
+	// Комплексная диагональная матрица
+	diag, _ := FromSlice([][]field.Complex{{{Re: 0, Im: math.Pi}, {}}, {{}, {Re: 1}}})
+	e2, err := Exp(diag)
+	assert.NoError(t, err)
+	expectedC, _ := FromSlice([][]field.Complex{{{Re: -1}, {}}, {{}, {Re: math.E}}})
+	assertMatrixNear(t, expectedC, e2, 1e-12)
+
+	_, err = Exp(NewMatrix[field.Float64](2, 3, 0))
+	assert.Error(t, err)
+}
+
+func TestSqrt(t *testing.T) {
+	mat, _ := FromSlice([][]field.Float64{
+		{4, 1, 0},
+		{1, 5, 2},
+		{0, 2, 6},
+	})
+	root, err := Sqrt(mat)
+	assert.NoError(t, err)
+	sq, _ := root.Mul(root)
+	assertMatrixNear(t, mat, sq, 1e-10)
+
+	par, err := SqrtParallel(mat)
+	assert.NoError(t, err)
+	assertMatrixNear(t, root, par, 1e-12)
+
+	// Несимметричная матрица с жордановой клеткой
+	jordan, _ := FromSlice([][]field.Float64{{4, 1}, {0, 4}})
+	root, err = Sqrt(jordan)
+	assert.NoError(t, err)
+	expected, _ := FromSlice([][]field.Float64{{2, 0.25}, {0, 2}})
+	assertMatrixNear(t, expected, root, 1e-12)
+
+	// Корень из -I не является вещественным, а комплексный существует
+	negative, _ := FromSlice([][]field.Float64{{-1, 0}, {0, -1}})
+	_, err = Sqrt(negative)
+	assert.Error(t, err)
+	negativeC := toComplexMatrix(negative)
+	rootC, err := Sqrt(negativeC)
+	assert.NoError(t, err)
+	sqC, _ := rootC.Mul(rootC)
+	assertMatrixNear(t, negativeC, sqC, 1e-12)
+
+	// Нильпотентная клетка не имеет квадратного корня
+	_, err = Sqrt(nilpotentBlock())
+	assert.Error(t, err)
+}
+
+func nilpotentBlock() *Matrix[field.Float64] {
+	m, _ := FromSlice([][]field.Float64{{0, 1}, {0, 0}})
+	return m
+}
+
+func TestLog(t *testing.T) {
+	mat, _ := FromSlice([][]field.Float64{
+		{3, 1, 0},
+		{0, 2, 1},
+		{1, 0, 4},
+	})
+	l, err := Log(mat)
+	assert.NoError(t, err)
+	back, err := Exp(l)
+	assert.NoError(t, err)
+	assertMatrixNear(t, mat, back, 1e-9)
+
+	par, err := LogParallel(mat)
+	assert.NoError(t, err)
+	assertMatrixNear(t, l, par, 1e-12)
+
+	// log(exp(A)) = A для матрицы с малыми собственными значениями
+	small, _ := FromSlice([][]field.Float64{{0.5, 0.2}, {-0.1, 0.3}})
+	e, _ := Exp(small)
+	l, err = Log(e)
+	assert.NoError(t, err)
+	assertMatrixNear(t, small, l, 1e-10)
+
+	_, err = Log(nilpotentBlock())
+	assert.Error(t, err)
+}
+
+func TestMatrixFunctionSingular(t *testing.T) {
+	// Вырожденная матрица с ведущим элементом порядка ошибки округления в LU
+	singular, _ := FromSlice([][]field.Float64{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}})
+
+	// Знаменатель Паде q(A) невырожден и для вырожденной A: exp(A)*exp(-A) = I, det exp(A) = e^tr(A)
+	e, err := Exp(singular)
+	assert.NoError(t, err)
+	par, err := ExpParallel(singular)
+	assert.NoError(t, err)
+	// Элементы exp(A) порядка 1e7, поэтому допуск относительный
+	assertMatrixNear(t, e, par, 1e-14*frobeniusNorm(e))
+	neg, _ := NewMatrix[field.Float64](3, 3, 0).Sub(singular)
+	inv, err := Exp(neg)
+	assert.NoError(t, err)
+	prod, _ := e.Mul(inv)
+	assertMatrixNear(t, IdentityMatrix[field.Float64](3, 0, 1), prod, 1e-8)
+	lu, err := e.LU(false)
+	assert.NoError(t, err)
+	assert.InEpsilon(t, math.Exp(15), float64(lu.Determinant()), 1e-9)
+
+	// Логарифм вырожденной матрицы не определен
+	_, err = Log(singular)
+	assert.Error(t, err)
+	_, err = LogParallel(singular)
+	assert.Error(t, err)
+}
+
+func TestFunction(t *testing.T) {
+	mat, _ := FromSlice([][]field.Float64{
+		{1, 2, 0},
+		{0, -1, 1},
+		{0, 0, 2},
+	})
+	exp := func(z field.Complex) field.Complex {
+		r := cmplx.Exp(complex(z.Re, z.Im))
+		return field.Complex{Re: real(r), Im: imag(r)}
+	}
+	f, err := Function(mat, exp)
+	assert.NoError(t, err)
+	e, _ := Exp(mat)
+	assertMatrixNear(t, e, f, 1e-10)
+
+	par, err := FunctionParallel(mat, exp)
+	assert.NoError(t, err)
+	assertMatrixNear(t, f, par, 1e-12)
+
+	// sin^2 + cos^2 = I
+	sinF := func(z field.Complex) field.Complex {
+		r := cmplx.Sin(complex(z.Re, z.Im))
+		return field.Complex{Re: real(r), Im: imag(r)}
+	}
+	cosF := func(z field.Complex) field.Complex {
+		r := cmplx.Cos(complex(z.Re, z.Im))
+		return field.Complex{Re: real(r), Im: imag(r)}
+	}
+	s, err := Function(mat, sinF)
+	assert.NoError(t, err)
+	c, err := Function(mat, cosF)
+	assert.NoError(t, err)
+	s2, _ := s.Mul(s)
+	c2, _ := c.Mul(c)
+	sum, _ := s2.Add(c2)
+	assertMatrixNear(t, IdentityMatrix[field.Float64](3, 0, 1), sum, 1e-10)
+
+	// Совпадающие собственные значения с нетривиальной клеткой не поддерживаются
+	_, err = Function(nilpotentBlock(), exp)
+	assert.Error(t, err)
+}
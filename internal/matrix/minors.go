@@ -0,0 +1,382 @@
+package matrix
+
+import (
+	"MatrixGo/internal/field"
+	"errors"
+	"fmt"
+	"runtime"
+	"sync"
+)
+
+// CompoundMatrix содержит все миноры порядка K: Values[r][c] — определитель подматрицы
+// из строк RowSets[r] и столбцов ColSets[c]. Подмножества перечислены в лексикографическом
+// порядке, поэтому Values — K-я составная (компаундная) матрица Cₖ(A).
+type CompoundMatrix[T field.Field[T]] struct {
+	K       int
+	RowSets [][]int
+	ColSets [][]int
+	Values  *Matrix[T]
+}
+
+// PrincipalMinor — главный минор с индексами строк и столбцов Indices
+type PrincipalMinor[T field.Field[T]] struct {
+	Indices []int
+	Value   T
+}
+
+// minorEvaluator вычисляет определители подматриц с заданными строками и столбцами;
+// results[t] соответствует паре rows[t], cols[t]
+type minorEvaluator[T field.Field[T]] func(m *Matrix[T], rows, cols [][]int) []T
+
+// evaluateMinorsSerial последовательно вычисляет миноры
+func evaluateMinorsSerial[T field.Field[T]](m *Matrix[T], rows, cols [][]int) []T {
+	res := make([]T, len(rows))
+	for t := range rows {
+		res[t] = minorDeterminant(m, rows[t], cols[t])
+	}
+	return res
+}
+
+// evaluateMinorsParallel распределяет миноры между рабочими горутинами
+func evaluateMinorsParallel[T field.Field[T]](m *Matrix[T], rows, cols [][]int) []T {
+	res := make([]T, len(rows))
+	tasks := make(chan int, len(rows))
+	for t := range rows {
+		tasks <- t
+	}
+	close(tasks)
+
+	var wg sync.WaitGroup
+	for w := 0; w < min(runtime.NumCPU(), len(rows)); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for t := range tasks {
+				res[t] = minorDeterminant(m, rows[t], cols[t])
+			}
+		}()
+	}
+	wg.Wait()
+	return res
+}
+
+// minorDeterminant вычисляет определитель подматрицы (индексы заранее проверены)
+func minorDeterminant[T field.Field[T]](m *Matrix[T], rows, cols []int) T {
+	sub, _ := m.Submatrix(rows, cols)
+	return sub.Determinant()
+}
+
+// complement возвращает индексы 0..n-1 без skip
+func complement(n, skip int) []int {
+	res := make([]int, 0, n-1)
+	for i := 0; i < n; i++ {
+		if i != skip {
+			res = append(res, i)
+		}
+	}
+	return res
+}
+
+// combinations перечисляет k-элементные подмножества {0, ..., n-1} в лексикографическом порядке
+func combinations(n, k int) [][]int {
+	var res [][]int
+	comb := make([]int, k)
+	var rec func(pos, start int)
+	rec = func(pos, start int) {
+		if pos == k {
+			res = append(res, append([]int(nil), comb...))
+			return
+		}
+		for i := start; i <= n-(k-pos); i++ {
+			comb[pos] = i
+			rec(pos+1, i+1)
+		}
+	}
+	rec(0, 0)
+	return res
+}
+
+// checkMinorIndices проверяет наборы строк и столбцов минора
+func checkMinorIndices[T field.Field[T]](op string, m *Matrix[T], rows, cols []int) error {
+	if len(rows) != len(cols) {
+		return &DimensionError{Op: op, Expected: "одинаковое число строк и столбцов", Actual: sizeString(len(rows), len(cols))}
+	}
+	if len(rows) == 0 {
+		return fmt.Errorf("%s: %w", op, ErrEmptyMatrix)
+	}
+	for _, i := range rows {
+		if err := checkIndex(op, axisRow, i, m.Rows); err != nil {
+			return err
+		}
+	}
+	for _, j := range cols {
+		if err := checkIndex(op, axisColumn, j, m.Cols); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MinorOf вычисляет минор — определитель подматрицы из строк rows и столбцов cols
+func (m *Matrix[T]) MinorOf(rows, cols []int) (T, error) {
+	if err := checkMinorIndices("MinorOf", m, rows, cols); err != nil {
+		return m.Data[0][0].Zero(), err
+	}
+	return minorDeterminant(m, rows, cols), nil
+}
+
+// Minor вычисляет дополнительный минор M_ij квадратной матрицы — определитель матрицы
+// без строки i и столбца j. Минор матрицы 1×1 равен единице.
+func (m *Matrix[T]) Minor(i, j int) (T, error) {
+	zero := m.Data[0][0].Zero()
+	if m.Rows != m.Cols {
+		return zero, &DimensionError{Op: "Minor", Expected: "квадратная матрица", Actual: sizeString(m.Rows, m.Cols)}
+	}
+	if err := checkIndex("Minor", axisRow, i, m.Rows); err != nil {
+		return zero, err
+	}
+	if err := checkIndex("Minor", axisColumn, j, m.Cols); err != nil {
+		return zero, err
+	}
+	if m.Rows == 1 {
+		return zero.One(), nil
+	}
+	return minorDeterminant(m, complement(m.Rows, i), complement(m.Cols, j)), nil
+}
+
+// Cofactor вычисляет алгебраическое дополнение C_ij = (-1)^(i+j) * M_ij
+func (m *Matrix[T]) Cofactor(i, j int) (T, error) {
+	minor, err := m.Minor(i, j)
+	if err != nil {
+		return minor, err
+	}
+	if (i+j)%2 == 1 {
+		minor = minor.Neg()
+	}
+	return minor, nil
+}
+
+// PrincipalMinorOf вычисляет главный минор с индексами строк и столбцов indices
+func (m *Matrix[T]) PrincipalMinorOf(indices []int) (T, error) {
+	return m.MinorOf(indices, indices)
+}
+
+// LeadingPrincipalMinors вычисляет ведущие главные миноры Δ_1, ..., Δ_n квадратной матрицы
+// (например, для критерия Сильвестра)
+func (m *Matrix[T]) LeadingPrincipalMinors() ([]T, error) {
+	return leadingPrincipalMinors(m, evaluateMinorsSerial[T])
+}
+
+// LeadingPrincipalMinorsParallel — параллельная версия LeadingPrincipalMinors
+func (m *Matrix[T]) LeadingPrincipalMinorsParallel() ([]T, error) {
+	return leadingPrincipalMinors(m, evaluateMinorsParallel[T])
+}
+
+func leadingPrincipalMinors[T field.Field[T]](m *Matrix[T], evaluate minorEvaluator[T]) ([]T, error) {
+	if m.Rows != m.Cols {
+		return nil, &DimensionError{Op: "LeadingPrincipalMinors", Expected: "квадратная матрица", Actual: sizeString(m.Rows, m.Cols)}
+	}
+	sets := make([][]int, m.Rows)
+	for k := range sets {
+		sets[k] = make([]int, k+1)
+		for i := range sets[k] {
+			sets[k][i] = i
+		}
+	}
+	return evaluate(m, sets, sets), nil
+}
+
+// PrincipalMinors вычисляет все главные миноры порядка k квадратной матрицы
+func (m *Matrix[T]) PrincipalMinors(k int) ([]PrincipalMinor[T], error) {
+	return principalMinors(m, k, evaluateMinorsSerial[T])
+}
+
+// PrincipalMinorsParallel — параллельная версия PrincipalMinors
+func (m *Matrix[T]) PrincipalMinorsParallel(k int) ([]PrincipalMinor[T], error) {
+	return principalMinors(m, k, evaluateMinorsParallel[T])
+}
+
+func principalMinors[T field.Field[T]](m *Matrix[T], k int, evaluate minorEvaluator[T]) ([]PrincipalMinor[T], error) {
+	if m.Rows != m.Cols {
+		return nil, &DimensionError{Op: "PrincipalMinors", Expected: "квадратная матрица", Actual: sizeString(m.Rows, m.Cols)}
+	}
+	if k < 1 || k > m.Rows {
+		return nil, fmt.Errorf("порядок минора должен быть от 1 до %d", m.Rows)
+	}
+	sets := combinations(m.Rows, k)
+	values := evaluate(m, sets, sets)
+	res := make([]PrincipalMinor[T], len(sets))
+	for t, set := range sets {
+		res[t] = PrincipalMinor[T]{Indices: set, Value: values[t]}
+	}
+	return res, nil
+}
+
+// AllMinors вычисляет все миноры порядка k (C(m,k)·C(n,k) определителей)
+func (m *Matrix[T]) AllMinors(k int) (*CompoundMatrix[T], error) {
+	return allMinors(m, k, evaluateMinorsSerial[T])
+}
+
+// AllMinorsParallel — параллельная версия AllMinors
+func (m *Matrix[T]) AllMinorsParallel(k int) (*CompoundMatrix[T], error) {
+	return allMinors(m, k, evaluateMinorsParallel[T])
+}
+
+func allMinors[T field.Field[T]](m *Matrix[T], k int, evaluate minorEvaluator[T]) (*CompoundMatrix[T], error) {
+	if k < 1 || k > min(m.Rows, m.Cols) {
+		return nil, fmt.Errorf("порядок минора должен быть от 1 до %d", min(m.Rows, m.Cols))
+	}
+	rowSets, colSets := combinations(m.Rows, k), combinations(m.Cols, k)
+	rows := make([][]int, 0, len(rowSets)*len(colSets))
+	cols := make([][]int, 0, len(rowSets)*len(colSets))
+	for _, r := range rowSets {
+		for _, c := range colSets {
+			rows, cols = append(rows, r), append(cols, c)
+		}
+	}
+	values := evaluate(m, rows, cols)
+
+	res := NewMatrix[T](len(rowSets), len(colSets), m.Data[0][0].Zero())
+	for r := range rowSets {
+		copy(res.Data[r], values[r*len(colSets):(r+1)*len(colSets)])
+	}
+	return &CompoundMatrix[T]{K: k, RowSets: rowSets, ColSets: colSets, Values: res}, nil
+}
+
+// DeterminantalRank находит ранг как наибольший порядок ненулевого минора и возвращает
+// строки и столбцы одного такого минора. Порядки перебираются сверху вниз с остановкой на
+// первом ненулевом миноре; в худшем случае число миноров экспоненциально, поэтому метод
+// предназначен для небольших матриц и проверки результата Rank.
+func (m *Matrix[T]) DeterminantalRank() (rank int, rows, cols []int) {
+	zero := m.Data[0][0].Zero()
+	for k := min(m.Rows, m.Cols); k > 0; k-- {
+		for _, r := range combinations(m.Rows, k) {
+			for _, c := range combinations(m.Cols, k) {
+				if !minorDeterminant(m, r, c).Equal(zero) {
+					return k, r, c
+				}
+			}
+		}
+	}
+	return 0, nil, nil
+}
+
+// Adjugate вычисляет присоединенную матрицу adj(A) = Cᵀ, для которой A*adj(A) = det(A)*I.
+// В отличие от Inverse, работает и для вырожденных матриц: при rank A = n-1 присоединенная
+// матрица имеет ранг 1 и равна c·x·yᵀ, где x и y порождают ядра A и Aᵀ, при меньшем ранге
+// она нулевая. Рациональные матрицы обрабатываются бесдробным алгоритмом Бареисса.
+func (m *Matrix[T]) Adjugate() (*Matrix[T], error) {
+	return adjugate(m, false)
+}
+
+// AdjugateParallel — параллельная версия Adjugate
+func (m *Matrix[T]) AdjugateParallel() (*Matrix[T], error) {
+	return adjugate(m, true)
+}
+
+// CofactorMatrix вычисляет матрицу алгебраических дополнений C, C_ij = (-1)^(i+j) * M_ij
+func (m *Matrix[T]) CofactorMatrix() (*Matrix[T], error) {
+	adj, err := adjugate(m, false)
+	if err != nil {
+		return nil, err
+	}
+	return adj.Transpose(), nil
+}
+
+// CofactorMatrixParallel — параллельная версия CofactorMatrix
+func (m *Matrix[T]) CofactorMatrixParallel() (*Matrix[T], error) {
+	adj, err := adjugate(m, true)
+	if err != nil {
+		return nil, err
+	}
+	return adj.Transpose(), nil
+}
+
+func adjugate[T field.Field[T]](m *Matrix[T], parallel bool) (*Matrix[T], error) {
+	if m.Rows != m.Cols {
+		return nil, &DimensionError{Op: "Adjugate", Expected: "квадратная матрица", Actual: sizeString(m.Rows, m.Cols)}
+	}
+	n := m.Rows
+	zero, one := m.Data[0][0].Zero(), m.Data[0][0].One()
+	if n == 1 {
+		return NewMatrix[T](1, 1, one), nil
+	}
+	if r, ok := any(m).(*Matrix[field.Rational]); ok {
+		fractionFree := AdjugateFractionFree
+		if parallel {
+			fractionFree = AdjugateFractionFreeParallel
+		}
+		adj, err := fractionFree(r)
+		if err != nil {
+			return nil, err
+		}
+		return any(adj).(*Matrix[T]), nil
+	}
+
+	rank := m.Rank()
+	if parallel {
+		rank = m.RankParallel()
+	}
+	switch {
+	case rank == n:
+		// adj(A) = det(A) * A⁻¹
+		var inv *Matrix[T]
+		var det T
+		var err error
+		if parallel {
+			inv, err = m.InverseParallel()
+			det = m.DeterminantParallel()
+		} else {
+			inv, err = m.Inverse()
+			det = m.Determinant()
+		}
+		if err != nil {
+			return nil, err
+		}
+		for i := range inv.Data {
+			for j := range inv.Data[i] {
+				inv.Data[i][j] = det.Mul(inv.Data[i][j])
+			}
+		}
+		return inv, nil
+	case rank < n-1:
+		return NewMatrix[T](n, n, zero), nil
+	}
+
+	// Ранг n-1: столбцы adj(A) лежат в ядре A, строки — в левом ядре,
+	// поэтому adj(A) = c·x·yᵀ; множитель c находится по одному алгебраическому дополнению
+	kernel, left := NullSpace(m), LeftNullSpace(m)
+	if len(kernel) != 1 || len(left) != 1 {
+		return nil, errors.New("не удалось определить ядро матрицы ранга n-1")
+	}
+	x, y := kernel[0].Data, left[0].Data
+	j := firstNonzero(x)
+	i := firstNonzero(y)
+	// adj(A)_ji = C_ij
+	cofactor, err := m.Cofactor(i, j)
+	if err != nil {
+		return nil, err
+	}
+	c, err := cofactor.Div(x[j].Mul(y[i]))
+	if err != nil {
+		return nil, err
+	}
+	res := NewMatrix[T](n, n, zero)
+	for r := 0; r < n; r++ {
+		xr := c.Mul(x[r])
+		for s := 0; s < n; s++ {
+			res.Data[r][s] = xr.Mul(y[s])
+		}
+	}
+	return res, nil
+}
+
+// firstNonzero возвращает индекс первого ненулевого элемента (-1, если все нулевые)
+func firstNonzero[T field.Field[T]](v []T) int {
+	for i, x := range v {
+		if !x.Equal(x.Zero()) {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,191 @@
+package matrix
+
+import (
+	"MatrixGo/internal/field"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// cofactorsByDefinition вычисляет матрицу алгебраических дополнений по определению
+func cofactorsByDefinition[T field.Field[T]](t *testing.T, m *Matrix[T]) *Matrix[T] {
+	t.Helper()
+	res := NewMatrix[T](m.Rows, m.Cols, m.Data[0][0].Zero())
+	for i := 0; i < m.Rows; i++ {
+		for j := 0; j < m.Cols; j++ {
+			c, err := m.Cofactor(i, j)
+			assert.NoError(t, err)
+			res.Data[i][j] = c
+		}
+	}
+	return res
+}
+
+func gfMatrix(t *testing.T, p int64, data [][]int64) *Matrix[field.GF] {
+	t.Helper()
+	rows := make([][]field.GF, len(data))
+	for i := range data {
+		rows[i] = make([]field.GF, len(data[i]))
+		for j := range data[i] {
+			x, err := field.NewGF(data[i][j], p)
+			assert.NoError(t, err)
+			rows[i][j] = x
+		}
+	}
+	mat, err := FromSlice(rows)
+	assert.NoError(t, err)
+	return mat
+}
+
+func TestMinorAndCofactor(t *testing.T) {
+	mat := ratMatrix(t, [][]int64{{1, 2, 3}, {0, 4, 5}, {1, 0, 6}})
+	minor, err := mat.Minor(0, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, field.NewRational(-5, 1), minor)
+	cofactor, err := mat.Cofactor(0, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, field.NewRational(5, 1), cofactor)
+
+	minor, err = mat.MinorOf([]int{0, 2}, []int{1, 2})
+	assert.NoError(t, err)
+	assert.Equal(t, field.NewRational(12, 1), minor)
+	principal, err := mat.PrincipalMinorOf([]int{1, 2})
+	assert.NoError(t, err)
+	assert.Equal(t, field.NewRational(24, 1), principal)
+
+	_, err = mat.MinorOf([]int{0}, []int{0, 1})
+	assert.ErrorIs(t, err, ErrDimensionMismatch)
+	_, err = mat.Minor(3, 0)
+	assert.ErrorIs(t, err, ErrIndexOutOfRange)
+	_, err = ratMatrix(t, [][]int64{{1, 2}}).Minor(0, 0)
+	assert.ErrorIs(t, err, ErrDimensionMismatch)
+}
+
+func TestAdjugate(t *testing.T) {
+	tests := []struct {
+		name string
+		mat  *Matrix[field.GF]
+	}{
+		{"nonsingular", gfMatrix(t, 7, [][]int64{{1, 2, 3}, {0, 4, 5}, {1, 0, 6}})},
+		{"rank n-1", gfMatrix(t, 7, [][]int64{{1, 2, 3}, {2, 4, 6}, {1, 0, 6}})},
+		{"rank n-2", gfMatrix(t, 7, [][]int64{{1, 2, 3}, {2, 4, 6}, {3, 6, 2}})},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expected := cofactorsByDefinition(t, tt.mat)
+			cof, err := tt.mat.CofactorMatrix()
+			assert.NoError(t, err)
+			assertMatrixEqual(t, expected, cof)
+			cof, err = tt.mat.CofactorMatrixParallel()
+			assert.NoError(t, err)
+			assertMatrixEqual(t, expected, cof)
+
+			adj, err := tt.mat.AdjugateParallel()
+			assert.NoError(t, err)
+			assertMatrixEqual(t, expected.Transpose(), adj)
+
+			// A*adj(A) = det(A)*I
+			prod, _ := tt.mat.Mul(adj)
+			det := tt.mat.Determinant()
+			assertMatrixEqual(t, IdentityMatrix(3, det.Zero(), det), prod)
+		})
+	}
+
+	t.Run("rational", func(t *testing.T) {
+		for _, data := range [][][]int64{
+			{{2, 4, 1}, {1, 2, 3}, {3, 6, 4}},
+			{{2, -1, 0, 3}, {1, 5, 2, -2}, {0, 3, 4, 1}, {7, 0, -1, 2}},
+		} {
+			mat := ratMatrix(t, data)
+			expected := cofactorsByDefinition(t, mat).Transpose()
+			adj, err := mat.Adjugate()
+			assert.NoError(t, err)
+			assertMatrixEqual(t, expected, adj)
+			adj, err = mat.AdjugateParallel()
+			assert.NoError(t, err)
+			assertMatrixEqual(t, expected, adj)
+		}
+	})
+
+	t.Run("float64 rank n-1", func(t *testing.T) {
+		mat, _ := FromSlice([][]field.Float64{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}})
+		adj, err := mat.Adjugate()
+		assert.NoError(t, err)
+		assertMatrixNear(t, cofactorsByDefinition(t, mat).Transpose(), adj, 1e-9)
+	})
+
+	_, err := ratMatrix(t, [][]int64{{1, 2}}).Adjugate()
+	assert.ErrorIs(t, err, ErrDimensionMismatch)
+}
+
+func TestAllMinors(t *testing.T) {
+	mat := ratMatrix(t, [][]int64{{1, 2, 0, 3}, {4, 1, 2, 1}, {0, 3, 1, 2}})
+	compound, err := mat.AllMinors(2)
+	assert.NoError(t, err)
+	assert.Equal(t, [][]int{{0, 1}, {0, 2}, {1, 2}}, compound.RowSets)
+	assert.Len(t, compound.ColSets, 6)
+	for r, rows := range compound.RowSets {
+		for c, cols := range compound.ColSets {
+			expected, _ := mat.MinorOf(rows, cols)
+			assert.Equal(t, expected, compound.Values.Data[r][c])
+		}
+	}
+	parallel, err := mat.AllMinorsParallel(2)
+	assert.NoError(t, err)
+	assertMatrixEqual(t, compound.Values, parallel.Values)
+
+	first, _ := mat.AllMinors(1)
+	assertMatrixEqual(t, mat, first.Values)
+
+	// Теорема Бине–Коши: C_k(A*B) = C_k(A)*C_k(B)
+	b := ratMatrix(t, [][]int64{{1, 0, 2}, {2, 1, 1}, {0, 1, 3}, {1, 1, 0}})
+	ab, _ := mat.Mul(b)
+	cab, _ := ab.AllMinors(2)
+	cb, _ := b.AllMinors(2)
+	prod, _ := compound.Values.Mul(cb.Values)
+	assertMatrixEqual(t, cab.Values, prod)
+
+	_, err = mat.AllMinors(4)
+	assert.Error(t, err)
+}
+
+func TestPrincipalMinors(t *testing.T) {
+	mat := ratMatrix(t, [][]int64{{2, -1, 0}, {-1, 2, -1}, {0, -1, 2}})
+	leading, err := mat.LeadingPrincipalMinors()
+	assert.NoError(t, err)
+	assert.Equal(t, []field.Rational{field.NewRational(2, 1), field.NewRational(3, 1), field.NewRational(4, 1)}, leading)
+	parallel, err := mat.LeadingPrincipalMinorsParallel()
+	assert.NoError(t, err)
+	assert.Equal(t, leading, parallel)
+
+	minors, err := mat.PrincipalMinors(2)
+	assert.NoError(t, err)
+	assert.Equal(t, []PrincipalMinor[field.Rational]{
+		{Indices: []int{0, 1}, Value: field.NewRational(3, 1)},
+		{Indices: []int{0, 2}, Value: field.NewRational(4, 1)},
+		{Indices: []int{1, 2}, Value: field.NewRational(3, 1)},
+	}, minors)
+	parallelMinors, err := mat.PrincipalMinorsParallel(2)
+	assert.NoError(t, err)
+	assert.Equal(t, minors, parallelMinors)
+
+	_, err = mat.PrincipalMinors(0)
+	assert.Error(t, err)
+}
+
+func TestDeterminantalRank(t *testing.T) {
+	rng := rand.New(rand.NewSource(49))
+	for trial := 0; trial < 10; trial++ {
+		// Произведение 5×r и r×4 имеет ранг не больше r
+		r := 1 + trial%3
+		a, b := randomRectRational(rng, 5, r, 3), randomRectRational(rng, r, 4, 3)
+		mat, _ := a.Mul(b)
+		rank, rows, cols := mat.DeterminantalRank()
+		assert.Equal(t, mat.Rank(), rank)
+		if rank > 0 {
+			minor, _ := mat.MinorOf(rows, cols)
+			assert.False(t, minor.Equal(minor.Zero()))
+		}
+	}
+}
@@ -0,0 +1,458 @@
+package matrix
+
+import (
+	"MatrixGo/internal/field"
+	"MatrixGo/internal/vector"
+	"errors"
+	"math/big"
+	"runtime"
+	"sync"
+)
+
+// modularPrimeStart — верхняя граница простых модулей: произведение двух вычетов помещается в int64
+const modularPrimeStart = 1<<31 - 1
+
+// RationalAlgorithm задает метод точных вычислений для рациональных матриц
+type RationalAlgorithm int
+
+const (
+	RationalAuto    RationalAlgorithm = iota // Бареисс для целочисленных матриц, иначе метод Гаусса
+	RationalGauss                            // Метод Гаусса с рациональной арифметикой
+	RationalBareiss                          // Бесдробный алгоритм Бареисса
+	RationalModular                          // Многомодульный метод с китайской теоремой об остатках
+)
+
+// ModularImage — результат вычислений по одному простому модулю
+type ModularImage struct {
+	Prime    int64
+	Det      int64   // определитель целочисленной матрицы по модулю Prime
+	Solution []int64 // решение системы по модулю Prime (nil, если Prime делит определитель)
+}
+
+// ModularImageStore хранит образы одной задачи по простым модулям, чтобы повторные
+// вычисления (например, через cache.MatrixCache) не пересчитывали их заново
+type ModularImageStore interface {
+	Image(p int64) (*ModularImage, bool)
+	SetImage(img *ModularImage)
+}
+
+// modularPrimes возвращает последовательность простых чисел, убывающих от modularPrimeStart.
+// Последовательность детерминирована, поэтому сохраненные образы переиспользуются.
+type modularPrimes struct {
+	next int64
+}
+
+func (g *modularPrimes) Next() (int64, error) {
+	for ; g.next > 2; g.next-- {
+		if big.NewInt(g.next).ProbablyPrime(20) {
+			p := g.next
+			g.next--
+			return p, nil
+		}
+	}
+	return 0, ErrPrimesExhausted
+}
+
+// Batch возвращает следующие count простых модулей
+func (g *modularPrimes) Batch(count int) ([]int64, error) {
+	batch := make([]int64, count)
+	for i := range batch {
+		p, err := g.Next()
+		if err != nil {
+			return nil, err
+		}
+		batch[i] = p
+	}
+	return batch, nil
+}
+
+// hadamardBound возвращает верхнюю оценку |det| по неравенству Адамара: произведение
+// норм строк, каждая из которых округляется вверх до целого
+func hadamardBound(a [][]*big.Int) *big.Int {
+	bound := big.NewInt(1)
+	sq := new(big.Int)
+	for _, row := range a {
+		sum := new(big.Int)
+		for _, x := range row {
+			sum.Add(sum, sq.Mul(x, x))
+		}
+		norm := new(big.Int).Sqrt(sum)
+		if new(big.Int).Mul(norm, norm).Cmp(sum) < 0 {
+			norm.Add(norm, big.NewInt(1))
+		}
+		bound.Mul(bound, norm)
+	}
+	return bound
+}
+
+// reduceModP приводит целочисленную матрицу по модулю p
+func reduceModP(a [][]*big.Int, p int64) [][]int64 {
+	mod := big.NewInt(p)
+	r := new(big.Int)
+	res := make([][]int64, len(a))
+	for i := range a {
+		res[i] = make([]int64, len(a[i]))
+		for j := range a[i] {
+			res[i][j] = r.Mod(a[i][j], mod).Int64()
+		}
+	}
+	return res
+}
+
+// invModP вычисляет обратный элемент по простому модулю малой теоремой Ферма
+func invModP(x, p int64) int64 {
+	res, base := int64(1), x%p
+	for e := p - 2; e > 0; e >>= 1 {
+		if e&1 == 1 {
+			res = res * base % p
+		}
+		base = base * base % p
+	}
+	return res
+}
+
+// modularImage вычисляет определитель и, если задана правая часть, решение по модулю p.
+// Исключение Гаусса выполняется в машинных словах: вычеты меньше 2^31, и их произведение
+// не переполняет int64, поэтому это значительно быстрее арифметики field.GF на big.Int.
+func modularImage(a [][]*big.Int, rhs []*big.Int, p int64) (*ModularImage, error) {
+	n := len(a)
+	if rhs != nil {
+		aug := make([][]*big.Int, n)
+		for i := range a {
+			aug[i] = append(append([]*big.Int(nil), a[i]...), rhs[i])
+		}
+		a = aug
+	}
+	M := reduceModP(a, p)
+	cols := len(M[0])
+
+	det := int64(1)
+	for k := 0; k < n; k++ {
+		piv := k
+		for piv < n && M[piv][k] == 0 {
+			piv++
+		}
+		if piv == n {
+			return &ModularImage{Prime: p, Det: 0}, nil
+		}
+		if piv != k {
+			M[k], M[piv] = M[piv], M[k]
+			det = p - det
+		}
+		det = det * M[k][k] % p
+
+		inv := invModP(M[k][k], p)
+		for j := k; j < cols; j++ {
+			M[k][j] = M[k][j] * inv % p
+		}
+		for i := k + 1; i < n; i++ {
+			f := M[i][k]
+			if f == 0 {
+				continue
+			}
+			for j := k; j < cols; j++ {
+				M[i][j] = (M[i][j] - f*M[k][j]%p + p) % p
+			}
+		}
+	}
+	img := &ModularImage{Prime: p, Det: det % p}
+	if rhs == nil {
+		return img, nil
+	}
+
+	// Обратная подстановка по единичной верхнетреугольной матрице
+	x := make([]int64, n)
+	for i := n - 1; i >= 0; i-- {
+		sum := M[i][n]
+		for j := i + 1; j < n; j++ {
+			sum = (sum - M[i][j]*x[j]%p + p) % p
+		}
+		x[i] = sum
+	}
+	img.Solution = x
+	return img, nil
+}
+
+// modularImages вычисляет образы для очередной партии простых модулей параллельно,
+// беря готовые образы из хранилища, если они там есть
+func modularImages(a [][]*big.Int, rhs []*big.Int, primes []int64, store ModularImageStore) ([]*ModularImage, error) {
+	images := make([]*ModularImage, len(primes))
+	errs := make([]error, len(primes))
+	var wg sync.WaitGroup
+	for i, p := range primes {
+		if store != nil {
+			if img, ok := store.Image(p); ok {
+				images[i] = img
+				continue
+			}
+		}
+		wg.Add(1)
+		go func(i int, p int64) {
+			defer wg.Done()
+			images[i], errs[i] = modularImage(a, rhs, p)
+		}(i, p)
+	}
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+		if store != nil {
+			store.SetImage(images[i])
+		}
+	}
+	return images, nil
+}
+
+// crtCombine дополняет вычет r по модулю M вычетом rp по простому модулю p:
+// результат x ≡ r (mod M), x ≡ rp (mod p), 0 ≤ x < M*p
+func crtCombine(r, M *big.Int, rp, p int64) *big.Int {
+	pb := big.NewInt(p)
+	// x = r + M*((rp - r)*M^(-1) mod p)
+	t := new(big.Int).Sub(big.NewInt(rp), r)
+	t.Mod(t, pb)
+	inv := new(big.Int).ModInverse(new(big.Int).Mod(M, pb), pb)
+	t.Mul(t, inv).Mod(t, pb)
+	return t.Mul(t, M).Add(t, r)
+}
+
+// symmetricResidue переводит вычет из [0, M) в симметричный диапазон (-M/2, M/2]
+func symmetricResidue(r, M *big.Int) *big.Int {
+	half := new(big.Int).Rsh(M, 1)
+	if r.Cmp(half) > 0 {
+		return new(big.Int).Sub(r, M)
+	}
+	return new(big.Int).Set(r)
+}
+
+// rationalReconstruct находит дробь n/d ≡ u (mod M) с |n| ≤ numBound и 0 < d ≤ (M-1)/(2*numBound)
+// расширенным алгоритмом Евклида. Такая дробь единственна, если существует.
+func rationalReconstruct(u, M, numBound *big.Int) (field.Rational, bool) {
+	denBound := new(big.Int).Sub(M, big.NewInt(1))
+	denBound.Quo(denBound, new(big.Int).Lsh(numBound, 1))
+
+	r0, r1 := new(big.Int).Set(M), new(big.Int).Mod(u, M)
+	t0, t1 := big.NewInt(0), big.NewInt(1)
+	q := new(big.Int)
+	for r1.Cmp(numBound) > 0 {
+		q.Quo(r0, r1)
+		r0, r1 = r1, new(big.Int).Sub(r0, new(big.Int).Mul(q, r1))
+		t0, t1 = t1, new(big.Int).Sub(t0, new(big.Int).Mul(q, t1))
+	}
+
+	if t1.Sign() == 0 || new(big.Int).Abs(t1).Cmp(denBound) > 0 {
+		return field.Rational{}, false
+	}
+	if new(big.Int).GCD(nil, nil, r1, new(big.Int).Abs(t1)).Cmp(big.NewInt(1)) != 0 {
+		return field.Rational{}, false
+	}
+	return field.NewRationalFromBig(r1, t1), true
+}
+
+// DeterminantModular вычисляет определитель рациональной матрицы многомодульным методом.
+// Строки приводятся к целым, определитель вычисляется по модулю партий простых чисел
+// около 2^31 параллельно и восстанавливается по китайской теореме об остатках.
+// Вычисления останавливаются, когда произведение модулей превышает удвоенную оценку Адамара.
+// store может быть nil.
+func DeterminantModular(m *Matrix[field.Rational], store ModularImageStore) (field.Rational, error) {
+	if m.Rows != m.Cols {
+		return field.Rational{}, errors.New("матрица должна быть квадратной")
+	}
+
+	ints, scales := scaledIntegerRows(m)
+	limit := new(big.Int).Lsh(hadamardBound(ints), 1)
+
+	primes := &modularPrimes{next: modularPrimeStart}
+	residue, modulus := big.NewInt(0), big.NewInt(1)
+	for modulus.Cmp(limit) <= 0 {
+		batch, err := primes.Batch(runtime.NumCPU())
+		if err != nil {
+			return field.Rational{}, err
+		}
+		images, err := modularImages(ints, nil, batch, store)
+		if err != nil {
+			return field.Rational{}, err
+		}
+		for _, img := range images {
+			residue = crtCombine(residue, modulus, img.Det, img.Prime)
+			modulus.Mul(modulus, big.NewInt(img.Prime))
+		}
+	}
+
+	den := big.NewInt(1)
+	for _, d := range scales {
+		den.Mul(den, d)
+	}
+	return field.NewRationalFromBig(symmetricResidue(residue, modulus), den), nil
+}
+
+// SolveSystemModular решает невырожденную систему A*x = b над рациональными числами
+// многомодульным методом. По каждому простому модулю система решается над GF(p),
+// вычеты компонент объединяются по китайской теореме об остатках, а компоненты
+// восстанавливаются как дроби. По правилу Крамера числители и знаменатель ограничены
+// оценками Адамара, поэтому при произведении модулей больше 2*N*D восстановление точно;
+// досрочная остановка происходит, как только восстановленное решение проходит точную проверку.
+// Модули, делящие определитель, пропускаются. store может быть nil.
+func SolveSystemModular(m *Matrix[field.Rational], v *vector.Vector[field.Rational], store ModularImageStore) (*vector.Vector[field.Rational], error) {
+	if m.Rows != m.Cols {
+		return nil, errors.New("матрица должна быть квадратной")
+	}
+	if m.Rows != v.Len() {
+		return nil, errors.New("размер вектора не совпадает с размером матрицы")
+	}
+
+	// Строки [A | b] приводятся к целым общим множителем
+	augmented := NewMatrix[field.Rational](m.Rows, m.Cols+1, field.NewRational(0, 1))
+	for i := 0; i < m.Rows; i++ {
+		copy(augmented.Data[i], m.Data[i])
+		augmented.Data[i][m.Cols] = v.Data[i]
+	}
+	aug, _ := scaledIntegerRows(augmented)
+	n := m.Rows
+	ints := make([][]*big.Int, n)
+	rhs := make([]*big.Int, n)
+	for i := range aug {
+		ints[i] = aug[i][:n]
+		rhs[i] = aug[i][n]
+	}
+
+	detBound := hadamardBound(ints)
+	numBound := hadamardBound(aug)
+	limit := new(big.Int).Mul(detBound, numBound)
+	limit.Lsh(limit, 1)
+
+	primes := &modularPrimes{next: modularPrimeStart}
+	residues := make([]*big.Int, n)
+	for i := range residues {
+		residues[i] = big.NewInt(0)
+	}
+	modulus := big.NewInt(1)
+	// Произведение модулей, делящих определитель; если оно превысит оценку Адамара, det = 0
+	singular := big.NewInt(1)
+	for {
+		batch, err := primes.Batch(runtime.NumCPU())
+		if err != nil {
+			return nil, err
+		}
+		images, err := modularImages(ints, rhs, batch, store)
+		if err != nil {
+			return nil, err
+		}
+		for _, img := range images {
+			if img.Solution == nil {
+				singular.Mul(singular, big.NewInt(img.Prime))
+				continue
+			}
+			for i := range residues {
+				residues[i] = crtCombine(residues[i], modulus, img.Solution[i], img.Prime)
+			}
+			modulus.Mul(modulus, big.NewInt(img.Prime))
+		}
+		if singular.Cmp(detBound) > 0 {
+			return nil, errors.New("матрица вырождена, решение невозможно")
+		}
+		if modulus.BitLen() == 1 {
+			continue
+		}
+
+		final := modulus.Cmp(limit) > 0
+		// До достижения оценки пробуем сбалансированные границы sqrt(M/2) и проверяем решение
+		bound := numBound
+		if !final {
+			bound = new(big.Int).Sqrt(new(big.Int).Rsh(modulus, 1))
+		}
+		if x, ok := reconstructSolution(residues, modulus, bound); ok && verifySolution(ints, rhs, x) {
+			return vector.NewVector(x), nil
+		}
+		if final {
+			return nil, errors.New("не удалось восстановить решение по модулям")
+		}
+	}
+}
+
+// reconstructSolution восстанавливает все компоненты решения как дроби
+func reconstructSolution(residues []*big.Int, modulus, numBound *big.Int) ([]field.Rational, bool) {
+	x := make([]field.Rational, len(residues))
+	for i, r := range residues {
+		q, ok := rationalReconstruct(r, modulus, numBound)
+		if !ok {
+			return nil, false
+		}
+		x[i] = q
+	}
+	return x, true
+}
+
+// verifySolution точно проверяет A*x = b для целочисленных A и b
+func verifySolution(a [][]*big.Int, rhs []*big.Int, x []field.Rational) bool {
+	for i := range a {
+		sum := field.NewRational(0, 1)
+		for j := range a[i] {
+			sum = sum.Add(field.NewRationalFromBig(a[i][j], big.NewInt(1)).Mul(x[j]))
+		}
+		if !sum.Equal(field.NewRationalFromBig(rhs[i], big.NewInt(1))) {
+			return false
+		}
+	}
+	return true
+}
+
+// DeterminantRational вычисляет определитель рациональной матрицы выбранным методом
+func DeterminantRational(m *Matrix[field.Rational], alg RationalAlgorithm) (field.Rational, error) {
+	switch alg {
+	case RationalAuto:
+		return m.Determinant(), nil
+	case RationalGauss:
+		return m.determinantGauss(), nil
+	case RationalBareiss:
+		return DeterminantFractionFree(m)
+	case RationalModular:
+		return DeterminantModular(m, nil)
+	default:
+		return field.Rational{}, errors.New("неизвестный алгоритм")
+	}
+}
+
+// SolveSystemRational решает невырожденную рациональную систему выбранным методом.
+// Для RationalBareiss решение строится как adj(A)*b / det(A).
+func SolveSystemRational(m *Matrix[field.Rational], v *vector.Vector[field.Rational], alg RationalAlgorithm) (*vector.Vector[field.Rational], error) {
+	switch alg {
+	case RationalAuto, RationalGauss:
+		return SolveSystem(m, v)
+	case RationalBareiss:
+		return solveFractionFree(m, v)
+	case RationalModular:
+		return SolveSystemModular(m, v, nil)
+	default:
+		return nil, errors.New("неизвестный алгоритм")
+	}
+}
+
+// solveFractionFree решает систему по правилу x = adj(A)*b / det(A) без промежуточных дробей
+func solveFractionFree(m *Matrix[field.Rational], v *vector.Vector[field.Rational]) (*vector.Vector[field.Rational], error) {
+	if m.Rows != v.Len() {
+		return nil, errors.New("размер вектора не совпадает с размером матрицы")
+	}
+	det, err := DeterminantFractionFree(m)
+	if err != nil {
+		return nil, err
+	}
+	if det.Sign() == 0 {
+		return nil, errors.New("матрица вырождена, решение невозможно")
+	}
+	adj, err := AdjugateFractionFree(m)
+	if err != nil {
+		return nil, err
+	}
+
+	x := make([]field.Rational, m.Rows)
+	for i := range x {
+		sum := field.NewRational(0, 1)
+		for j := 0; j < m.Cols; j++ {
+			sum = sum.Add(adj.Data[i][j].Mul(v.Data[j]))
+		}
+		x[i], _ = sum.Div(det)
+	}
+	return vector.NewVector(x), nil
+}
@@ -0,0 +1,156 @@
+package matrix
+
+import (
+	"MatrixGo/internal/field"
+	"MatrixGo/internal/vector"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// mapImageStore — простое хранилище образов для проверки повторного использования
+type mapImageStore struct {
+	images map[int64]*ModularImage
+	hits   int
+}
+
+func (s *mapImageStore) Image(p int64) (*ModularImage, bool) {
+	img, ok := s.images[p]
+	if ok {
+		s.hits++
+	}
+	return img, ok
+}
+
+func (s *mapImageStore) SetImage(img *ModularImage) {
+	s.images[img.Prime] = img
+}
+
+func TestRationalReconstruct(t *testing.T) {
+	M := big.NewInt(1000003)
+	// -3/7 mod M
+	inv := new(big.Int).ModInverse(big.NewInt(7), M)
+	u := new(big.Int).Mul(big.NewInt(-3), inv)
+	u.Mod(u, M)
+
+	q, ok := rationalReconstruct(u, M, big.NewInt(100))
+	assert.True(t, ok)
+	assert.True(t, q.Equal(field.NewRational(-3, 7)))
+}
+
+func TestDeterminantModular(t *testing.T) {
+	t.Run("matches bareiss", func(t *testing.T) {
+		rng := rand.New(rand.NewSource(2))
+		for _, n := range []int{1, 3, 6, 12} {
+			mat := randomIntMatrix(rng, n, 1000)
+			det, err := DeterminantModular(mat, nil)
+			assert.NoError(t, err)
+			assert.True(t, det.Equal(mat.Determinant()))
+		}
+	})
+
+	t.Run("fractions", func(t *testing.T) {
+		mat, _ := FromSlice([][]field.Rational{
+			{field.NewRational(1, 2), field.NewRational(-2, 3)},
+			{field.NewRational(5, 7), field.NewRational(3, 1)},
+		})
+		det, err := DeterminantModular(mat, nil)
+		assert.NoError(t, err)
+		assert.True(t, det.Equal(mat.determinantGauss()))
+	})
+
+	t.Run("singular", func(t *testing.T) {
+		det, err := DeterminantModular(ratMatrix(t, [][]int64{{1, 2}, {2, 4}}), nil)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, det.Sign())
+	})
+
+	t.Run("reuses images", func(t *testing.T) {
+		mat := randomIntMatrix(rand.New(rand.NewSource(3)), 5, 100)
+		store := &mapImageStore{images: map[int64]*ModularImage{}}
+
+		first, err := DeterminantModular(mat, store)
+		assert.NoError(t, err)
+		assert.Zero(t, store.hits)
+		assert.NotEmpty(t, store.images)
+
+		second, err := DeterminantModular(mat, store)
+		assert.NoError(t, err)
+		assert.True(t, first.Equal(second))
+		assert.Equal(t, len(store.images), store.hits)
+	})
+}
+
+func TestSolveSystemModular(t *testing.T) {
+	t.Run("matches gauss", func(t *testing.T) {
+		rng := rand.New(rand.NewSource(4))
+		for _, n := range []int{1, 4, 10} {
+			mat := randomIntMatrix(rng, n, 50)
+			b := make([]field.Rational, n)
+			for i := range b {
+				b[i] = field.NewRational(rng.Int63n(101)-50, rng.Int63n(5)+1)
+			}
+			vec := vector.NewVector(b)
+
+			expected, err := SolveSystem(mat, vec)
+			assert.NoError(t, err)
+			x, err := SolveSystemModular(mat, vec, nil)
+			assert.NoError(t, err)
+			for i := range x.Data {
+				assert.True(t, expected.Data[i].Equal(x.Data[i]))
+			}
+		}
+	})
+
+	t.Run("singular", func(t *testing.T) {
+		mat := ratMatrix(t, [][]int64{{1, 2}, {2, 4}})
+		vec := vector.NewVector([]field.Rational{field.NewRational(1, 1), field.NewRational(2, 1)})
+
+		_, err := SolveSystemModular(mat, vec, nil)
+		assert.Error(t, err)
+	})
+}
+
+func TestRationalAlgorithms(t *testing.T) {
+	mat, _ := FromSlice([][]field.Rational{
+		{field.NewRational(2, 3), field.NewRational(1, 1), field.NewRational(0, 1)},
+		{field.NewRational(-1, 2), field.NewRational(4, 1), field.NewRational(1, 5)},
+		{field.NewRational(3, 1), field.NewRational(-2, 1), field.NewRational(7, 4)},
+	})
+	vec := vector.NewVector([]field.Rational{field.NewRational(1, 1), field.NewRational(-2, 3), field.NewRational(5, 1)})
+
+	expectedDet := mat.determinantGauss()
+	expectedX, err := SolveSystem(mat, vec)
+	assert.NoError(t, err)
+
+	for _, alg := range []RationalAlgorithm{RationalAuto, RationalGauss, RationalBareiss, RationalModular} {
+		det, err := DeterminantRational(mat, alg)
+		assert.NoError(t, err)
+		assert.True(t, det.Equal(expectedDet))
+
+		x, err := SolveSystemRational(mat, vec, alg)
+		assert.NoError(t, err)
+		for i := range x.Data {
+			assert.True(t, expectedX.Data[i].Equal(x.Data[i]))
+		}
+	}
+}
+
+func BenchmarkDeterminantModular(b *testing.B) {
+	mat := randomIntMatrix(rand.New(rand.NewSource(1)), benchmarkDeterminantSize, 100)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		DeterminantModular(mat, nil)
+	}
+}
+
+func TestModularPrimesExhausted(t *testing.T) {
+	primes := &modularPrimes{next: 12}
+	batch, err := primes.Batch(3)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{11, 7, 5}, batch)
+	_, err = primes.Batch(2)
+	assert.ErrorIs(t, err, ErrPrimesExhausted)
+}
@@ -7,46 +7,161 @@ import (
 	"sync"
 )
 
-// Task описывает координаты одного элемента, который нужно вычислить
-type multiplyTask[T field.Field[T]] struct {
-	Row int
-	Col int
+// DefaultMulTileSize — сторона квадратного блока в блочном умножении по умолчанию. Блоки
+// A, B и C такого размера помещаются в кэш процессора, поэтому каждый загруженный элемент
+// используется многократно.
+const DefaultMulTileSize = 64
+
+// MulOptions задает параметры MultiplyParallelWith; нулевые значения заменяются значениями
+// по умолчанию
+type MulOptions struct {
+	TileSize int // сторона блока (по умолчанию DefaultMulTileSize); можно подобрать под конкретную машину
+	// StrassenThreshold — наименьший размер, начиная с которого для точных полей (rational, GF)
+	// применяется рекурсия Штрассена–Винограда; 0 (по умолчанию) отключает рекурсию.
+	// Сложение рациональных чисел не дешевле умножения, поэтому для rational рекурсия
+	// выгодна лишь при больших размерах и малом пороге; для GF выигрыш заметнее.
+	StrassenThreshold int
 }
 
-// MultiplyParallel выполняет параллельное умножение матриц
+// MultiplyParallel выполняет параллельное умножение матриц с параметрами по умолчанию
+// (см. MultiplyParallelWith)
 func (m1 *Matrix[T]) MultiplyParallel(m2 *Matrix[T]) (*Matrix[T], error) {
+	return m1.MultiplyParallelWith(m2, MulOptions{})
+}
+
+// MultiplyParallelWith выполняет параллельное умножение матриц. Результат делится на блоки
+// TileSize×TileSize, которые рабочие горутины забирают из общей очереди; каждый блок
+// накапливается по полосам общего измерения того же размера. Для Float64 внутренний цикл —
+// скалярное произведение строки A на строку транспонированной B (обе лежат в памяти подряд).
+// Для точных полей при размерах от StrassenThreshold применяется рекурсия
+// Штрассена–Винограда, а блоки нижнего уровня умножаются параллельно.
+func (m1 *Matrix[T]) MultiplyParallelWith(m2 *Matrix[T], opts MulOptions) (*Matrix[T], error) {
 	if m1.Cols != m2.Rows {
 		return nil, errors.New("недопустимые размеры для умножения")
 	}
+	tile := opts.TileSize
+	if tile <= 0 {
+		tile = DefaultMulTileSize
+	}
+	threshold := opts.StrassenThreshold
+	if threshold > 0 && isExactField(m1.Data[0][0]) && min(m1.Rows, m1.Cols, m2.Cols) >= threshold {
+		return strassenMul(m1, m2, max(threshold, 2), func(a, b *Matrix[T]) *Matrix[T] {
+			return mulTiledParallel(a, b, tile)
+		}), nil
+	}
+	return mulTiledParallel(m1, m2, tile), nil
+}
 
+// mulTiledParallel — блочное умножение с распределением блоков результата по рабочим
+func mulTiledParallel[T field.Field[T]](m1, m2 *Matrix[T], tile int) *Matrix[T] {
 	res := NewMatrix[T](m1.Rows, m2.Cols, m1.Data[0][0].Zero())
 
-	numWorkers := runtime.NumCPU()
-	tasks := make(chan multiplyTask[T], m1.Rows*m2.Cols)
-	var wg sync.WaitGroup
+	// Для Float64 строки B^T служат непрерывными столбцами B
+	var bt *Matrix[field.Float64]
+	if b, ok := any(m2).(*Matrix[field.Float64]); ok {
+		bt = b.Transpose()
+	}
+
+	type block struct{ i0, j0 int }
+	blocks := make(chan block, (m1.Rows/tile+1)*(m2.Cols/tile+1))
+	for i0 := 0; i0 < m1.Rows; i0 += tile {
+		for j0 := 0; j0 < m2.Cols; j0 += tile {
+			blocks <- block{i0, j0}
+		}
+	}
+	close(blocks)
 
-	for i := 0; i < numWorkers; i++ {
+	numWorkers := min(runtime.NumCPU(), len(blocks))
+	var wg sync.WaitGroup
+	for w := 0; w < numWorkers; w++ {
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
-			for task := range tasks {
-				sum := m1.Data[0][0].Zero()
-				for k := 0; k < m1.Cols; k++ {
-					sum = sum.Add(m1.Data[task.Row][k].Mul(m2.Data[k][task.Col]))
+			for b := range blocks {
+				i1, j1 := min(b.i0+tile, m1.Rows), min(b.j0+tile, m2.Cols)
+				if bt != nil {
+					mulTileFloat64(any(res).(*Matrix[field.Float64]), any(m1).(*Matrix[field.Float64]), bt, b.i0, i1, b.j0, j1, tile)
+				} else {
+					mulTile(res, m1, m2, b.i0, i1, b.j0, j1, tile)
 				}
-				res.Data[task.Row][task.Col] = sum
 			}
 		}()
 	}
+	wg.Wait()
+	return res
+}
 
-	for i := 0; i < m1.Rows; i++ {
-		for j := 0; j < m2.Cols; j++ {
-			tasks <- multiplyTask[T]{Row: i, Col: j}
+// mulTile прибавляет к блоку C[i0:i1, j0:j1] произведение A[i0:i1, :] * B[:, j0:j1],
+// проходя общее измерение полосами ширины tile
+func mulTile[T field.Field[T]](C, A, B *Matrix[T], i0, i1, j0, j1, tile int) {
+	for k0 := 0; k0 < A.Cols; k0 += tile {
+		k1 := min(k0+tile, A.Cols)
+		for i := i0; i < i1; i++ {
+			a, c := A.Data[i], C.Data[i][j0:j1]
+			for k := k0; k < k1; k++ {
+				aik, b := a[k], B.Data[k][j0:j1]
+				for j := range c {
+					c[j] = c[j].Add(aik.Mul(b[j]))
+				}
+			}
 		}
 	}
-	close(tasks)
+}
 
-	wg.Wait()
+// mulTileFloat64 — вариант mulTile для Float64 со скалярными произведениями строк A и B^T.
+// Строки обрабатываются парами: четыре произведения (i, j), (i, j+1), (i+1, j), (i+1, j+1)
+// накапливаются одновременно, поэтому каждый загруженный элемент используется дважды.
+func mulTileFloat64(C, A, Bt *Matrix[field.Float64], i0, i1, j0, j1, tile int) {
+	for k0 := 0; k0 < A.Cols; k0 += tile {
+		k1 := min(k0+tile, A.Cols)
+		i := i0
+		for ; i+1 < i1; i += 2 {
+			a0, a1 := A.Data[i][k0:k1], A.Data[i+1][k0:k1]
+			c0, c1 := C.Data[i], C.Data[i+1]
+			j := j0
+			for ; j+1 < j1; j += 2 {
+				b0, b1 := Bt.Data[j][k0:k1], Bt.Data[j+1][k0:k1]
+				var s00, s01, s10, s11 field.Float64
+				for k, x0 := range a0 {
+					x1, y0, y1 := a1[k], b0[k], b1[k]
+					s00 += x0 * y0
+					s01 += x0 * y1
+					s10 += x1 * y0
+					s11 += x1 * y1
+				}
+				c0[j] += s00
+				c0[j+1] += s01
+				c1[j] += s10
+				c1[j+1] += s11
+			}
+			if j < j1 {
+				c0[j] += dotFloat64(a0, Bt.Data[j][k0:k1])
+				c1[j] += dotFloat64(a1, Bt.Data[j][k0:k1])
+			}
+		}
+		if i < i1 {
+			a, c := A.Data[i][k0:k1], C.Data[i]
+			for j := j0; j < j1; j++ {
+				c[j] += dotFloat64(a, Bt.Data[j][k0:k1])
+			}
+		}
+	}
+}
 
-	return res, nil
+// dotFloat64 вычисляет скалярное произведение с четырьмя независимыми накопителями,
+// что позволяет процессору выполнять сложения конвейерно
+func dotFloat64(a, b []field.Float64) field.Float64 {
+	b = b[:len(a)]
+	var s0, s1, s2, s3 field.Float64
+	k := 0
+	for ; k+4 <= len(a); k += 4 {
+		s0 += a[k] * b[k]
+		s1 += a[k+1] * b[k+1]
+		s2 += a[k+2] * b[k+2]
+		s3 += a[k+3] * b[k+3]
+	}
+	for ; k < len(a); k++ {
+		s0 += a[k] * b[k]
+	}
+	return (s0 + s1) + (s2 + s3)
 }
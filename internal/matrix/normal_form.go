@@ -0,0 +1,342 @@
+package matrix
+
+import (
+	"MatrixGo/internal/field"
+	"MatrixGo/internal/vector"
+	"errors"
+	"math/big"
+)
+
+// SmithDecomposition хранит нормальную форму Смита U*A*V = D целочисленной матрицы
+type SmithDecomposition struct {
+	D          *Matrix[field.Rational] // диагональная матрица d_1 | d_2 | ... | d_r, d_i > 0
+	U          *Matrix[field.Rational] // унимодулярная матрица строковых преобразований (Rows×Rows)
+	V          *Matrix[field.Rational] // унимодулярная матрица столбцовых преобразований (Cols×Cols)
+	Invariants []field.Rational        // ненулевые инвариантные множители d_1, ..., d_r
+	Rank       int
+}
+
+// HermiteDecomposition хранит нормальную форму Эрмита H = U*A (строчный вариант)
+type HermiteDecomposition struct {
+	H      *Matrix[field.Rational] // ступенчатая матрица: ведущие элементы положительны, элементы над ними в [0, pivot)
+	U      *Matrix[field.Rational] // унимодулярная матрица преобразования
+	Pivots []int                   // столбцы ведущих элементов
+	Rank   int
+}
+
+// DiophantineSolution описывает все целочисленные решения системы A*x = b:
+// x = Particular + сумма целых кратных векторов Kernel
+type DiophantineSolution struct {
+	Solvable   bool
+	Particular *vector.Vector[field.Rational]   // частное целочисленное решение
+	Kernel     []*vector.Vector[field.Rational] // базис решетки целочисленных решений A*x = 0
+}
+
+// intIdentity создает целочисленную единичную матрицу
+func intIdentity(n int) [][]*big.Int {
+	res := make([][]*big.Int, n)
+	for i := range res {
+		res[i] = make([]*big.Int, n)
+		for j := range res[i] {
+			res[i][j] = new(big.Int)
+		}
+		res[i][i].SetInt64(1)
+	}
+	return res
+}
+
+// intToRationalMatrix переводит целочисленную матрицу в рациональную
+func intToRationalMatrix(a [][]*big.Int, rows, cols int) *Matrix[field.Rational] {
+	one := big.NewInt(1)
+	res := NewMatrix[field.Rational](rows, cols, field.NewRational(0, 1))
+	for i := range a {
+		for j := range a[i] {
+			res.Data[i][j] = field.NewRationalFromBig(a[i][j], one)
+		}
+	}
+	return res
+}
+
+// requireIntegerEntries возвращает элементы матрицы как целые числа или ошибку
+func requireIntegerEntries(m *Matrix[field.Rational]) ([][]*big.Int, error) {
+	ints, ok := integerEntries(m)
+	if !ok {
+		return nil, errors.New("матрица должна быть целочисленной")
+	}
+	return ints, nil
+}
+
+// combineRows заменяет строки i и k их унимодулярной комбинацией
+// (row_i, row_k) ← (s*row_i + t*row_k, u*row_i + v*row_k), s*v - t*u = 1
+func combineRows(a [][]*big.Int, i, k int, s, t, u, v *big.Int) {
+	x, y := new(big.Int), new(big.Int)
+	for j := range a[i] {
+		x.Mul(s, a[i][j])
+		y.Mul(t, a[k][j])
+		ni := new(big.Int).Add(x, y)
+		x.Mul(u, a[i][j])
+		y.Mul(v, a[k][j])
+		a[k][j] = new(big.Int).Add(x, y)
+		a[i][j] = ni
+	}
+}
+
+// addRowMultiple выполняет row_i ← row_i - q*row_k
+func addRowMultiple(a [][]*big.Int, i, k int, q *big.Int) {
+	tmp := new(big.Int)
+	for j := range a[i] {
+		a[i][j].Sub(a[i][j], tmp.Mul(q, a[k][j]))
+	}
+}
+
+// addColumnMultiple выполняет col_j ← col_j - q*col_k
+func addColumnMultiple(a [][]*big.Int, j, k int, q *big.Int) {
+	tmp := new(big.Int)
+	for i := range a {
+		a[i][j].Sub(a[i][j], tmp.Mul(q, a[i][k]))
+	}
+}
+
+// negateRow меняет знак строки
+func negateRow(a [][]*big.Int, i int) {
+	for j := range a[i] {
+		a[i][j].Neg(a[i][j])
+	}
+}
+
+// swapColumns меняет местами столбцы
+func swapColumns(a [][]*big.Int, j, k int) {
+	for i := range a {
+		a[i][j], a[i][k] = a[i][k], a[i][j]
+	}
+}
+
+// HermiteForm вычисляет строчную нормальную форму Эрмита H = U*A целочисленной матрицы.
+// Столбцы обрабатываются слева направо: элементы под ведущим собираются в него
+// расширенным алгоритмом Евклида. При reduce = true элементы над ведущим приводятся
+// по его модулю сразу после каждого шага, что сдерживает рост коэффициентов H и U;
+// иначе приведение выполняется один раз в конце (результат тот же).
+func HermiteForm(m *Matrix[field.Rational], reduce bool) (*HermiteDecomposition, error) {
+	A, err := requireIntegerEntries(m)
+	if err != nil {
+		return nil, err
+	}
+	rows, cols := m.Rows, m.Cols
+	U := intIdentity(rows)
+
+	// reduceAbove приводит элементы столбца c над ведущей строкой r в диапазон [0, pivot)
+	reduceAbove := func(r, c int) {
+		q := new(big.Int)
+		for k := 0; k < r; k++ {
+			q.Div(A[k][c], A[r][c])
+			if q.Sign() != 0 {
+				addRowMultiple(A, k, r, q)
+				addRowMultiple(U, k, r, q)
+			}
+		}
+	}
+
+	var pivots []int
+	r := 0
+	for c := 0; c < cols && r < rows; c++ {
+		for i := r + 1; i < rows; i++ {
+			if A[i][c].Sign() == 0 {
+				continue
+			}
+			// g = s*x + t*y; (row_r, row_i) ← (s*row_r + t*row_i, -(y/g)*row_r + (x/g)*row_i)
+			x, y := new(big.Int).Set(A[r][c]), new(big.Int).Set(A[i][c])
+			s, t := new(big.Int), new(big.Int)
+			g := new(big.Int).GCD(s, t, x, y)
+			u := new(big.Int).Neg(new(big.Int).Quo(y, g))
+			v := new(big.Int).Quo(x, g)
+			combineRows(A, r, i, s, t, u, v)
+			combineRows(U, r, i, s, t, u, v)
+		}
+		if A[r][c].Sign() == 0 {
+			continue
+		}
+		if A[r][c].Sign() < 0 {
+			negateRow(A, r)
+			negateRow(U, r)
+		}
+		if reduce {
+			reduceAbove(r, c)
+		}
+		pivots = append(pivots, c)
+		r++
+	}
+	if !reduce {
+		for i, c := range pivots {
+			reduceAbove(i, c)
+		}
+	}
+
+	return &HermiteDecomposition{
+		H:      intToRationalMatrix(A, rows, cols),
+		U:      intToRationalMatrix(U, rows, rows),
+		Pivots: pivots,
+		Rank:   len(pivots),
+	}, nil
+}
+
+// SmithForm вычисляет нормальную форму Смита U*A*V = D целочисленной матрицы.
+// На каждом шаге ведущим выбирается наименьший по модулю ненулевой элемент оставшейся
+// подматрицы; строка и столбец ведущего обнуляются делением с остатком, пока остатки
+// не исчезнут. Если какой-то элемент подматрицы не делится на ведущий, его строка
+// прибавляется к ведущей, и шаг повторяется. Выбор наименьшего ведущего ограничивает
+// рост коэффициентов.
+func SmithForm(m *Matrix[field.Rational]) (*SmithDecomposition, error) {
+	A, err := requireIntegerEntries(m)
+	if err != nil {
+		return nil, err
+	}
+	rows, cols := m.Rows, m.Cols
+	U, V := intIdentity(rows), intIdentity(cols)
+
+	// moveToPivot переставляет элемент (i, j) в позицию (t, t)
+	moveToPivot := func(t, i, j int) {
+		if i != t {
+			A[t], A[i] = A[i], A[t]
+			U[t], U[i] = U[i], U[t]
+		}
+		if j != t {
+			swapColumns(A, t, j)
+			swapColumns(V, t, j)
+		}
+	}
+
+	t := 0
+	for ; t < rows && t < cols; t++ {
+		// Наименьший по модулю ненулевой элемент подматрицы
+		bi, bj := -1, -1
+		for i := t; i < rows; i++ {
+			for j := t; j < cols; j++ {
+				if A[i][j].Sign() != 0 && (bi < 0 || A[i][j].CmpAbs(A[bi][bj]) < 0) {
+					bi, bj = i, j
+				}
+			}
+		}
+		if bi < 0 {
+			break
+		}
+		moveToPivot(t, bi, bj)
+
+		q, rem := new(big.Int), new(big.Int)
+		for {
+			for i := t + 1; i < rows; i++ {
+				q.Quo(A[i][t], A[t][t])
+				if q.Sign() != 0 {
+					addRowMultiple(A, i, t, q)
+					addRowMultiple(U, i, t, q)
+				}
+			}
+			for j := t + 1; j < cols; j++ {
+				q.Quo(A[t][j], A[t][t])
+				if q.Sign() != 0 {
+					addColumnMultiple(A, j, t, q)
+					addColumnMultiple(V, j, t, q)
+				}
+			}
+
+			// Остатки меньше ведущего по модулю: делаем наименьший из них новым ведущим
+			bi, bj = t, t
+			for i := t + 1; i < rows; i++ {
+				if A[i][t].Sign() != 0 && A[i][t].CmpAbs(A[bi][bj]) < 0 {
+					bi, bj = i, t
+				}
+			}
+			for j := t + 1; j < cols; j++ {
+				if A[t][j].Sign() != 0 && A[t][j].CmpAbs(A[bi][bj]) < 0 {
+					bi, bj = t, j
+				}
+			}
+			if bi != t || bj != t {
+				moveToPivot(t, bi, bj)
+				continue
+			}
+
+			// Строка и столбец обнулены; проверяем делимость подматрицы на ведущий
+			bad := -1
+			for i := t + 1; i < rows && bad < 0; i++ {
+				for j := t + 1; j < cols; j++ {
+					if rem.Rem(A[i][j], A[t][t]).Sign() != 0 {
+						bad = i
+						break
+					}
+				}
+			}
+			if bad < 0 {
+				break
+			}
+			// row_t ← row_t + row_bad
+			minusOne := big.NewInt(-1)
+			addRowMultiple(A, t, bad, minusOne)
+			addRowMultiple(U, t, bad, minusOne)
+		}
+
+		if A[t][t].Sign() < 0 {
+			negateRow(A, t)
+			negateRow(U, t)
+		}
+	}
+
+	res := &SmithDecomposition{
+		D:    intToRationalMatrix(A, rows, cols),
+		U:    intToRationalMatrix(U, rows, rows),
+		V:    intToRationalMatrix(V, cols, cols),
+		Rank: t,
+	}
+	for i := 0; i < t; i++ {
+		res.Invariants = append(res.Invariants, res.D.Data[i][i])
+	}
+	return res, nil
+}
+
+// SolveInteger находит все целочисленные решения системы A*x = b с целыми A и b.
+// Из U*A*V = D система сводится к D*y = U*b, x = V*y: y_i = (U*b)_i / d_i при i < r
+// (решение существует, только если деление нацело), (U*b)_i = 0 при i ≥ r, а y_r, ..., y_(n-1)
+// свободны, поэтому последние столбцы V образуют базис решетки решений однородной системы.
+func SolveInteger(m *Matrix[field.Rational], b *vector.Vector[field.Rational]) (*DiophantineSolution, error) {
+	if m.Rows != b.Len() {
+		return nil, errors.New("размер вектора не совпадает с количеством строк матрицы")
+	}
+	for _, x := range b.Data {
+		if x.Den().Cmp(big.NewInt(1)) != 0 {
+			return nil, errors.New("вектор правой части должен быть целочисленным")
+		}
+	}
+
+	smith, err := SmithForm(m)
+	if err != nil {
+		return nil, err
+	}
+	ub := matVec(smith.U, b.Data)
+
+	zero := field.NewRational(0, 1)
+	y := make([]field.Rational, m.Cols)
+	for i := range y {
+		y[i] = zero
+	}
+	for i := 0; i < m.Rows; i++ {
+		if i >= smith.Rank {
+			if ub[i].Sign() != 0 {
+				return &DiophantineSolution{Solvable: false}, nil
+			}
+			continue
+		}
+		q, _ := ub[i].Div(smith.Invariants[i])
+		if q.Den().Cmp(big.NewInt(1)) != 0 {
+			return &DiophantineSolution{Solvable: false}, nil
+		}
+		y[i] = q
+	}
+
+	res := &DiophantineSolution{
+		Solvable:   true,
+		Particular: vector.NewVector(matVec(smith.V, y)),
+	}
+	for j := smith.Rank; j < m.Cols; j++ {
+		res.Kernel = append(res.Kernel, vector.NewVector(columnOf(smith.V, j)))
+	}
+	return res, nil
+}
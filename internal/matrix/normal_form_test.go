@@ -0,0 +1,145 @@
+package matrix
+
+import (
+	"MatrixGo/internal/field"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// assertUnimodular проверяет, что матрица целочисленная и ее определитель равен ±1
+func assertUnimodular(t *testing.T, m *Matrix[field.Rational]) {
+	t.Helper()
+	_, ok := integerEntries(m)
+	assert.True(t, ok)
+	det := m.Determinant()
+	assert.True(t, det.Equal(field.NewRational(1, 1)) || det.Equal(field.NewRational(-1, 1)))
+}
+
+func TestHermiteForm(t *testing.T) {
+	mat := ratMatrix(t, [][]int64{
+		{2, 3, 6, 2},
+		{5, 6, 1, 6},
+		{8, 3, 1, 1},
+	})
+
+	for _, reduce := range []bool{true, false} {
+		res, err := HermiteForm(mat, reduce)
+		assert.NoError(t, err)
+		assertUnimodular(t, res.U)
+
+		UA, _ := res.U.Mul(mat)
+		assertMatrixEqual(t, res.H, UA)
+		assert.Equal(t, 3, res.Rank)
+		assert.Equal(t, []int{0, 1, 2}, res.Pivots)
+		assertMatrixEqual(t, ratMatrix(t, [][]int64{
+			{1, 0, 50, -11},
+			{0, 3, 28, -2},
+			{0, 0, 61, -13},
+		}), res.H)
+	}
+
+	t.Run("rank deficient", func(t *testing.T) {
+		mat := ratMatrix(t, [][]int64{{2, 4, 6}, {3, 6, 9}, {1, 2, 4}})
+
+		res, err := HermiteForm(mat, true)
+		assert.NoError(t, err)
+		assert.Equal(t, []int{0, 2}, res.Pivots)
+		assertMatrixEqual(t, ratMatrix(t, [][]int64{{1, 2, 0}, {0, 0, 1}, {0, 0, 0}}), res.H)
+	})
+
+	t.Run("not integer", func(t *testing.T) {
+		mat, _ := FromSlice([][]field.Rational{{field.NewRational(1, 2)}})
+		_, err := HermiteForm(mat, true)
+		assert.Error(t, err)
+	})
+}
+
+func TestSmithForm(t *testing.T) {
+	t.Run("known invariants", func(t *testing.T) {
+		mat := ratMatrix(t, [][]int64{
+			{2, 4, 4},
+			{-6, 6, 12},
+			{10, -4, -16},
+		})
+
+		res, err := SmithForm(mat)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, res.Rank)
+		assertMatrixEqual(t, ratMatrix(t, [][]int64{{2, 0, 0}, {0, 6, 0}, {0, 0, 12}}), res.D)
+
+		assertUnimodular(t, res.U)
+		assertUnimodular(t, res.V)
+		UA, _ := res.U.Mul(mat)
+		UAV, _ := UA.Mul(res.V)
+		assertMatrixEqual(t, res.D, UAV)
+	})
+
+	t.Run("random rectangular", func(t *testing.T) {
+		rng := rand.New(rand.NewSource(5))
+		for _, size := range [][2]int{{3, 5}, {5, 3}, {4, 4}} {
+			rows := make([][]int64, size[0])
+			for i := range rows {
+				rows[i] = make([]int64, size[1])
+				for j := range rows[i] {
+					rows[i][j] = rng.Int63n(21) - 10
+				}
+			}
+			mat := ratMatrix(t, rows)
+
+			res, err := SmithForm(mat)
+			assert.NoError(t, err)
+			UA, _ := res.U.Mul(mat)
+			UAV, _ := UA.Mul(res.V)
+			assertMatrixEqual(t, res.D, UAV)
+			assert.Equal(t, mat.Rank(), res.Rank)
+			for i := 1; i < len(res.Invariants); i++ {
+				q, _ := res.Invariants[i].Div(res.Invariants[i-1])
+				assert.Equal(t, "1", q.Den().String(), "d_(i-1) должен делить d_i")
+			}
+		}
+	})
+}
+
+func TestSolveInteger(t *testing.T) {
+	t.Run("solvable", func(t *testing.T) {
+		// 2x + 3y + 5z = 7, 4x + 1y - z = 3
+		mat := ratMatrix(t, [][]int64{{2, 3, 5}, {4, 1, -1}})
+		b := ratVector(7, 3)
+
+		res, err := SolveInteger(mat, b)
+		assert.NoError(t, err)
+		assert.True(t, res.Solvable)
+		assert.Len(t, res.Kernel, 1)
+
+		x := res.Particular.Data
+		for _, k := range res.Kernel {
+			for i := range x {
+				x[i] = x[i].Add(k.Data[i].Mul(field.NewRational(3, 1)))
+			}
+		}
+		ax := matVec(mat, x)
+		for i := range ax {
+			assert.True(t, ax[i].Equal(b.Data[i]))
+		}
+		for _, k := range res.Kernel {
+			for _, v := range matVec(mat, k.Data) {
+				assert.Equal(t, 0, v.Sign())
+			}
+		}
+	})
+
+	t.Run("no integer solution", func(t *testing.T) {
+		// 2x + 4y = 3 имеет рациональные, но не целые решения
+		res, err := SolveInteger(ratMatrix(t, [][]int64{{2, 4}}), ratVector(3))
+		assert.NoError(t, err)
+		assert.False(t, res.Solvable)
+	})
+
+	t.Run("inconsistent", func(t *testing.T) {
+		res, err := SolveInteger(ratMatrix(t, [][]int64{{1, 1}, {2, 2}}), ratVector(1, 3))
+		assert.NoError(t, err)
+		assert.False(t, res.Solvable)
+	})
+}
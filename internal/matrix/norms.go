@@ -0,0 +1,238 @@
+package matrix
+
+import (
+	"MatrixGo/internal/field"
+	"MatrixGo/internal/vector"
+	"errors"
+	"fmt"
+	"math"
+)
+
+// NormKind задает вид матричной нормы
+type NormKind int
+
+const (
+	NormOne       NormKind = iota // максимальная сумма модулей по столбцам
+	NormInf                       // максимальная сумма модулей по строкам
+	NormFrobenius                 // корень из суммы квадратов модулей
+	NormMax                       // максимальный модуль элемента
+)
+
+// String возвращает название нормы
+func (k NormKind) String() string {
+	switch k {
+	case NormOne:
+		return "1"
+	case NormInf:
+		return "inf"
+	case NormFrobenius:
+		return "frobenius"
+	case NormMax:
+		return "max"
+	}
+	return fmt.Sprintf("NormKind(%d)", int(k))
+}
+
+// conditionEstimateSteps ограничивает число итераций оценщика Хейджера–Хайэма
+const conditionEstimateSteps = 5
+
+// magnitude возвращает модуль элемента: для rational — модуль дроби, для float64 и complex —
+// Abs(). Для конечных полей модуль не определен.
+func magnitude[T field.Field[T]](x T) (float64, error) {
+	switch v := any(x).(type) {
+	case field.Rational:
+		return math.Abs(v.Float64()), nil
+	case interface{ Abs() float64 }:
+		return v.Abs(), nil
+	}
+	return 0, ErrNormUndefined
+}
+
+// Norm вычисляет матричную норму заданного вида для упорядоченных (float64, rational)
+// и комплексных полей. Для GF возвращается ErrNormUndefined.
+func (m *Matrix[T]) Norm(kind NormKind) (float64, error) {
+	if kind < NormOne || kind > NormMax {
+		return 0, fmt.Errorf("неизвестная норма %d", kind)
+	}
+	rowSums := make([]float64, m.Rows)
+	colSums := make([]float64, m.Cols)
+	frobenius, maxAbs := 0.0, 0.0
+	for i := 0; i < m.Rows; i++ {
+		for j := 0; j < m.Cols; j++ {
+			a, err := magnitude(m.Data[i][j])
+			if err != nil {
+				return 0, err
+			}
+			rowSums[i] += a
+			colSums[j] += a
+			frobenius = math.Hypot(frobenius, a)
+			maxAbs = math.Max(maxAbs, a)
+		}
+	}
+
+	switch kind {
+	case NormOne:
+		return maxOf(colSums), nil
+	case NormInf:
+		return maxOf(rowSums), nil
+	case NormFrobenius:
+		return frobenius, nil
+	}
+	return maxAbs, nil
+}
+
+// maxOf возвращает наибольший элемент среза (0 для пустого)
+func maxOf(values []float64) float64 {
+	res := 0.0
+	for _, v := range values {
+		res = math.Max(res, v)
+	}
+	return res
+}
+
+// vectorNormInf вычисляет ∞-норму вектора
+func vectorNormInf[T field.Field[T]](v []T) (float64, error) {
+	norm := 0.0
+	for _, x := range v {
+		a, err := magnitude(x)
+		if err != nil {
+			return 0, err
+		}
+		norm = math.Max(norm, a)
+	}
+	return norm, nil
+}
+
+// EstimateCondition1 оценивает число обусловленности κ₁(A) = ||A||₁*||A⁻¹||₁ без вычисления
+// обратной матрицы: ||A⁻¹||₁ оценивается снизу алгоритмом Хейджера–Хайэма за несколько решений
+// систем с A и Aᴴ, для которых используется одно LU-разложение (O(n²) после разложения).
+// Оценка обычно совпадает с точным значением или отличается от него в пределах нескольких раз.
+// Для вырожденной матрицы возвращается +Inf.
+func EstimateCondition1[T field.Scalar[T]](m *Matrix[T]) (float64, error) {
+	if m.Rows != m.Cols {
+		return 0, errors.New("матрица должна быть квадратной")
+	}
+	lu, err := m.LU(true)
+	if err != nil {
+		return 0, err
+	}
+	if lu.IsSingular() {
+		return math.Inf(1), nil
+	}
+	return conditionFromLU(m, lu)
+}
+
+// conditionFromLU вычисляет оценку κ₁ по готовому разложению невырожденной матрицы
+func conditionFromLU[T field.Scalar[T]](m *Matrix[T], lu *LUFactorization[T]) (float64, error) {
+	inverseNorm, err := EstimateInverseNorm1(lu)
+	if err != nil {
+		return 0, err
+	}
+	return norm1(m) * inverseNorm, nil
+}
+
+// EstimateInverseNorm1 оценивает ||A⁻¹||₁ по LU-разложению (алгоритм Хейджера в варианте Хайэма).
+// Начиная с x = (1/n, ..., 1/n), метод поднимается по выпуклой функции ||A⁻¹x||₁ к вершине
+// единичного шара e_j, пока значение растет. Дополнительная проба со знакопеременным вектором
+// защищает от матриц, на которых градиентный подъем останавливается слишком рано.
+func EstimateInverseNorm1[T field.Scalar[T]](f *LUFactorization[T]) (float64, error) {
+	if f.IsSingular() {
+		return math.Inf(1), nil
+	}
+	n := f.U.Rows
+	zero := f.U.Data[0][0].Zero()
+	solve := func(x []T) ([]T, error) {
+		y, err := f.Solve(vector.NewVector(x))
+		if err != nil {
+			return nil, err
+		}
+		return y.Data, nil
+	}
+	// Aᴴ*z = ξ равносильно Aᵀ*conj(z) = conj(ξ)
+	solveAdjoint := func(x []T) ([]T, error) {
+		conj := make([]T, n)
+		for i, v := range x {
+			conj[i] = v.Conj()
+		}
+		z, err := f.SolveTranspose(vector.NewVector(conj))
+		if err != nil {
+			return nil, err
+		}
+		for i, v := range z.Data {
+			z.Data[i] = v.Conj()
+		}
+		return z.Data, nil
+	}
+	absSum := func(v []T) float64 {
+		sum := 0.0
+		for _, x := range v {
+			sum += x.Abs()
+		}
+		return sum
+	}
+
+	x := make([]T, n)
+	for i := range x {
+		x[i] = zero.FromFloat64(1 / float64(n))
+	}
+	estimate, last := 0.0, -1
+	for step := 0; step < conditionEstimateSteps; step++ {
+		y, err := solve(x)
+		if err != nil {
+			return 0, err
+		}
+		value := absSum(y)
+		if step > 0 && value <= estimate {
+			break
+		}
+		estimate = value
+
+		// ξ = sign(y), для комплексных чисел — y/|y|
+		xi := make([]T, n)
+		for i, v := range y {
+			if a := v.Abs(); a == 0 {
+				xi[i] = zero.One()
+			} else {
+				xi[i] = v.Scale(1 / a)
+			}
+		}
+		z, err := solveAdjoint(xi)
+		if err != nil {
+			return 0, err
+		}
+
+		// Подъем прекращается, если ни одна вершина не увеличивает значение: ||z||∞ ≤ Re(zᴴx)
+		j, zMax, zx := 0, 0.0, 0.0
+		for i, v := range z {
+			if a := v.Abs(); a > zMax {
+				j, zMax = i, a
+			}
+			zx += v.Real()*x[i].Real() + v.Imag()*x[i].Imag()
+		}
+		if zMax <= zx || j == last {
+			break
+		}
+		last = j
+		for i := range x {
+			x[i] = zero
+		}
+		x[j] = zero.One()
+	}
+
+	// Проба x_i = (-1)^i (1 + i/(n-1))
+	for i := range x {
+		v := 1.0
+		if n > 1 {
+			v += float64(i) / float64(n-1)
+		}
+		if i%2 == 1 {
+			v = -v
+		}
+		x[i] = zero.FromFloat64(v)
+	}
+	y, err := solve(x)
+	if err != nil {
+		return 0, err
+	}
+	return math.Max(estimate, 2*absSum(y)/(3*float64(n))), nil
+}
@@ -0,0 +1,176 @@
+package matrix
+
+import (
+	"MatrixGo/internal/field"
+	"MatrixGo/internal/vector"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// scaledHilbert строит матрицу Гильберта порядка n, умноженную на НОК(1..2n-1), чтобы все
+// элементы были целыми и точно представимыми в float64
+func scaledHilbert(n int) *Matrix[field.Float64] {
+	lcm := int64(1)
+	for k := int64(2); k < int64(2*n); k++ {
+		a, b := lcm, k
+		for b != 0 {
+			a, b = b, a%b
+		}
+		lcm = lcm / a * k
+	}
+	m := NewMatrix[field.Float64](n, n, 0)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			m.Data[i][j] = field.Float64(lcm / int64(i+j+1))
+		}
+	}
+	return m
+}
+
+func TestNorms(t *testing.T) {
+	mat := ratMatrix(t, [][]int64{{1, -2, 3}, {-4, 5, -6}})
+	expected := map[NormKind]float64{NormOne: 9, NormInf: 15, NormFrobenius: math.Sqrt(91), NormMax: 6}
+	for kind, value := range expected {
+		norm, err := mat.Norm(kind)
+		assert.NoError(t, err)
+		assert.InDelta(t, value, norm, 1e-12, kind.String())
+	}
+
+	c, _ := FromSlice([][]field.Complex{{{Re: 3, Im: 4}, {Re: 1}}, {{Im: -2}, {Re: 0}}})
+	norm, err := c.Norm(NormOne)
+	assert.NoError(t, err)
+	assert.InDelta(t, 7, norm, 1e-12)
+	norm, err = c.Norm(NormFrobenius)
+	assert.NoError(t, err)
+	assert.InDelta(t, math.Sqrt(30), norm, 1e-12)
+
+	gf, _ := field.NewGF(1, 5)
+	_, err = NewMatrix(2, 2, gf).Norm(NormOne)
+	assert.ErrorIs(t, err, ErrNormUndefined)
+	_, err = mat.Norm(NormKind(10))
+	assert.Error(t, err)
+}
+
+func TestSolveTranspose(t *testing.T) {
+	mat := ratMatrix(t, [][]int64{{0, 2, 1}, {1, 1, 0}, {3, 0, 4}})
+	lu, err := mat.LU(false)
+	assert.NoError(t, err)
+	b := ratVector(1, 2, 3)
+	x, err := lu.SolveTranspose(b)
+	assert.NoError(t, err)
+	expected, _ := solveSystemGauss(mat.Transpose(), b)
+	assert.Equal(t, expected.Data, x.Data)
+}
+
+func TestEstimateCondition1(t *testing.T) {
+	rng := rand.New(rand.NewSource(48))
+	for trial := 0; trial < 20; trial++ {
+		mat := randomFloatMatrix(rng, 12)
+		inv, err := mat.Inverse()
+		assert.NoError(t, err)
+		exact := norm1(mat) * norm1(inv)
+		estimate, err := EstimateCondition1(mat)
+		assert.NoError(t, err)
+		// Оценка снизу, обычно точная
+		assert.LessOrEqual(t, estimate, exact*(1+1e-9))
+		assert.GreaterOrEqual(t, estimate, exact/3)
+	}
+
+	hilbert := scaledHilbert(8)
+	inv, _ := hilbert.Inverse()
+	estimate, err := EstimateCondition1(hilbert)
+	assert.NoError(t, err)
+	assert.InEpsilon(t, norm1(hilbert)*norm1(inv), estimate, 1e-3)
+
+	c, _ := FromSlice([][]field.Complex{{{Re: 1}, {Im: 1}}, {{Re: 2}, {Re: 1, Im: 1}}})
+	cInv, _ := c.Inverse()
+	estimate, err = EstimateCondition1(c)
+	assert.NoError(t, err)
+	assert.InEpsilon(t, norm1(c)*norm1(cInv), estimate, 1e-9)
+
+	singular, _ := FromSlice([][]field.Float64{{1, 2}, {2, 4}})
+	estimate, err = EstimateCondition1(singular)
+	assert.NoError(t, err)
+	assert.True(t, math.IsInf(estimate, 1))
+}
+
+func TestSolveRefined(t *testing.T) {
+	const n = 8
+	hilbert := scaledHilbert(n)
+	ones := make([]field.Float64, n)
+	for i := range ones {
+		ones[i] = 1
+	}
+	b, _ := hilbert.MulVec(vector.NewVector(ones))
+
+	maxError := func(x *vector.Vector[field.Float64]) float64 {
+		res := 0.0
+		for _, xi := range x.Data {
+			res = math.Max(res, math.Abs(float64(xi)-1))
+		}
+		return res
+	}
+
+	lu, _ := hilbert.LU(false)
+	plain, _ := lu.Solve(b)
+	x, diag, err := SolveRefined(hilbert, b, 0)
+	assert.NoError(t, err)
+	assert.Greater(t, diag.RefinementSteps, 0)
+	assert.Less(t, maxError(x), 1e-12)
+	assert.Less(t, maxError(x), maxError(plain)/100)
+	assert.Less(t, diag.BackwardError, 1e-15)
+	assert.Greater(t, diag.Condition, 1e9)
+
+	before, err := DiagnoseSolution(hilbert, b, plain)
+	assert.NoError(t, err)
+	assert.GreaterOrEqual(t, before.Residual, diag.Residual)
+	assert.Equal(t, 0, before.RefinementSteps)
+
+	_, _, err = SolveRefined(hilbert, vector.NewVector(ones[:3]), 0)
+	assert.Error(t, err)
+}
+
+func TestSolveRefinedSingular(t *testing.T) {
+	// Несовместная система с вырожденной матрицей: решения нет ни в точной, ни в
+	// приближенной арифметике, поэтому SolveRefined не должен возвращать вектор
+	singular, _ := FromSlice([][]field.Float64{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}})
+	b := vector.NewVector([]field.Float64{1, 2, 4})
+	x, d, err := SolveRefined(singular, b, 0)
+	assert.Error(t, err)
+	assert.Nil(t, x)
+	assert.Nil(t, d)
+
+	// Диагностика произвольного вектора для вырожденной матрицы: κ₁ = +∞
+	diag, err := DiagnoseSolution(singular, b, vector.NewVector([]field.Float64{1, 1, 1}))
+	assert.NoError(t, err)
+	assert.True(t, math.IsInf(diag.Condition, 1))
+	assert.True(t, math.IsInf(diag.ForwardErrorBound, 1))
+
+	estimate, err := EstimateCondition1(singular)
+	assert.NoError(t, err)
+	assert.True(t, math.IsInf(estimate, 1))
+
+	// Ведущий элемент 3ε*s проходит порог LU, но κ₁ ≈ 4/(3ε) > 1/ε: решение отвергается.
+	// Множитель s = 2^27 точен и выводит ведущий элемент за абсолютный допуск Equal.
+	const s = 1 << 27
+	nearly, _ := FromSlice([][]field.Float64{{s, s}, {s, s * (1 + 3*machineEpsilon)}})
+	_, err = nearly.LU(false)
+	assert.NoError(t, err)
+	_, _, err = SolveRefined(nearly, vector.NewVector([]field.Float64{2 * s, 2 * s}), 0)
+	assert.Error(t, err)
+}
+
+func TestDiagnoseInverse(t *testing.T) {
+	mat, _ := FromSlice([][]field.Float64{{4, 1}, {2, 3}})
+	inv, _ := mat.Inverse()
+	d, err := DiagnoseInverse(mat, inv)
+	assert.NoError(t, err)
+	assert.Less(t, d.Residual, 1e-15)
+	assert.InDelta(t, 3, d.Condition, 1e-12)
+
+	_, err = DiagnoseInverse(mat, NewMatrix[field.Float64](3, 3, 0))
+	assert.ErrorIs(t, err, ErrDimensionMismatch)
+}
@@ -0,0 +1,82 @@
+package matrix
+
+import (
+	"MatrixGo/internal/field"
+	"errors"
+)
+
+// matrixMultiplier — способ умножения матриц: последовательный Mul или MultiplyParallel
+type matrixMultiplier[T field.Field[T]] func(a, b *Matrix[T]) (*Matrix[T], error)
+
+func serialMul[T field.Field[T]](a, b *Matrix[T]) (*Matrix[T], error) {
+	return a.Mul(b)
+}
+
+func parallelMul[T field.Field[T]](a, b *Matrix[T]) (*Matrix[T], error) {
+	return a.MultiplyParallel(b)
+}
+
+// luInverse обращает матрицу через LU-разложение с порогом для ведущих элементов,
+// поэтому численно вырожденная матрица дает ошибку, а не обратную из ошибок округления
+func luInverse[T field.Field[T]](factorize func(*Matrix[T]) (*LUFactorization[T], error)) func(*Matrix[T]) (*Matrix[T], error) {
+	return func(a *Matrix[T]) (*Matrix[T], error) {
+		lu, err := factorize(a)
+		if err != nil {
+			return nil, err
+		}
+		return lu.Inverse()
+	}
+}
+
+// Pow возводит квадратную матрицу в целую степень бинарным возведением (O(log k) умножений).
+// Отрицательная степень вычисляется как степень обратной матрицы, A^0 = I.
+func (m *Matrix[T]) Pow(k int64) (*Matrix[T], error) {
+	return matrixPow(m, k, serialMul[T], luInverse(func(a *Matrix[T]) (*LUFactorization[T], error) {
+		return a.LU(false)
+	}))
+}
+
+// PowParallel — параллельная версия Pow на основе MultiplyParallel и LUParallel
+func (m *Matrix[T]) PowParallel(k int64) (*Matrix[T], error) {
+	return matrixPow(m, k, parallelMul[T], luInverse(func(a *Matrix[T]) (*LUFactorization[T], error) {
+		return a.LUParallel(false)
+	}))
+}
+
+func matrixPow[T field.Field[T]](m *Matrix[T], k int64, mul matrixMultiplier[T], inverse func(*Matrix[T]) (*Matrix[T], error)) (*Matrix[T], error) {
+	if m.Rows != m.Cols {
+		return nil, errors.New("матрица должна быть квадратной")
+	}
+
+	base := m
+	if k < 0 {
+		inv, err := inverse(m)
+		if err != nil {
+			return nil, err
+		}
+		base = inv
+	}
+
+	zero := m.Data[0][0].Zero()
+	result := IdentityMatrix[T](m.Rows, zero, zero.One())
+	// -k переполняется при k = MinInt64, поэтому работаем с беззнаковым модулем
+	e := uint64(k)
+	if k < 0 {
+		e = -e
+	}
+	var err error
+	for e > 0 {
+		if e&1 == 1 {
+			if result, err = mul(result, base); err != nil {
+				return nil, err
+			}
+		}
+		e >>= 1
+		if e > 0 {
+			if base, err = mul(base, base); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,78 @@
+package matrix
+
+import (
+	"MatrixGo/internal/field"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPow(t *testing.T) {
+	// Степени матрицы Фибоначчи: [[1,1],[1,0]]^k = [[F(k+1), F(k)], [F(k), F(k-1)]]
+	fib := ratMatrix(t, [][]int64{{1, 1}, {1, 0}})
+
+	p, err := fib.Pow(10)
+	assert.NoError(t, err)
+	assertMatrixEqual(t, ratMatrix(t, [][]int64{{89, 55}, {55, 34}}), p)
+
+	p, err = fib.Pow(0)
+	assert.NoError(t, err)
+	assertMatrixEqual(t, ratMatrix(t, [][]int64{{1, 0}, {0, 1}}), p)
+
+	// Отрицательная степень: A^(-3) * A^3 = I
+	inv, err := fib.Pow(-3)
+	assert.NoError(t, err)
+	cube, _ := fib.Pow(3)
+	prod, _ := inv.Mul(cube)
+	assertMatrixEqual(t, ratMatrix(t, [][]int64{{1, 0}, {0, 1}}), prod)
+
+	par, err := fib.PowParallel(-3)
+	assert.NoError(t, err)
+	assertMatrixEqual(t, inv, par)
+
+	par, err = fib.PowParallel(25)
+	assert.NoError(t, err)
+	assertMatrixEqual(t, ratMatrix(t, [][]int64{{121393, 75025}, {75025, 46368}}), par)
+}
+
+func TestPowGF(t *testing.T) {
+	// F(k) mod 7 имеет период 16, поэтому A^16 = I над GF(7)
+	gf := func(v int64) field.GF {
+		x, _ := field.NewGF(v, 7)
+		return x
+	}
+	fib, _ := FromSlice([][]field.GF{{gf(1), gf(1)}, {gf(1), gf(0)}})
+	p, err := fib.Pow(16)
+	assert.NoError(t, err)
+	id, _ := FromSlice([][]field.GF{{gf(1), gf(0)}, {gf(0), gf(1)}})
+	assertMatrixEqual(t, id, p)
+
+	// A^(-1) = A^15
+	inv, err := fib.Pow(-1)
+	assert.NoError(t, err)
+	p15, _ := fib.PowParallel(15)
+	assertMatrixEqual(t, p15, inv)
+}
+
+func TestPowErrors(t *testing.T) {
+	_, err := ratMatrix(t, [][]int64{{1, 2, 3}}).Pow(2)
+	assert.Error(t, err)
+
+	singular := ratMatrix(t, [][]int64{{1, 2}, {2, 4}})
+	_, err = singular.Pow(-1)
+	assert.Error(t, err)
+	_, err = singular.PowParallel(-2)
+	assert.Error(t, err)
+
+	// Численно вырожденная матрица: последний ведущий элемент — ошибка округления
+	singularFloat, _ := FromSlice([][]field.Float64{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}})
+	_, err = singularFloat.Pow(-1)
+	assert.Error(t, err)
+	_, err = singularFloat.PowParallel(-1)
+	assert.Error(t, err)
+
+	// Неотрицательные степени вырожденной матрицы допустимы
+	p, err := singular.Pow(2)
+	assert.NoError(t, err)
+	assertMatrixEqual(t, ratMatrix(t, [][]int64{{5, 10}, {10, 20}}), p)
+}
@@ -0,0 +1,419 @@
+package matrix
+
+import (
+	"MatrixGo/internal/field"
+	"MatrixGo/internal/polynomial"
+	"errors"
+	"math"
+)
+
+// DefaultPropertyTolerance — допуск по умолчанию при проверке свойств матриц float64 и complex
+const DefaultPropertyTolerance = 1e-9
+
+// ErrPropertyUndefined сообщает, что свойство не определено для элементов данного поля
+var ErrPropertyUndefined = errors.New("свойство не определено для элементов данного поля")
+
+// PropertyReport — отчет о структурных и алгебраических свойствах матрицы. Для точных полей
+// (rational, GF) все проверки точные; для float64 и complex элементы сравниваются с допуском
+// Tolerance относительно масштаба max(1, max|a_ij|).
+type PropertyReport struct {
+	Rows, Cols      int
+	Exact           bool    // проверки выполнены в точной арифметике
+	Tolerance       float64 // использованный допуск (0 для точных полей)
+	Square          bool
+	Symmetric       bool // A = Aᵀ
+	SkewSymmetric   bool // A = -Aᵀ
+	Hermitian       bool // A = Aᴴ (для вещественных полей совпадает с Symmetric)
+	Orthogonal      bool // AᵀA = I
+	Unitary         bool // AᴴA = I (для вещественных полей совпадает с Orthogonal)
+	Diagonal        bool
+	UpperTriangular bool
+	LowerTriangular bool
+	Idempotent      bool // A² = A
+	Involutory      bool // A² = I
+	Nilpotent       bool // A^k = 0 при некотором k
+	NilpotencyIndex int  // наименьшее такое k (0, если матрица не нильпотентна)
+	// PositiveDefinite — эрмитова матрица с xᴴAx > 0; nil, если свойство не определено (GF)
+	PositiveDefinite *bool
+	// Diagonalizable — диагонализуемость над алгебраическим замыканием поля (для float64 — над C);
+	// nil, если проверку выполнить не удалось
+	Diagonalizable *bool
+}
+
+// elementComparer сравнивает элементы точно или с допуском
+type elementComparer[T field.Field[T]] struct {
+	exact bool
+	tol   float64
+}
+
+// newComparer выбирает точное сравнение для rational и GF и сравнение с допуском для остальных полей
+func newComparer[T field.Field[T]](x T, tol float64) elementComparer[T] {
+	if tol <= 0 {
+		tol = DefaultPropertyTolerance
+	}
+	if isExactField(x) {
+		return elementComparer[T]{exact: true}
+	}
+	return elementComparer[T]{tol: tol}
+}
+
+// equal сравнивает a и b; scale — масштаб величин, с которым сравнивается разность
+func (c elementComparer[T]) equal(a, b T, scale float64) bool {
+	if c.exact {
+		return a.Equal(b)
+	}
+	d, err := magnitude(a.Sub(b))
+	if err != nil {
+		return a.Equal(b)
+	}
+	return d <= c.tol*math.Max(1, scale)
+}
+
+// matricesEqual сравнивает матрицы одинакового размера поэлементно
+func (c elementComparer[T]) matricesEqual(x, y *Matrix[T]) bool {
+	scale := 0.0
+	if !c.exact {
+		a, _ := x.Norm(NormMax)
+		b, _ := y.Norm(NormMax)
+		scale = math.Max(a, b)
+	}
+	for i := 0; i < x.Rows; i++ {
+		for j := 0; j < x.Cols; j++ {
+			if !c.equal(x.Data[i][j], y.Data[i][j], scale) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// isZero проверяет, что все элементы матрицы равны нулю
+func (c elementComparer[T]) isZero(m *Matrix[T]) bool {
+	zero := m.Data[0][0].Zero()
+	for i := 0; i < m.Rows; i++ {
+		for j := 0; j < m.Cols; j++ {
+			if !c.equal(m.Data[i][j], zero, 0) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// conjugate возвращает комплексно сопряженный элемент (для остальных полей — сам элемент)
+func conjugate[T field.Field[T]](x T) T {
+	if c, ok := any(x).(interface{ Conj() T }); ok {
+		return c.Conj()
+	}
+	return x
+}
+
+// adjoint возвращает Aᵀ или, при conj = true, Aᴴ
+func adjoint[T field.Field[T]](m *Matrix[T], conj bool) *Matrix[T] {
+	res := m.Transpose()
+	if conj {
+		for i := range res.Data {
+			for j := range res.Data[i] {
+				res.Data[i][j] = conjugate(res.Data[i][j])
+			}
+		}
+	}
+	return res
+}
+
+// maxScale возвращает max|a_ij| для сравнения с допуском (0 для точных полей)
+func (c elementComparer[T]) maxScale(m *Matrix[T]) float64 {
+	if c.exact {
+		return 0
+	}
+	s, _ := m.Norm(NormMax)
+	return s
+}
+
+// checkSymmetry проверяет a_ij = sign * conj?(a_ji)
+func checkSymmetry[T field.Field[T]](m *Matrix[T], c elementComparer[T], negate, conj bool) bool {
+	if m.Rows != m.Cols {
+		return false
+	}
+	scale := c.maxScale(m)
+	for i := 0; i < m.Rows; i++ {
+		for j := 0; j <= i; j++ {
+			other := m.Data[j][i]
+			if conj {
+				other = conjugate(other)
+			}
+			if negate {
+				other = other.Neg()
+			}
+			if !c.equal(m.Data[i][j], other, scale) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// IsSymmetric проверяет A = Aᵀ (tol используется только для float64 и complex; при tol ≤ 0 —
+// DefaultPropertyTolerance)
+func (m *Matrix[T]) IsSymmetric(tol float64) bool {
+	return checkSymmetry(m, newComparer(m.Data[0][0], tol), false, false)
+}
+
+// IsSkewSymmetric проверяет A = -Aᵀ
+func (m *Matrix[T]) IsSkewSymmetric(tol float64) bool {
+	return checkSymmetry(m, newComparer(m.Data[0][0], tol), true, false)
+}
+
+// IsHermitian проверяет A = Aᴴ
+func (m *Matrix[T]) IsHermitian(tol float64) bool {
+	return checkSymmetry(m, newComparer(m.Data[0][0], tol), false, true)
+}
+
+// IsOrthogonal проверяет AᵀA = I
+func (m *Matrix[T]) IsOrthogonal(tol float64) bool {
+	return isOrthonormal(m, newComparer(m.Data[0][0], tol), false)
+}
+
+// IsUnitary проверяет AᴴA = I
+func (m *Matrix[T]) IsUnitary(tol float64) bool {
+	return isOrthonormal(m, newComparer(m.Data[0][0], tol), true)
+}
+
+func isOrthonormal[T field.Field[T]](m *Matrix[T], c elementComparer[T], conj bool) bool {
+	if m.Rows != m.Cols {
+		return false
+	}
+	prod, _ := adjoint(m, conj).Mul(m)
+	return c.matricesEqual(prod, IdentityMatrix(m.Rows, m.Data[0][0].Zero(), m.Data[0][0].One()))
+}
+
+// IsDiagonal проверяет, что все элементы вне главной диагонали равны нулю
+func (m *Matrix[T]) IsDiagonal(tol float64) bool {
+	return zeroOutside(m, newComparer(m.Data[0][0], tol), 0, 0)
+}
+
+// IsUpperTriangular проверяет, что все элементы ниже главной диагонали равны нулю
+func (m *Matrix[T]) IsUpperTriangular(tol float64) bool {
+	return zeroOutside(m, newComparer(m.Data[0][0], tol), 0, m.Cols)
+}
+
+// IsLowerTriangular проверяет, что все элементы выше главной диагонали равны нулю
+func (m *Matrix[T]) IsLowerTriangular(tol float64) bool {
+	return zeroOutside(m, newComparer(m.Data[0][0], tol), m.Rows, 0)
+}
+
+// zeroOutside проверяет, что вне полосы -lower ≤ j - i ≤ upper все элементы нулевые
+func zeroOutside[T field.Field[T]](m *Matrix[T], c elementComparer[T], lower, upper int) bool {
+	zero := m.Data[0][0].Zero()
+	scale := c.maxScale(m)
+	for i := 0; i < m.Rows; i++ {
+		for j := 0; j < m.Cols; j++ {
+			if (j-i > upper || i-j > lower) && !c.equal(m.Data[i][j], zero, scale) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// IsIdempotent проверяет A² = A
+func (m *Matrix[T]) IsIdempotent(tol float64) bool {
+	if m.Rows != m.Cols {
+		return false
+	}
+	sq, _ := m.Mul(m)
+	return newComparer(m.Data[0][0], tol).matricesEqual(sq, m)
+}
+
+// IsInvolutory проверяет A² = I
+func (m *Matrix[T]) IsInvolutory(tol float64) bool {
+	if m.Rows != m.Cols {
+		return false
+	}
+	sq, _ := m.Mul(m)
+	return newComparer(m.Data[0][0], tol).matricesEqual(sq, IdentityMatrix(m.Rows, m.Data[0][0].Zero(), m.Data[0][0].One()))
+}
+
+// NilpotencyIndex возвращает наименьшее k, при котором A^k = 0, или 0, если матрица не
+// нильпотентна. Индекс нильпотентности не превышает n, поэтому достаточно n умножений.
+// Для float64 и complex A^k считается нулевой, если ||A^k||₁ ≤ tol·||A||₁^k: отношение
+// не зависит от масштаба и размера A, а для A = cI при c ≠ 0 равно 1 при любом k.
+func (m *Matrix[T]) NilpotencyIndex(tol float64) int {
+	if m.Rows != m.Cols {
+		return 0
+	}
+	c := newComparer(m.Data[0][0], tol)
+	if !c.exact {
+		return numericNilpotencyIndex(m, c.tol)
+	}
+	power := m
+	for k := 1; k <= m.Rows; k++ {
+		if c.isZero(power) {
+			return k
+		}
+		power, _ = power.Mul(m)
+	}
+	return 0
+}
+
+// numericNilpotencyIndex проверяет ||B^k||₁ ≤ tol для B = A/||A||₁; нормировка исключает
+// переполнение и исчезновение порядка при возведении в степень
+func numericNilpotencyIndex[T field.Field[T]](m *Matrix[T], tol float64) int {
+	norm, err := m.Norm(NormOne)
+	if err != nil {
+		return 0
+	}
+	if norm == 0 {
+		return 1
+	}
+	b := m.Clone()
+	for i := range b.Data {
+		for j := range b.Data[i] {
+			x, ok := any(b.Data[i][j]).(interface{ Scale(float64) T })
+			if !ok {
+				return 0
+			}
+			b.Data[i][j] = x.Scale(1 / norm)
+		}
+	}
+	power := b
+	for k := 2; k <= m.Rows; k++ {
+		power, _ = power.Mul(b)
+		if p, _ := power.Norm(NormOne); p <= tol {
+			return k
+		}
+	}
+	return 0
+}
+
+// IsPositiveDefinite проверяет, что матрица эрмитова (симметрична) и xᴴAx > 0 для всех x ≠ 0.
+// Для rational проверка точная (LDLᵀ с положительными ведущими элементами), для float64
+// и complex используется разложение Холецкого эрмитовой части. Для GF возвращается
+// ErrPropertyUndefined.
+func (m *Matrix[T]) IsPositiveDefinite(tol float64) (bool, error) {
+	if _, ok := any(m.Data[0][0]).(field.GF); ok {
+		return false, ErrPropertyUndefined
+	}
+	if !m.IsHermitian(tol) {
+		return false, nil
+	}
+	var err error
+	switch v := any(m).(type) {
+	case *Matrix[field.Rational]:
+		_, err = LDL(v, true)
+	case *Matrix[field.Float64]:
+		_, err = Cholesky(hermitianPart(v))
+	case *Matrix[field.Complex]:
+		_, err = Cholesky(hermitianPart(v))
+	default:
+		return false, ErrPropertyUndefined
+	}
+	return err == nil, nil
+}
+
+// hermitianPart возвращает (A + Aᴴ)/2, устраняя несимметричность на уровне погрешности
+func hermitianPart[T field.Scalar[T]](m *Matrix[T]) *Matrix[T] {
+	res := m.Clone()
+	for i := 0; i < m.Rows; i++ {
+		for j := 0; j < m.Cols; j++ {
+			res.Data[i][j] = m.Data[i][j].Add(m.Data[j][i].Conj()).Scale(0.5)
+		}
+	}
+	return res
+}
+
+// IsDiagonalizable проверяет диагонализуемость над алгебраическим замыканием поля.
+// Для rational и GF проверка точная: матрица диагонализуема тогда и только тогда, когда ее
+// минимальный многочлен не имеет кратных корней, то есть взаимно прост со своей производной.
+// Для float64 и complex проверяется, что найденные собственные векторы образуют хорошо
+// обусловленный базис и действительно удовлетворяют A*V = V*Λ с допуском tol.
+func (m *Matrix[T]) IsDiagonalizable(tol float64) (bool, error) {
+	if m.Rows != m.Cols {
+		return false, errors.New("матрица должна быть квадратной")
+	}
+	c := newComparer(m.Data[0][0], tol)
+	switch v := any(m).(type) {
+	case *Matrix[field.Float64]:
+		return numericallyDiagonalizable(v, c.tol)
+	case *Matrix[field.Complex]:
+		return numericallyDiagonalizable(v, c.tol)
+	}
+
+	minimal, err := MinimalPolynomial(m)
+	if err != nil {
+		return false, err
+	}
+	g, err := polynomial.GCD(minimal, minimal.Derivative())
+	if err != nil {
+		return false, err
+	}
+	return g.Degree() == 0, nil
+}
+
+// numericallyDiagonalizable проверяет диагонализуемость по вычисленному спектральному разложению
+func numericallyDiagonalizable[T field.Scalar[T]](m *Matrix[T], tol float64) (bool, error) {
+	// Нормальные матрицы (в частности, эрмитовы и унитарные) всегда диагонализуемы
+	c := elementComparer[T]{tol: tol}
+	adj := adjoint(m, true)
+	left, _ := m.Mul(adj)
+	right, _ := adj.Mul(m)
+	if c.matricesEqual(left, right) {
+		return true, nil
+	}
+
+	eig, err := Eigen(m)
+	if err != nil {
+		return false, err
+	}
+	A := toComplexMatrix(m)
+	scale := math.Max(1, frobeniusNorm(A))
+	AV, _ := A.Mul(eig.Vectors)
+	for i := 0; i < AV.Rows; i++ {
+		for j := 0; j < AV.Cols; j++ {
+			if AV.Data[i][j].Sub(eig.Vectors.Data[i][j].Mul(eig.Values[j])).Abs() > math.Sqrt(tol)*scale {
+				return false, nil
+			}
+		}
+	}
+	cond, err := ConditionNumber(eig.Vectors)
+	if err != nil {
+		return false, err
+	}
+	return cond*tol < 1, nil
+}
+
+// Analyze проверяет все свойства матрицы и возвращает отчет. Допуск tol используется для
+// float64 и complex (при tol ≤ 0 — DefaultPropertyTolerance), для точных полей игнорируется.
+func (m *Matrix[T]) Analyze(tol float64) *PropertyReport {
+	c := newComparer(m.Data[0][0], tol)
+	r := &PropertyReport{
+		Rows:            m.Rows,
+		Cols:            m.Cols,
+		Exact:           c.exact,
+		Tolerance:       c.tol,
+		Square:          m.Rows == m.Cols,
+		Symmetric:       m.IsSymmetric(tol),
+		SkewSymmetric:   m.IsSkewSymmetric(tol),
+		Hermitian:       m.IsHermitian(tol),
+		Orthogonal:      m.IsOrthogonal(tol),
+		Unitary:         m.IsUnitary(tol),
+		Diagonal:        m.IsDiagonal(tol),
+		UpperTriangular: m.IsUpperTriangular(tol),
+		LowerTriangular: m.IsLowerTriangular(tol),
+		Idempotent:      m.IsIdempotent(tol),
+		Involutory:      m.IsInvolutory(tol),
+		NilpotencyIndex: m.NilpotencyIndex(tol),
+	}
+	r.Nilpotent = r.NilpotencyIndex > 0
+	if !r.Square {
+		return r
+	}
+
+	if pd, err := m.IsPositiveDefinite(tol); err == nil {
+		r.PositiveDefinite = &pd
+	}
+	if diag, err := m.IsDiagonalizable(tol); err == nil {
+		r.Diagonalizable = &diag
+	}
+	return r
+}
@@ -0,0 +1,150 @@
+package matrix
+
+import (
+	"MatrixGo/internal/field"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnalyzeExact(t *testing.T) {
+	boolPtr := func(b bool) *bool { return &b }
+	tests := []struct {
+		name   string
+		matrix [][]int64
+		check  func(t *testing.T, r *PropertyReport)
+	}{
+		{"symmetric positive definite", [][]int64{{2, -1, 0}, {-1, 2, -1}, {0, -1, 2}}, func(t *testing.T, r *PropertyReport) {
+			assert.True(t, r.Symmetric)
+			assert.True(t, r.Hermitian)
+			assert.False(t, r.SkewSymmetric)
+			assert.Equal(t, boolPtr(true), r.PositiveDefinite)
+			assert.Equal(t, boolPtr(true), r.Diagonalizable)
+		}},
+		{"skew-symmetric", [][]int64{{0, 2}, {-2, 0}}, func(t *testing.T, r *PropertyReport) {
+			assert.True(t, r.SkewSymmetric)
+			assert.False(t, r.Symmetric)
+			assert.Equal(t, boolPtr(false), r.PositiveDefinite)
+			// Собственные значения ±2i различны: диагонализуема над C, хотя не над Q
+			assert.Equal(t, boolPtr(true), r.Diagonalizable)
+		}},
+		{"permutation", [][]int64{{0, 1, 0}, {0, 0, 1}, {1, 0, 0}}, func(t *testing.T, r *PropertyReport) {
+			assert.True(t, r.Orthogonal)
+			assert.True(t, r.Unitary)
+			assert.False(t, r.Involutory)
+		}},
+		{"nilpotent", [][]int64{{0, 1, 2}, {0, 0, 3}, {0, 0, 0}}, func(t *testing.T, r *PropertyReport) {
+			assert.True(t, r.Nilpotent)
+			assert.Equal(t, 3, r.NilpotencyIndex)
+			assert.True(t, r.UpperTriangular)
+			assert.False(t, r.LowerTriangular)
+			assert.Equal(t, boolPtr(false), r.Diagonalizable)
+		}},
+		{"projection", [][]int64{{1, 1}, {0, 0}}, func(t *testing.T, r *PropertyReport) {
+			assert.True(t, r.Idempotent)
+			assert.False(t, r.Nilpotent)
+			assert.Equal(t, boolPtr(true), r.Diagonalizable)
+		}},
+		{"reflection", [][]int64{{1, 0}, {0, -1}}, func(t *testing.T, r *PropertyReport) {
+			assert.True(t, r.Involutory)
+			assert.True(t, r.Diagonal)
+			assert.True(t, r.Orthogonal)
+			assert.Equal(t, boolPtr(false), r.PositiveDefinite)
+		}},
+		{"rectangular", [][]int64{{1, 0, 0}, {2, 3, 0}}, func(t *testing.T, r *PropertyReport) {
+			assert.False(t, r.Square)
+			assert.True(t, r.LowerTriangular)
+			assert.Nil(t, r.PositiveDefinite)
+			assert.Nil(t, r.Diagonalizable)
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := ratMatrix(t, tt.matrix).Analyze(0)
+			assert.True(t, r.Exact)
+			tt.check(t, r)
+		})
+	}
+}
+
+func TestAnalyzeGF(t *testing.T) {
+	// В GF(2) матрица [[1, 1], [0, 1]] инволютивна, но не диагонализуема: (x+1)² — минимальный многочлен
+	mat := gfMatrix(t, 2, [][]int64{{1, 1}, {0, 1}})
+	r := mat.Analyze(0)
+	assert.True(t, r.Involutory)
+	assert.Nil(t, r.PositiveDefinite)
+	assert.Equal(t, false, *r.Diagonalizable)
+
+	// x^3 - 1 в GF(3) равен (x-1)^3: перестановочная матрица не диагонализуема
+	perm := gfMatrix(t, 3, [][]int64{{0, 1, 0}, {0, 0, 1}, {1, 0, 0}})
+	diag, err := perm.IsDiagonalizable(0)
+	assert.NoError(t, err)
+	assert.False(t, diag)
+
+	_, err = mat.IsPositiveDefinite(0)
+	assert.ErrorIs(t, err, ErrPropertyUndefined)
+}
+
+func TestAnalyzeFloat(t *testing.T) {
+	c, s := math.Cos(0.3), math.Sin(0.3)
+	rotation, _ := FromSlice([][]field.Float64{{field.Float64(c), field.Float64(-s)}, {field.Float64(s), field.Float64(c)}})
+	r := rotation.Analyze(0)
+	assert.False(t, r.Exact)
+	assert.Equal(t, DefaultPropertyTolerance, r.Tolerance)
+	assert.True(t, r.Orthogonal)
+	assert.False(t, r.Symmetric)
+	assert.True(t, *r.Diagonalizable)
+
+	// Погрешность ниже допуска не нарушает симметричность
+	nearly, _ := FromSlice([][]field.Float64{{4, 1 + 1e-12}, {1, 3}})
+	assert.True(t, nearly.IsSymmetric(0))
+	assert.False(t, nearly.IsSymmetric(1e-14))
+	pd, err := nearly.IsPositiveDefinite(0)
+	assert.NoError(t, err)
+	assert.True(t, pd)
+
+	jordan, _ := FromSlice([][]field.Float64{{2, 1}, {0, 2}})
+	diag, err := jordan.IsDiagonalizable(0)
+	assert.NoError(t, err)
+	assert.False(t, diag)
+	defective, _ := FromSlice([][]field.Float64{{0, 1, 2}, {0, 0, 3}, {0, 0, 0}})
+	assert.Equal(t, 3, defective.NilpotencyIndex(0))
+
+	hermitian, _ := FromSlice([][]field.Complex{{{Re: 2}, {Re: 1, Im: -1}}, {{Re: 1, Im: 1}, {Re: 3}}})
+	r = hermitian.Analyze(0)
+	assert.True(t, r.Hermitian)
+	assert.False(t, r.Symmetric)
+	assert.True(t, *r.PositiveDefinite)
+
+	unitary, _ := FromSlice([][]field.Complex{{{Re: 0}, {Im: 1}}, {{Im: 1}, {Re: 0}}})
+	assert.True(t, unitary.IsUnitary(0))
+	assert.False(t, unitary.IsOrthogonal(0))
+}
+
+func TestNilpotencyIndexScaleInvariant(t *testing.T) {
+	scaledIdentity := func(n int, c float64) *Matrix[field.Float64] {
+		return IdentityMatrix[field.Float64](n, 0, field.Float64(c))
+	}
+	// Обратимые матрицы с малыми элементами не нильпотентны
+	for _, tt := range []struct {
+		n int
+		c float64
+	}{{3, 1e-3}, {30, 0.5}, {10, 0.1}, {2, 1e-6}} {
+		mat := scaledIdentity(tt.n, tt.c)
+		assert.Equal(t, 0, mat.NilpotencyIndex(0), "%d×%d, c = %g", tt.n, tt.n, tt.c)
+		assert.False(t, mat.Analyze(0).Nilpotent)
+	}
+	small, _ := FromSlice([][]field.Complex{{{Re: 1e-7}, {Re: 0}}, {{Re: 0}, {Im: 1e-7}}})
+	assert.Equal(t, 0, small.NilpotencyIndex(0))
+
+	// Нильпотентные матрицы распознаются при любом масштабе
+	for _, c := range []float64{1e-8, 1, 1e8} {
+		shift := NewMatrix[field.Float64](4, 4, 0)
+		for i := 0; i+1 < 4; i++ {
+			shift.Data[i][i+1] = field.Float64(c * float64(i+1))
+		}
+		assert.Equal(t, 4, shift.NilpotencyIndex(0), "c = %g", c)
+	}
+	assert.Equal(t, 1, NewMatrix[field.Float64](3, 3, 0).NilpotencyIndex(0))
+}
@@ -0,0 +1,57 @@
+package matrix
+
+import "MatrixGo/internal/field"
+
+// FullRankFactorization раскладывает матрицу ранга r в произведение A = C*F, где
+// C (Rows×r) — ведущие столбцы A, а F (r×Cols) — ненулевые строки ее RREF
+func FullRankFactorization[T field.Field[T]](m *Matrix[T]) (C, F *Matrix[T], rank int) {
+	res := m.RREF(false)
+	rank = res.Rank
+	zero := m.Data[0][0].Zero()
+
+	C = NewMatrix[T](m.Rows, rank, zero)
+	for j, p := range res.Pivots {
+		for i := 0; i < m.Rows; i++ {
+			C.Data[i][j] = m.Data[i][p]
+		}
+	}
+	F = NewMatrix[T](rank, m.Cols, zero)
+	for i := 0; i < rank; i++ {
+		copy(F.Data[i], res.Reduced.Data[i])
+	}
+	return C, F, rank
+}
+
+// PseudoInverseExact вычисляет точную псевдообратную Мура–Пенроуза над рациональными числами
+// через скелетное разложение A = C*F: A⁺ = F^T*(F*F^T)^(-1)*(C^T*C)^(-1)*C^T.
+// Матрицы Грама C^T*C и F*F^T невырождены, поскольку C и F имеют полный ранг.
+func PseudoInverseExact(m *Matrix[field.Rational]) (*Matrix[field.Rational], error) {
+	C, F, rank := FullRankFactorization(m)
+	if rank == 0 {
+		return NewMatrix[field.Rational](m.Cols, m.Rows, m.Data[0][0].Zero()), nil
+	}
+
+	Ct, Ft := C.Transpose(), F.Transpose()
+	ctc, _ := Ct.Mul(C)
+	fft, _ := F.Mul(Ft)
+
+	ctcLU, err := ctc.LU(false)
+	if err != nil {
+		return nil, err
+	}
+	fftLU, err := fft.LU(false)
+	if err != nil {
+		return nil, err
+	}
+
+	// (C^T*C)^(-1)*C^T и F^T*(F*F^T)^(-1) получаем решением систем, не обращая матрицы явно
+	left, err := ctcLU.SolveMany(Ct)
+	if err != nil {
+		return nil, err
+	}
+	rightT, err := fftLU.SolveMany(F)
+	if err != nil {
+		return nil, err
+	}
+	return rightT.Transpose().Mul(left)
+}
@@ -0,0 +1,160 @@
+package matrix
+
+import (
+	"MatrixGo/internal/field"
+	"math"
+)
+
+// qrRankTolerance — относительный порог, ниже которого диагональный элемент R считается нулевым
+const qrRankTolerance = 1e-10
+
+// QRDecomposition хранит разложение A*P = Q*R
+type QRDecomposition[T field.Scalar[T]] struct {
+	Q    *Matrix[T] // унитарная (ортогональная) матрица Rows×Rows
+	R    *Matrix[T] // верхняя треугольная матрица Rows×Cols
+	Perm []int      // столбец j матрицы A*P — это столбец Perm[j] матрицы A
+	Rank int        // численный ранг, определенный по диагонали R
+}
+
+// HouseholderQR вычисляет QR-разложение отражениями Хаусхолдера.
+// При pivoting = true на каждом шаге выбирается столбец с наибольшей нормой,
+// и диагональ R убывает по модулю, что позволяет надежно определить ранг.
+func HouseholderQR[T field.Scalar[T]](m *Matrix[T], pivoting bool) *QRDecomposition[T] {
+	rows, cols := m.Rows, m.Cols
+	zero := m.Data[0][0].Zero()
+	R := m.Clone()
+	Q := IdentityMatrix[T](rows, zero, zero.One())
+
+	perm := make([]int, cols)
+	for j := range perm {
+		perm[j] = j
+	}
+
+	steps := rows
+	if cols < steps {
+		steps = cols
+	}
+
+	for k := 0; k < steps; k++ {
+		if pivoting {
+			best, bestNorm := k, -1.0
+			for j := k; j < cols; j++ {
+				norm := columnNorm2(R, j, k)
+				if norm > bestNorm {
+					best, bestNorm = j, norm
+				}
+			}
+			if best != k {
+				for i := 0; i < rows; i++ {
+					R.Data[i][k], R.Data[i][best] = R.Data[i][best], R.Data[i][k]
+				}
+				perm[k], perm[best] = perm[best], perm[k]
+			}
+		}
+
+		v, alpha, vNorm2, ok := householderVector(R, k, k)
+		if !ok {
+			continue
+		}
+
+		applyHouseholderLeft(R, v, k, k, vNorm2)
+		applyHouseholderRight(Q, v, k, vNorm2)
+
+		R.Data[k][k] = alpha
+		for i := k + 1; i < rows; i++ {
+			R.Data[i][k] = zero
+		}
+	}
+
+	return &QRDecomposition[T]{
+		Q:    Q,
+		R:    R,
+		Perm: perm,
+		Rank: numericalRankFromDiagonal(R, steps),
+	}
+}
+
+// householderVector строит вектор v отражения, переводящего часть столбца col
+// начиная со строки row0 в alpha*e1. Возвращает ok = false, если эта часть столбца нулевая.
+func householderVector[T field.Scalar[T]](A *Matrix[T], row0, col int) (v []T, alpha T, vNorm2 float64, ok bool) {
+	normX := math.Sqrt(columnNorm2(A, col, row0))
+	if normX == 0 {
+		return nil, alpha, 0, false
+	}
+
+	// alpha = -e^{i·arg(x0)}·||x||, чтобы избежать вычитания близких чисел
+	x0 := A.Data[row0][col]
+	phase := x0.One()
+	if x0.Abs() > 0 {
+		phase = x0.Scale(1 / x0.Abs())
+	}
+	alpha = phase.Scale(-normX)
+
+	v = make([]T, A.Rows-row0)
+	v[0] = x0.Sub(alpha)
+	for i := row0 + 1; i < A.Rows; i++ {
+		v[i-row0] = A.Data[i][col]
+	}
+	for _, vi := range v {
+		vNorm2 += vi.Abs() * vi.Abs()
+	}
+	return v, alpha, vNorm2, vNorm2 > 0
+}
+
+// columnNorm2 вычисляет квадрат нормы столбца j начиная со строки from
+func columnNorm2[T field.Scalar[T]](m *Matrix[T], j, from int) float64 {
+	sum := 0.0
+	for i := from; i < m.Rows; i++ {
+		a := m.Data[i][j].Abs()
+		sum += a * a
+	}
+	return sum
+}
+
+// applyHouseholderLeft применяет отражение H = I - 2vv*/|v|² к строкам [row0, Rows)
+// и столбцам [col0, Cols) матрицы: A = H*A
+func applyHouseholderLeft[T field.Scalar[T]](A *Matrix[T], v []T, row0, col0 int, vNorm2 float64) {
+	for j := col0; j < A.Cols; j++ {
+		s := v[0].Zero()
+		for i := range v {
+			s = s.Add(v[i].Conj().Mul(A.Data[row0+i][j]))
+		}
+		s = s.Scale(2 / vNorm2)
+		for i := range v {
+			A.Data[row0+i][j] = A.Data[row0+i][j].Sub(s.Mul(v[i]))
+		}
+	}
+}
+
+// applyHouseholderRight применяет отражение справа к столбцам [col0, col0+len(v)): A = A*H
+func applyHouseholderRight[T field.Scalar[T]](A *Matrix[T], v []T, col0 int, vNorm2 float64) {
+	for r := 0; r < A.Rows; r++ {
+		s := v[0].Zero()
+		for i := range v {
+			s = s.Add(A.Data[r][col0+i].Mul(v[i]))
+		}
+		s = s.Scale(2 / vNorm2)
+		for i := range v {
+			A.Data[r][col0+i] = A.Data[r][col0+i].Sub(s.Mul(v[i].Conj()))
+		}
+	}
+}
+
+// numericalRankFromDiagonal считает диагональные элементы R, превышающие относительный порог
+func numericalRankFromDiagonal[T field.Scalar[T]](R *Matrix[T], steps int) int {
+	maxDiag := 0.0
+	for k := 0; k < steps; k++ {
+		if a := R.Data[k][k].Abs(); a > maxDiag {
+			maxDiag = a
+		}
+	}
+
+	tol := qrRankTolerance * maxDiag * float64(max(R.Rows, R.Cols))
+	rank := 0
+	for k := 0; k < steps; k++ {
+		if R.Data[k][k].Abs() > tol {
+			rank++
+		}
+	}
+	return rank
+}
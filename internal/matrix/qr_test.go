@@ -0,0 +1,140 @@
+package matrix
+
+import (
+	"MatrixGo/internal/field"
+	"MatrixGo/internal/vector"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHouseholderQR(t *testing.T) {
+	mat, _ := FromSlice([][]field.Float64{
+		{12, -51, 4},
+		{6, 167, -68},
+		{-4, 24, -41},
+		{1, 1, 1},
+	})
+
+	for _, pivoting := range []bool{false, true} {
+		qr := HouseholderQR(mat, pivoting)
+		assert.Equal(t, 3, qr.Rank)
+
+		// Q ортогональна
+		qtq, _ := qr.Q.Transpose().Mul(qr.Q)
+		assertMatrixEqual(t, Eye(4, field.Float64(0), field.Float64(1)), qtq)
+
+		// R верхняя треугольная
+		for i := 0; i < qr.R.Rows; i++ {
+			for j := 0; j < i && j < qr.R.Cols; j++ {
+				assert.Equal(t, field.Float64(0), qr.R.Data[i][j])
+			}
+		}
+
+		// Q*R = A*P
+		prod, _ := qr.Q.Mul(qr.R)
+		for i := 0; i < mat.Rows; i++ {
+			for j := 0; j < mat.Cols; j++ {
+				assert.InDelta(t, float64(mat.Data[i][qr.Perm[j]]), float64(prod.Data[i][j]), 1e-9)
+			}
+		}
+	}
+}
+
+func TestHouseholderQRComplex(t *testing.T) {
+	mat, _ := FromSlice([][]field.Complex{
+		{{Re: 1, Im: 1}, {Re: 2, Im: 0}},
+		{{Re: 0, Im: -1}, {Re: 1, Im: 3}},
+		{{Re: 2, Im: 0}, {Re: 0, Im: 1}},
+	})
+
+	qr := HouseholderQR(mat, true)
+	assert.Equal(t, 2, qr.Rank)
+
+	prod, _ := qr.Q.Mul(qr.R)
+	for i := 0; i < mat.Rows; i++ {
+		for j := 0; j < mat.Cols; j++ {
+			assert.True(t, mat.Data[i][qr.Perm[j]].Equal(prod.Data[i][j]))
+		}
+	}
+}
+
+func TestGramSchmidtQR(t *testing.T) {
+	mat := ratMatrix(t, [][]int64{
+		{1, 1, 2},
+		{1, 0, 1},
+		{0, 1, 1},
+	})
+
+	qr := GramSchmidtQR(mat)
+	assert.Equal(t, 2, qr.Rank)
+	assert.True(t, qr.D[2].Equal(field.NewRational(0, 1)))
+
+	prod, _ := qr.Q.Mul(qr.R)
+	assertMatrixEqual(t, mat, prod)
+
+	qtq, _ := qr.Q.Transpose().Mul(qr.Q)
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			expected := field.NewRational(0, 1)
+			if i == j {
+				expected = qr.D[i]
+			}
+			assert.True(t, expected.Equal(qtq.Data[i][j]))
+		}
+	}
+}
+
+func TestLeastSquares(t *testing.T) {
+	t.Run("float64 line fit", func(t *testing.T) {
+		// Прямая y = 1 + 2x по точкам с шумом, симметричным относительно прямой
+		A, _ := FromSlice([][]field.Float64{{1, 0}, {1, 1}, {1, 2}, {1, 3}})
+		b := vector.NewVector([]field.Float64{1.1, 2.9, 5.1, 6.9})
+
+		res, err := LeastSquares(A, b)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, res.Rank)
+		assert.InDelta(t, 1.06, float64(res.Solution.Data[0]), 1e-9)
+		assert.InDelta(t, 1.96, float64(res.Solution.Data[1]), 1e-9)
+
+		// Невязка ортогональна столбцам A
+		for j := 0; j < A.Cols; j++ {
+			dot := 0.0
+			for i := 0; i < A.Rows; i++ {
+				dot += float64(A.Data[i][j] * res.Residual.Data[i])
+			}
+			assert.InDelta(t, 0, dot, 1e-9)
+		}
+	})
+
+	t.Run("rational exact with dependent column", func(t *testing.T) {
+		A := ratMatrix(t, [][]int64{{1, 0, 1}, {1, 1, 2}, {1, 2, 3}})
+		b := ratVector(0, 1, 1)
+
+		res, err := LeastSquares(A, b)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, res.Rank)
+		assert.True(t, field.NewRational(1, 6).Equal(res.Solution.Data[0]))
+		assert.True(t, field.NewRational(1, 2).Equal(res.Solution.Data[1]))
+		assert.True(t, field.NewRational(0, 1).Equal(res.Solution.Data[2]))
+
+		for j := 0; j < A.Cols; j++ {
+			assert.True(t, dotColumns(A, j, vectorAsColumn(res.Residual), 0).Equal(field.NewRational(0, 1)))
+		}
+	})
+
+	t.Run("unsupported field", func(t *testing.T) {
+		one, _ := field.NewGF(1, 5)
+		A := NewMatrix[field.GF](2, 2, one)
+		_, err := LeastSquares(A, vector.NewVector([]field.GF{one, one}))
+		assert.Error(t, err)
+	})
+}
+
+func vectorAsColumn[T field.Field[T]](v *vector.Vector[T]) *Matrix[T] {
+	m := NewMatrix[T](v.Len(), 1, v.Data[0].Zero())
+	for i := 0; i < v.Len(); i++ {
+		m.Data[i][0] = v.Data[i]
+	}
+	return m
+}
@@ -0,0 +1,110 @@
+package matrix
+
+import "MatrixGo/internal/field"
+
+// RREFResult содержит приведенный ступенчатый вид матрицы и сведения о ведущих элементах
+type RREFResult[T field.Field[T]] struct {
+	Reduced   *Matrix[T] // приведенный ступенчатый вид
+	Pivots    []int      // номера столбцов с ведущими элементами
+	Rank      int        // ранг матрицы
+	Perm      []int      // Perm[i] — исходный номер строки, оказавшейся на месте i
+	Transform *Matrix[T] // матрица E, такая что E*A = Reduced (nil, если не запрошена)
+}
+
+// newRREFState подготавливает копию матрицы, перестановку и (при необходимости) матрицу преобразования
+func newRREFState[T field.Field[T]](m *Matrix[T], withTransform bool) (*Matrix[T], []int, *Matrix[T]) {
+	mat := m.Clone()
+	perm := make([]int, m.Rows)
+	for i := range perm {
+		perm[i] = i
+	}
+
+	var E *Matrix[T]
+	if withTransform {
+		E = IdentityMatrix[T](m.Rows, m.Data[0][0].Zero(), m.Data[0][0].One())
+	}
+	return mat, perm, E
+}
+
+// findPivotRow ищет строку с ненулевым элементом в столбце k, начиная со строки h
+func findPivotRow[T field.Field[T]](mat *Matrix[T], h, k int) int {
+	zero := mat.Data[0][0].Zero()
+	for i := h; i < mat.Rows; i++ {
+		if !mat.Data[i][k].Equal(zero) {
+			return i
+		}
+	}
+	return -1
+}
+
+// normalizePivotRow меняет строки местами и делит ведущую строку на ведущий элемент
+func normalizePivotRow[T field.Field[T]](mat *Matrix[T], perm []int, E *Matrix[T], h, k, pivotRow int) {
+	if pivotRow != h {
+		mat.Data[h], mat.Data[pivotRow] = mat.Data[pivotRow], mat.Data[h]
+		perm[h], perm[pivotRow] = perm[pivotRow], perm[h]
+		if E != nil {
+			E.Data[h], E.Data[pivotRow] = E.Data[pivotRow], E.Data[h]
+		}
+	}
+
+	pivot := mat.Data[h][k]
+	for j := k; j < mat.Cols; j++ {
+		mat.Data[h][j], _ = mat.Data[h][j].Div(pivot)
+	}
+	if E != nil {
+		for j := 0; j < E.Cols; j++ {
+			E.Data[h][j], _ = E.Data[h][j].Div(pivot)
+		}
+	}
+}
+
+// eliminateRow обнуляет элемент строки i в столбце k с помощью ведущей строки h
+func eliminateRow[T field.Field[T]](mat *Matrix[T], E *Matrix[T], i, h, k int) {
+	factor := mat.Data[i][k]
+	if factor.Equal(factor.Zero()) {
+		return
+	}
+	for j := k; j < mat.Cols; j++ {
+		mat.Data[i][j] = mat.Data[i][j].Sub(factor.Mul(mat.Data[h][j]))
+	}
+	if E != nil {
+		for j := 0; j < E.Cols; j++ {
+			E.Data[i][j] = E.Data[i][j].Sub(factor.Mul(E.Data[h][j]))
+		}
+	}
+}
+
+// RREF приводит матрицу к приведенному ступенчатому виду методом Гаусса-Жордана.
+// Если withTransform = true, дополнительно накапливается матрица E, такая что E*A = RREF.
+func (m *Matrix[T]) RREF(withTransform bool) *RREFResult[T] {
+	mat, perm, E := newRREFState(m, withTransform)
+	pivots := make([]int, 0)
+	h := 0
+
+	for k := 0; k < mat.Cols && h < mat.Rows; k++ {
+		pivotRow := findPivotRow(mat, h, k)
+		if pivotRow < 0 {
+			continue
+		}
+
+		normalizePivotRow(mat, perm, E, h, k, pivotRow)
+
+		// Обнуляем элементы столбца k во всех остальных строках
+		for i := 0; i < mat.Rows; i++ {
+			if i != h {
+				eliminateRow(mat, E, i, h, k)
+			}
+		}
+
+		pivots = append(pivots, k)
+		h++
+	}
+
+	return &RREFResult[T]{
+		Reduced:   mat,
+		Pivots:    pivots,
+		Rank:      len(pivots),
+		Perm:      perm,
+		Transform: E,
+	}
+}
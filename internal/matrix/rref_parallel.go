@@ -0,0 +1,60 @@
+package matrix
+
+import (
+	"runtime"
+	"sync"
+)
+
+// RREFParallel приводит матрицу к приведенному ступенчатому виду,
+// распределяя исключение по строкам между горутинами
+func (m *Matrix[T]) RREFParallel(withTransform bool) *RREFResult[T] {
+	mat, perm, E := newRREFState(m, withTransform)
+	pivots := make([]int, 0)
+	rowCount := mat.Rows
+	h := 0
+
+	for k := 0; k < mat.Cols && h < rowCount; k++ {
+		pivotRow := findPivotRow(mat, h, k)
+		if pivotRow < 0 {
+			continue
+		}
+
+		normalizePivotRow(mat, perm, E, h, k, pivotRow)
+
+		var wg sync.WaitGroup
+		numCPU := runtime.NumCPU()
+		rowsPerGoroutine := rowCount / numCPU
+		if rowsPerGoroutine < 1 {
+			rowsPerGoroutine = 1
+		}
+
+		for startRow := 0; startRow < rowCount; startRow += rowsPerGoroutine {
+			endRow := startRow + rowsPerGoroutine
+			if endRow > rowCount {
+				endRow = rowCount
+			}
+
+			wg.Add(1)
+			go func(start, end, h int) {
+				defer wg.Done()
+				for i := start; i < end; i++ {
+					if i != h {
+						eliminateRow(mat, E, i, h, k)
+					}
+				}
+			}(startRow, endRow, h)
+		}
+		wg.Wait()
+
+		pivots = append(pivots, k)
+		h++
+	}
+
+	return &RREFResult[T]{
+		Reduced:   mat,
+		Pivots:    pivots,
+		Rank:      len(pivots),
+		Perm:      perm,
+		Transform: E,
+	}
+}
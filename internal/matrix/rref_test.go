@@ -0,0 +1,89 @@
+package matrix
+
+import (
+	"MatrixGo/internal/field"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func ratMatrix(t *testing.T, data [][]int64) *Matrix[field.Rational] {
+	t.Helper()
+	rows := make([][]field.Rational, len(data))
+	for i := range data {
+		rows[i] = make([]field.Rational, len(data[i]))
+		for j := range data[i] {
+			rows[i][j] = field.NewRational(data[i][j], 1)
+		}
+	}
+	mat, err := FromSlice(rows)
+	assert.NoError(t, err)
+	return mat
+}
+
+func assertMatrixEqual[T field.Field[T]](t *testing.T, expected, actual *Matrix[T]) {
+	t.Helper()
+	assert.Equal(t, expected.Rows, actual.Rows)
+	assert.Equal(t, expected.Cols, actual.Cols)
+	for i := 0; i < expected.Rows; i++ {
+		for j := 0; j < expected.Cols; j++ {
+			assert.True(t, expected.Data[i][j].Equal(actual.Data[i][j]),
+				"элемент [%d][%d]: ожидалось %v, получено %v", i, j, expected.Data[i][j], actual.Data[i][j])
+		}
+	}
+}
+
+func TestRREF(t *testing.T) {
+	tests := []struct {
+		name     string
+		matrix   [][]int64
+		expected [][]int64
+		pivots   []int
+	}{
+		{
+			name:     "full rank 2x2",
+			matrix:   [][]int64{{0, 2}, {3, 1}},
+			expected: [][]int64{{1, 0}, {0, 1}},
+			pivots:   []int{0, 1},
+		},
+		{
+			name:     "rank deficient 3x4",
+			matrix:   [][]int64{{1, 2, 1, 1}, {2, 4, 0, 6}, {1, 2, 0, 3}},
+			expected: [][]int64{{1, 2, 0, 3}, {0, 0, 1, -2}, {0, 0, 0, 0}},
+			pivots:   []int{0, 2},
+		},
+		{
+			name:     "zero matrix",
+			matrix:   [][]int64{{0, 0}, {0, 0}},
+			expected: [][]int64{{0, 0}, {0, 0}},
+			pivots:   []int{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mat := ratMatrix(t, tt.matrix)
+			expected := ratMatrix(t, tt.expected)
+
+			for _, res := range []*RREFResult[field.Rational]{mat.RREF(true), mat.RREFParallel(true)} {
+				assertMatrixEqual(t, expected, res.Reduced)
+				assert.Equal(t, tt.pivots, res.Pivots)
+				assert.Equal(t, len(tt.pivots), res.Rank)
+				assert.Equal(t, mat.Rank(), res.Rank)
+				assert.Len(t, res.Perm, mat.Rows)
+
+				// Проверяем, что E*A = RREF
+				product, err := res.Transform.Mul(mat)
+				assert.NoError(t, err)
+				assertMatrixEqual(t, res.Reduced, product)
+			}
+		})
+	}
+}
+
+func TestRREFWithoutTransform(t *testing.T) {
+	mat, _ := FromSlice([][]field.Float64{{2, 4}, {1, 3}})
+	res := mat.RREF(false)
+	assert.Nil(t, res.Transform)
+	assert.Equal(t, 2, res.Rank)
+}
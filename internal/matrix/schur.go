@@ -0,0 +1,37 @@
+package matrix
+
+import (
+	"MatrixGo/internal/field"
+	"errors"
+)
+
+// SchurDecomposition хранит комплексное разложение Шура A = Q*T*Q^H
+type SchurDecomposition struct {
+	T *Matrix[field.Complex] // верхняя треугольная матрица с собственными значениями на диагонали
+	Q *Matrix[field.Complex] // унитарная матрица
+}
+
+// Schur вычисляет комплексную форму Шура: приведение к форме Хессенберга и QR-итерации
+// со сдвигами Уилкинсона, вращения которых накапливаются в Q
+func Schur[T field.Scalar[T]](m *Matrix[T]) (*SchurDecomposition, error) {
+	if m.Rows != m.Cols {
+		return nil, errors.New("матрица должна быть квадратной")
+	}
+
+	hess, err := Hessenberg(toComplexMatrix(m))
+	if err != nil {
+		return nil, err
+	}
+	H, Q := hess.H, hess.Q
+	if _, err := hessenbergQR(H, Q); err != nil {
+		return nil, err
+	}
+
+	// Под диагональю остались только отброшенные при дефляции величины порядка машинной точности
+	for i := 1; i < H.Rows; i++ {
+		for j := 0; j < i; j++ {
+			H.Data[i][j] = field.Complex{}
+		}
+	}
+	return &SchurDecomposition{T: H, Q: Q}, nil
+}
@@ -86,3 +86,22 @@ func SolveSystemParallel[T field.Field[T]](mat *Matrix[T], vec *vector.Vector[T]
 
 	return vector.NewVector[T](x), nil
 }
+
+// SolveSystemSparseParallel решает систему с разреженной правой частью параллельно
+func SolveSystemSparseParallel[T field.Field[T]](mat *Matrix[T], vec *vector.SparseVector[T]) (*vector.Vector[T], error) {
+	if mat.Rows != vec.Len() {
+		return nil, errors.New("размер вектора не совпадает с размером матрицы")
+	}
+	return SolveSystemParallel(mat, vec.ToDense())
+}
+
+// SolveGeneralParallel решает произвольную систему A*x = b, приводя расширенную матрицу
+// к ступенчатому виду параллельно
+func SolveGeneralParallel[T field.Field[T]](mat *Matrix[T], vec *vector.Vector[T]) (*GeneralSolution[T], error) {
+	augmented, err := augment(mat, vec)
+	if err != nil {
+		return nil, err
+	}
+	rref := augmented.RREFParallel(false)
+	return generalSolutionFromRREF(rref, mat.Cols), nil
+}
@@ -0,0 +1,466 @@
+package matrix
+
+import (
+	"MatrixGo/internal/field"
+	"MatrixGo/internal/vector"
+	"fmt"
+	"sort"
+)
+
+// SparseFormat — способ сжатого хранения разреженной матрицы
+type SparseFormat int
+
+const (
+	SparseCSR SparseFormat = iota // сжатые строки: Ptr по строкам, Indices — номера столбцов
+	SparseCSC                     // сжатые столбцы: Ptr по столбцам, Indices — номера строк
+)
+
+// SparseMatrix хранит только ненулевые элементы матрицы в формате CSR или CSC.
+// Элементы строки (столбца) k лежат в Indices[Ptr[k]:Ptr[k+1]] и Values[Ptr[k]:Ptr[k+1]];
+// индексы внутри строки (столбца) возрастают, явные нули не хранятся.
+type SparseMatrix[T field.Field[T]] struct {
+	Rows    int
+	Cols    int
+	Format  SparseFormat
+	Ptr     []int
+	Indices []int
+	Values  []T
+	zero    T
+}
+
+// COOMatrix накапливает элементы разреженной матрицы в виде троек (строка, столбец, значение)
+// для последующего сжатия в CSR или CSC. Повторяющиеся позиции суммируются при сжатии.
+type COOMatrix[T field.Field[T]] struct {
+	Rows   int
+	Cols   int
+	RowIdx []int
+	ColIdx []int
+	Values []T
+	zero   T
+}
+
+// NewCOO создает пустой построитель разреженной матрицы
+func NewCOO[T field.Field[T]](rows, cols int, zero T) *COOMatrix[T] {
+	return &COOMatrix[T]{Rows: rows, Cols: cols, zero: zero.Zero()}
+}
+
+// Append добавляет элемент (i, j); нули пропускаются
+func (c *COOMatrix[T]) Append(i, j int, val T) error {
+	if err := checkIndex("COO.Append", axisRow, i, c.Rows); err != nil {
+		return err
+	}
+	if err := checkIndex("COO.Append", axisColumn, j, c.Cols); err != nil {
+		return err
+	}
+	if val.Equal(c.zero) {
+		return nil
+	}
+	c.RowIdx = append(c.RowIdx, i)
+	c.ColIdx = append(c.ColIdx, j)
+	c.Values = append(c.Values, val)
+	return nil
+}
+
+// compressTriplets сжимает тройки по ключу major (строки для CSR, столбцы для CSC).
+// Две устойчивые сортировки подсчетом упорядочивают элементы по (major, minor),
+// после чего соседние повторы суммируются, а получившиеся нули отбрасываются.
+func compressTriplets[T field.Field[T]](majorSize, minorSize int, major, minor []int, values []T, zero T) ([]int, []int, []T) {
+	nnz := len(values)
+	// Сортировка по minor
+	order := countingOrder(minorSize, minor, nil)
+	// Устойчивая сортировка по major сохраняет порядок minor внутри каждой группы
+	order = countingOrder(majorSize, major, order)
+
+	ptr := make([]int, majorSize+1)
+	indices := make([]int, 0, nnz)
+	vals := make([]T, 0, nnz)
+	for k := 0; k < len(order); {
+		p := order[k]
+		row, col, sum := major[p], minor[p], values[p]
+		k++
+		for k < len(order) && major[order[k]] == row && minor[order[k]] == col {
+			sum = sum.Add(values[order[k]])
+			k++
+		}
+		if !sum.Equal(zero) {
+			indices = append(indices, col)
+			vals = append(vals, sum)
+			ptr[row+1]++
+		}
+	}
+	for i := 0; i < majorSize; i++ {
+		ptr[i+1] += ptr[i]
+	}
+	return ptr, indices, vals
+}
+
+// countingOrder устойчиво упорядочивает позиции order (или 0..len(keys)-1, если order = nil)
+// по ключам keys из [0, size)
+func countingOrder(size int, keys []int, order []int) []int {
+	if order == nil {
+		order = make([]int, len(keys))
+		for i := range order {
+			order[i] = i
+		}
+	}
+	start := make([]int, size+1)
+	for _, p := range order {
+		start[keys[p]+1]++
+	}
+	for i := 0; i < size; i++ {
+		start[i+1] += start[i]
+	}
+	res := make([]int, len(order))
+	for _, p := range order {
+		res[start[keys[p]]] = p
+		start[keys[p]]++
+	}
+	return res
+}
+
+// ToCSR сжимает тройки в формат CSR
+func (c *COOMatrix[T]) ToCSR() *SparseMatrix[T] {
+	ptr, indices, values := compressTriplets(c.Rows, c.Cols, c.RowIdx, c.ColIdx, c.Values, c.zero)
+	return &SparseMatrix[T]{Rows: c.Rows, Cols: c.Cols, Format: SparseCSR, Ptr: ptr, Indices: indices, Values: values, zero: c.zero}
+}
+
+// ToCSC сжимает тройки в формат CSC
+func (c *COOMatrix[T]) ToCSC() *SparseMatrix[T] {
+	ptr, indices, values := compressTriplets(c.Cols, c.Rows, c.ColIdx, c.RowIdx, c.Values, c.zero)
+	return &SparseMatrix[T]{Rows: c.Rows, Cols: c.Cols, Format: SparseCSC, Ptr: ptr, Indices: indices, Values: values, zero: c.zero}
+}
+
+// NewSparseIdentity создает разреженную единичную матрицу в формате CSR
+func NewSparseIdentity[T field.Field[T]](n int, zero T) *SparseMatrix[T] {
+	res := &SparseMatrix[T]{Rows: n, Cols: n, Format: SparseCSR, Ptr: make([]int, n+1), Indices: make([]int, n), Values: make([]T, n), zero: zero.Zero()}
+	for i := 0; i < n; i++ {
+		res.Ptr[i+1] = i + 1
+		res.Indices[i] = i
+		res.Values[i] = zero.One()
+	}
+	return res
+}
+
+// SparseFromDense строит разреженную матрицу в формате CSR по плотной
+func SparseFromDense[T field.Field[T]](m *Matrix[T]) *SparseMatrix[T] {
+	zero := m.Data[0][0].Zero()
+	res := &SparseMatrix[T]{Rows: m.Rows, Cols: m.Cols, Format: SparseCSR, Ptr: make([]int, m.Rows+1), zero: zero}
+	for i := 0; i < m.Rows; i++ {
+		for j, x := range m.Data[i] {
+			if !x.Equal(zero) {
+				res.Indices = append(res.Indices, j)
+				res.Values = append(res.Values, x)
+			}
+		}
+		res.Ptr[i+1] = len(res.Indices)
+	}
+	return res
+}
+
+// ToDense преобразует разреженную матрицу в плотную
+func (s *SparseMatrix[T]) ToDense() *Matrix[T] {
+	res := NewMatrix[T](s.Rows, s.Cols, s.zero)
+	for k := 0; k+1 < len(s.Ptr); k++ {
+		for p := s.Ptr[k]; p < s.Ptr[k+1]; p++ {
+			if s.Format == SparseCSR {
+				res.Data[k][s.Indices[p]] = s.Values[p]
+			} else {
+				res.Data[s.Indices[p]][k] = s.Values[p]
+			}
+		}
+	}
+	return res
+}
+
+// Nnz возвращает количество хранимых ненулевых элементов
+func (s *SparseMatrix[T]) Nnz() int {
+	return len(s.Values)
+}
+
+// Dims возвращает размеры матрицы
+func (s *SparseMatrix[T]) Dims() (rows, cols int) {
+	return s.Rows, s.Cols
+}
+
+// Zero возвращает нулевой элемент поля, над которым построена матрица
+func (s *SparseMatrix[T]) Zero() T {
+	return s.zero
+}
+
+// At возвращает элемент (i, j) (ноль, если элемент не хранится)
+func (s *SparseMatrix[T]) At(i, j int) (T, error) {
+	if err := checkIndex("SparseMatrix.At", axisRow, i, s.Rows); err != nil {
+		return s.zero, err
+	}
+	if err := checkIndex("SparseMatrix.At", axisColumn, j, s.Cols); err != nil {
+		return s.zero, err
+	}
+	major, minor := i, j
+	if s.Format == SparseCSC {
+		major, minor = j, i
+	}
+	lo, hi := s.Ptr[major], s.Ptr[major+1]
+	p := lo + sort.SearchInts(s.Indices[lo:hi], minor)
+	if p < hi && s.Indices[p] == minor {
+		return s.Values[p], nil
+	}
+	return s.zero, nil
+}
+
+// Clone возвращает копию разреженной матрицы
+func (s *SparseMatrix[T]) Clone() *SparseMatrix[T] {
+	return &SparseMatrix[T]{
+		Rows:    s.Rows,
+		Cols:    s.Cols,
+		Format:  s.Format,
+		Ptr:     append([]int(nil), s.Ptr...),
+		Indices: append([]int(nil), s.Indices...),
+		Values:  append([]T(nil), s.Values...),
+		zero:    s.zero,
+	}
+}
+
+// Transpose возвращает транспонированную матрицу без копирования: CSR матрицы A
+// совпадает с CSC матрицы A^T, поэтому меняются только размеры и формат
+func (s *SparseMatrix[T]) Transpose() *SparseMatrix[T] {
+	res := *s
+	res.Rows, res.Cols = s.Cols, s.Rows
+	if s.Format == SparseCSR {
+		res.Format = SparseCSC
+	} else {
+		res.Format = SparseCSR
+	}
+	return &res
+}
+
+// convertFormat перестраивает сжатое хранение по другому измерению (транспонирование массивов)
+func (s *SparseMatrix[T]) convertFormat() *SparseMatrix[T] {
+	majorSize, minorSize := s.Rows, s.Cols
+	format := SparseCSC
+	if s.Format == SparseCSC {
+		majorSize, minorSize = s.Cols, s.Rows
+		format = SparseCSR
+	}
+
+	ptr := make([]int, minorSize+1)
+	for _, idx := range s.Indices {
+		ptr[idx+1]++
+	}
+	for i := 0; i < minorSize; i++ {
+		ptr[i+1] += ptr[i]
+	}
+	next := append([]int(nil), ptr[:minorSize]...)
+	indices := make([]int, len(s.Indices))
+	values := make([]T, len(s.Values))
+	// Обход по возрастанию major дает возрастающие индексы в каждой новой группе
+	for k := 0; k < majorSize; k++ {
+		for p := s.Ptr[k]; p < s.Ptr[k+1]; p++ {
+			q := next[s.Indices[p]]
+			indices[q] = k
+			values[q] = s.Values[p]
+			next[s.Indices[p]]++
+		}
+	}
+	return &SparseMatrix[T]{Rows: s.Rows, Cols: s.Cols, Format: format, Ptr: ptr, Indices: indices, Values: values, zero: s.zero}
+}
+
+// ToCSR возвращает матрицу в формате CSR (саму матрицу, если она уже в этом формате)
+func (s *SparseMatrix[T]) ToCSR() *SparseMatrix[T] {
+	if s.Format == SparseCSR {
+		return s
+	}
+	return s.convertFormat()
+}
+
+// ToCSC возвращает матрицу в формате CSC (саму матрицу, если она уже в этом формате)
+func (s *SparseMatrix[T]) ToCSC() *SparseMatrix[T] {
+	if s.Format == SparseCSC {
+		return s
+	}
+	return s.convertFormat()
+}
+
+// Add возвращает сумму разреженных матриц в формате CSR
+func (s *SparseMatrix[T]) Add(other *SparseMatrix[T]) (*SparseMatrix[T], error) {
+	if s.Rows != other.Rows || s.Cols != other.Cols {
+		return nil, &DimensionError{Op: "SparseMatrix.Add", Expected: sizeString(s.Rows, s.Cols), Actual: sizeString(other.Rows, other.Cols)}
+	}
+
+	a, b := s.ToCSR(), other.ToCSR()
+	res := &SparseMatrix[T]{Rows: s.Rows, Cols: s.Cols, Format: SparseCSR, Ptr: make([]int, s.Rows+1), zero: s.zero}
+	for i := 0; i < s.Rows; i++ {
+		// Слияние упорядоченных строк
+		p, q := a.Ptr[i], b.Ptr[i]
+		for p < a.Ptr[i+1] || q < b.Ptr[i+1] {
+			switch {
+			case q >= b.Ptr[i+1] || (p < a.Ptr[i+1] && a.Indices[p] < b.Indices[q]):
+				res.Indices = append(res.Indices, a.Indices[p])
+				res.Values = append(res.Values, a.Values[p])
+				p++
+			case p >= a.Ptr[i+1] || b.Indices[q] < a.Indices[p]:
+				res.Indices = append(res.Indices, b.Indices[q])
+				res.Values = append(res.Values, b.Values[q])
+				q++
+			default:
+				sum := a.Values[p].Add(b.Values[q])
+				if !sum.Equal(s.zero) {
+					res.Indices = append(res.Indices, a.Indices[p])
+					res.Values = append(res.Values, sum)
+				}
+				p++
+				q++
+			}
+		}
+		res.Ptr[i+1] = len(res.Indices)
+	}
+	return res, nil
+}
+
+// Mul перемножает разреженные матрицы алгоритмом Густавсона: строка i результата
+// накапливается в плотном буфере как сумма строк B с коэффициентами из строки i матрицы A
+func (s *SparseMatrix[T]) Mul(other *SparseMatrix[T]) (*SparseMatrix[T], error) {
+	if s.Cols != other.Rows {
+		return nil, &DimensionError{Op: "SparseMatrix.Mul", Expected: fmt.Sprintf("%d строк во втором множителе", s.Cols), Actual: fmt.Sprint(other.Rows)}
+	}
+
+	a, b := s.ToCSR(), other.ToCSR()
+	res := &SparseMatrix[T]{Rows: s.Rows, Cols: other.Cols, Format: SparseCSR, Ptr: make([]int, s.Rows+1), zero: s.zero}
+	acc := make([]T, other.Cols)
+	mark := make([]int, other.Cols)
+	for j := range mark {
+		mark[j] = -1
+	}
+	var cols []int
+	for i := 0; i < a.Rows; i++ {
+		cols = cols[:0]
+		for p := a.Ptr[i]; p < a.Ptr[i+1]; p++ {
+			k, aik := a.Indices[p], a.Values[p]
+			for q := b.Ptr[k]; q < b.Ptr[k+1]; q++ {
+				j := b.Indices[q]
+				if mark[j] != i {
+					mark[j] = i
+					acc[j] = aik.Mul(b.Values[q])
+					cols = append(cols, j)
+				} else {
+					acc[j] = acc[j].Add(aik.Mul(b.Values[q]))
+				}
+			}
+		}
+		sort.Ints(cols)
+		for _, j := range cols {
+			if !acc[j].Equal(s.zero) {
+				res.Indices = append(res.Indices, j)
+				res.Values = append(res.Values, acc[j])
+			}
+		}
+		res.Ptr[i+1] = len(res.Indices)
+	}
+	return res, nil
+}
+
+// MulDense вычисляет произведение разреженной и плотной матриц S*D
+func (s *SparseMatrix[T]) MulDense(d *Matrix[T]) (*Matrix[T], error) {
+	if s.Cols != d.Rows {
+		return nil, &DimensionError{Op: "SparseMatrix.MulDense", Expected: fmt.Sprintf("%d строк в плотной матрице", s.Cols), Actual: fmt.Sprint(d.Rows)}
+	}
+
+	a := s.ToCSR()
+	res := NewMatrix[T](s.Rows, d.Cols, s.zero)
+	for i := 0; i < a.Rows; i++ {
+		row := res.Data[i]
+		for p := a.Ptr[i]; p < a.Ptr[i+1]; p++ {
+			aik, dk := a.Values[p], d.Data[a.Indices[p]]
+			for j := range row {
+				row[j] = row[j].Add(aik.Mul(dk[j]))
+			}
+		}
+	}
+	return res, nil
+}
+
+// LeftMulDense вычисляет произведение плотной и разреженной матриц D*S
+func (s *SparseMatrix[T]) LeftMulDense(d *Matrix[T]) (*Matrix[T], error) {
+	if d.Cols != s.Rows {
+		return nil, &DimensionError{Op: "SparseMatrix.LeftMulDense", Expected: fmt.Sprintf("%d столбцов в плотной матрице", s.Rows), Actual: fmt.Sprint(d.Cols)}
+	}
+
+	b := s.ToCSR()
+	res := NewMatrix[T](d.Rows, s.Cols, s.zero)
+	for r := 0; r < d.Rows; r++ {
+		row := res.Data[r]
+		for k, dk := range d.Data[r] {
+			if dk.Equal(s.zero) {
+				continue
+			}
+			for p := b.Ptr[k]; p < b.Ptr[k+1]; p++ {
+				row[b.Indices[p]] = row[b.Indices[p]].Add(dk.Mul(b.Values[p]))
+			}
+		}
+	}
+	return res, nil
+}
+
+// MulVec вычисляет произведение S*v с плотным вектором (работает в обоих форматах)
+func (s *SparseMatrix[T]) MulVec(v *vector.Vector[T]) (*vector.Vector[T], error) {
+	if s.Cols != v.Len() {
+		return nil, &DimensionError{Op: "SparseMatrix.MulVec", Expected: fmt.Sprintf("вектор размера %d", s.Cols), Actual: fmt.Sprint(v.Len())}
+	}
+
+	res := make([]T, s.Rows)
+	for i := range res {
+		res[i] = s.zero
+	}
+	if s.Format == SparseCSR {
+		for i := 0; i < s.Rows; i++ {
+			sum := s.zero
+			for p := s.Ptr[i]; p < s.Ptr[i+1]; p++ {
+				sum = sum.Add(s.Values[p].Mul(v.Data[s.Indices[p]]))
+			}
+			res[i] = sum
+		}
+	} else {
+		for j := 0; j < s.Cols; j++ {
+			if v.Data[j].Equal(s.zero) {
+				continue
+			}
+			for p := s.Ptr[j]; p < s.Ptr[j+1]; p++ {
+				res[s.Indices[p]] = res[s.Indices[p]].Add(s.Values[p].Mul(v.Data[j]))
+			}
+		}
+	}
+	return vector.NewVector(res), nil
+}
+
+// MulSparseVector вычисляет произведение S*v с разреженным вектором. Складываются только
+// столбцы S, соответствующие ненулевым элементам v, поэтому для многократного использования
+// матрицу выгодно хранить в формате CSC (CSR преобразуется при каждом вызове).
+func (s *SparseMatrix[T]) MulSparseVector(v *vector.SparseVector[T]) (*vector.SparseVector[T], error) {
+	if s.Cols != v.Len() {
+		return nil, &DimensionError{Op: "SparseMatrix.MulSparseVector", Expected: fmt.Sprintf("вектор размера %d", s.Cols), Actual: fmt.Sprint(v.Len())}
+	}
+
+	a := s.ToCSC()
+	acc := make(map[int]T)
+	for k, j := range v.Indices {
+		vj := v.Values[k]
+		for p := a.Ptr[j]; p < a.Ptr[j+1]; p++ {
+			i := a.Indices[p]
+			if cur, ok := acc[i]; ok {
+				acc[i] = cur.Add(a.Values[p].Mul(vj))
+			} else {
+				acc[i] = a.Values[p].Mul(vj)
+			}
+		}
+	}
+
+	indices := make([]int, 0, len(acc))
+	for i := range acc {
+		indices = append(indices, i)
+	}
+	sort.Ints(indices)
+	values := make([]T, len(indices))
+	for k, i := range indices {
+		values[k] = acc[i]
+	}
+	return vector.NewSparseVectorFromPairs(s.Rows, indices, values, s.zero)
+}
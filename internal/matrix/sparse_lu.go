@@ -0,0 +1,356 @@
+package matrix
+
+import (
+	"MatrixGo/internal/field"
+	"MatrixGo/internal/vector"
+	"container/heap"
+	"errors"
+	"fmt"
+)
+
+// SparseOrdering — способ упорядочивания столбцов перед разреженным LU-разложением
+type SparseOrdering int
+
+const (
+	OrderingNatural       SparseOrdering = iota // исходный порядок
+	OrderingMinimumDegree                       // минимальная степень на графе A + A^T
+)
+
+// sparsePivotThreshold — доля максимального по модулю кандидата, при которой диагональный
+// элемент еще предпочитается как ведущий (пороговый выбор сохраняет разреженность)
+const sparsePivotThreshold = 0.1
+
+// SparseLU хранит разложение P*A*Q = L*U квадратной разреженной матрицы
+type SparseLU[T field.Field[T]] struct {
+	L       *SparseMatrix[T] // нижняя унитреугольная матрица в формате CSC
+	U       *SparseMatrix[T] // верхняя треугольная матрица в формате CSC
+	RowPerm []int            // строка k матрицы P*A — это строка RowPerm[k] матрицы A
+	ColPerm []int            // столбец k матрицы A*Q — это столбец ColPerm[k] матрицы A
+}
+
+// minimumDegreeOrdering строит порядок исключения минимальной степени для симметричного
+// графа смежности: на каждом шаге исключается вершина наименьшей степени, а ее соседи
+// соединяются в клику (заполнение). Порядок снижает заполнение множителей L и U.
+func minimumDegreeOrdering(adj []map[int]struct{}) []int {
+	n := len(adj)
+	h := &degreeHeap{}
+	for v := 0; v < n; v++ {
+		heap.Push(h, degreeEntry{degree: len(adj[v]), node: v})
+	}
+
+	eliminated := make([]bool, n)
+	order := make([]int, 0, n)
+	for h.Len() > 0 {
+		e := heap.Pop(h).(degreeEntry)
+		// Записи с устаревшей степенью пропускаются
+		if eliminated[e.node] || e.degree != len(adj[e.node]) {
+			continue
+		}
+		v := e.node
+		eliminated[v] = true
+		order = append(order, v)
+
+		nbrs := make([]int, 0, len(adj[v]))
+		for u := range adj[v] {
+			nbrs = append(nbrs, u)
+			delete(adj[u], v)
+		}
+		for a, u := range nbrs {
+			for _, w := range nbrs[a+1:] {
+				adj[u][w] = struct{}{}
+				adj[w][u] = struct{}{}
+			}
+		}
+		for _, u := range nbrs {
+			heap.Push(h, degreeEntry{degree: len(adj[u]), node: u})
+		}
+		adj[v] = nil
+	}
+	return order
+}
+
+type degreeEntry struct {
+	degree int
+	node   int
+}
+
+// degreeHeap — очередь вершин по возрастанию (степень, номер)
+type degreeHeap []degreeEntry
+
+func (h degreeHeap) Len() int { return len(h) }
+func (h degreeHeap) Less(i, j int) bool {
+	if h[i].degree != h[j].degree {
+		return h[i].degree < h[j].degree
+	}
+	return h[i].node < h[j].node
+}
+func (h degreeHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+func (h *degreeHeap) Push(x any)   { *h = append(*h, x.(degreeEntry)) }
+func (h *degreeHeap) Pop() any {
+	old := *h
+	x := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return x
+}
+
+// columnOrdering вычисляет перестановку столбцов для разложения
+func columnOrdering[T field.Field[T]](a *SparseMatrix[T], ordering SparseOrdering) ([]int, error) {
+	n := a.Cols
+	switch ordering {
+	case OrderingNatural:
+		order := make([]int, n)
+		for i := range order {
+			order[i] = i
+		}
+		return order, nil
+	case OrderingMinimumDegree:
+		// Граф смежности структуры A + A^T без диагонали
+		adj := make([]map[int]struct{}, n)
+		for i := range adj {
+			adj[i] = make(map[int]struct{})
+		}
+		for j := 0; j < n; j++ {
+			for p := a.Ptr[j]; p < a.Ptr[j+1]; p++ {
+				if i := a.Indices[p]; i != j {
+					adj[i][j] = struct{}{}
+					adj[j][i] = struct{}{}
+				}
+			}
+		}
+		return minimumDegreeOrdering(adj), nil
+	}
+	return nil, fmt.Errorf("неизвестное упорядочивание %d", ordering)
+}
+
+// pivotMagnitude возвращает модуль элемента для числовых полей; для точных полей ok = false
+func pivotMagnitude[T field.Field[T]](x T) (float64, bool) {
+	if s, ok := any(x).(interface{ Abs() float64 }); ok {
+		return s.Abs(), true
+	}
+	return 0, false
+}
+
+// LU вычисляет разреженное разложение P*A*Q = L*U левосторонним алгоритмом Гилберта–Пирлза.
+// Столбцы переставляются заранее выбранным упорядочиванием, строки — выбором ведущего
+// элемента по ходу разложения: для точных полей берется диагональный элемент, если он
+// ненулевой, для числовых (Float64, Complex) — пороговый выбор по модулю с предпочтением
+// диагонали. Для каждого столбца решается разреженная треугольная система L*x = A(:, q_k),
+// структура решения находится обходом в глубину по графу L, поэтому работа пропорциональна
+// числу операций с ненулевыми элементами.
+func (s *SparseMatrix[T]) LU(ordering SparseOrdering) (*SparseLU[T], error) {
+	if s.Rows != s.Cols {
+		return nil, &DimensionError{Op: "SparseMatrix.LU", Expected: "квадратная матрица", Actual: sizeString(s.Rows, s.Cols)}
+	}
+	n := s.Rows
+	a := s.ToCSC()
+	q, err := columnOrdering(a, ordering)
+	if err != nil {
+		return nil, err
+	}
+	zero, one := s.zero, s.zero.One()
+
+	// Столбцы L хранятся с номерами строк A; pinv[i] — шаг, на котором строка i стала ведущей
+	pinv := make([]int, n)
+	for i := range pinv {
+		pinv[i] = -1
+	}
+	lPtr, uPtr := []int{0}, []int{0}
+	var lIdx, uIdx []int
+	var lVal, uVal []T
+
+	x := make([]T, n)
+	for i := range x {
+		x[i] = zero
+	}
+	marked := make([]bool, n)
+	stack := make([]int, 0, n)
+	pstack := make([]int, n)
+	reach := make([]int, 0, n)
+
+	for k := 0; k < n; k++ {
+		col := q[k]
+
+		// Структура решения: вершины, достижимые из ненулевых строк A(:, col) по графу L,
+		// в обратном топологическом порядке обхода в глубину
+		reach = reach[:0]
+		for p := a.Ptr[col]; p < a.Ptr[col+1]; p++ {
+			start := a.Indices[p]
+			if marked[start] {
+				continue
+			}
+			stack = append(stack[:0], start)
+			marked[start] = true
+			if c := pinv[start]; c >= 0 {
+				pstack[start] = lPtr[c]
+			}
+			for len(stack) > 0 {
+				j := stack[len(stack)-1]
+				c := pinv[j]
+				done := true
+				if c >= 0 {
+					for ; pstack[j] < lPtr[c+1]; pstack[j]++ {
+						i := lIdx[pstack[j]]
+						if marked[i] {
+							continue
+						}
+						marked[i] = true
+						if ci := pinv[i]; ci >= 0 {
+							pstack[i] = lPtr[ci]
+						}
+						stack = append(stack, i)
+						pstack[j]++
+						done = false
+						break
+					}
+				}
+				if done {
+					stack = stack[:len(stack)-1]
+					reach = append(reach, j)
+				}
+			}
+		}
+
+		// Разреженная прямая подстановка в топологическом порядке (reach обходится с конца)
+		for p := a.Ptr[col]; p < a.Ptr[col+1]; p++ {
+			x[a.Indices[p]] = a.Values[p]
+		}
+		for t := len(reach) - 1; t >= 0; t-- {
+			j := reach[t]
+			c := pinv[j]
+			if c < 0 {
+				continue
+			}
+			xj := x[j]
+			// Первый элемент столбца L — единица на месте ведущей строки
+			for p := lPtr[c] + 1; p < lPtr[c+1]; p++ {
+				x[lIdx[p]] = x[lIdx[p]].Sub(lVal[p].Mul(xj))
+			}
+		}
+
+		// Выбор ведущей строки среди еще не использованных
+		pivotRow := -1
+		best := 0.0
+		numeric := false
+		for _, i := range reach {
+			if pinv[i] >= 0 {
+				continue
+			}
+			mag, ok := pivotMagnitude(x[i])
+			numeric = ok
+			if !ok {
+				if !x[i].Equal(zero) && (pivotRow < 0 || i == col) {
+					pivotRow = i
+				}
+				continue
+			}
+			if mag > best {
+				best, pivotRow = mag, i
+			}
+		}
+		if numeric && pivotRow >= 0 && pinv[col] < 0 && marked[col] {
+			if mag, _ := pivotMagnitude(x[col]); mag >= sparsePivotThreshold*best {
+				pivotRow = col
+			}
+		}
+		if pivotRow < 0 {
+			for _, i := range reach {
+				x[i] = zero
+				marked[i] = false
+			}
+			return nil, errors.New("матрица вырождена")
+		}
+		pivot := x[pivotRow]
+
+		// Столбец k: U получает элементы ведущих строк и диагональ, L — остальные, деленные
+		// на ведущий. Структурные элементы сохраняются, даже если численно близки к нулю:
+		// их отбрасывание накапливает погрешность в числовых полях.
+		lIdx = append(lIdx, pivotRow)
+		lVal = append(lVal, one)
+		for _, i := range reach {
+			if i == pivotRow {
+				continue
+			}
+			if c := pinv[i]; c >= 0 {
+				uIdx = append(uIdx, c)
+				uVal = append(uVal, x[i])
+			} else {
+				l, _ := x[i].Div(pivot)
+				lIdx = append(lIdx, i)
+				lVal = append(lVal, l)
+			}
+		}
+		uIdx = append(uIdx, k)
+		uVal = append(uVal, pivot)
+		lPtr = append(lPtr, len(lIdx))
+		uPtr = append(uPtr, len(uIdx))
+		pinv[pivotRow] = k
+
+		for _, i := range reach {
+			x[i] = zero
+			marked[i] = false
+		}
+	}
+
+	// Номера строк L переводятся в номера шагов; двойное перестроение хранения
+	// упорядочивает индексы внутри столбцов
+	rowPerm := make([]int, n)
+	for i, k := range pinv {
+		rowPerm[k] = i
+	}
+	for p, i := range lIdx {
+		lIdx[p] = pinv[i]
+	}
+	L := &SparseMatrix[T]{Rows: n, Cols: n, Format: SparseCSC, Ptr: lPtr, Indices: lIdx, Values: lVal, zero: zero}
+	U := &SparseMatrix[T]{Rows: n, Cols: n, Format: SparseCSC, Ptr: uPtr, Indices: uIdx, Values: uVal, zero: zero}
+	return &SparseLU[T]{
+		L:       L.convertFormat().convertFormat(),
+		U:       U.convertFormat().convertFormat(),
+		RowPerm: rowPerm,
+		ColPerm: q,
+	}, nil
+}
+
+// Nnz возвращает суммарное количество ненулевых элементов L и U (меру заполнения)
+func (f *SparseLU[T]) Nnz() int {
+	return f.L.Nnz() + f.U.Nnz()
+}
+
+// Solve решает систему A*x = b: L*U*y = P*b, x = Q*y
+func (f *SparseLU[T]) Solve(b *vector.Vector[T]) (*vector.Vector[T], error) {
+	n := f.L.Rows
+	if b.Len() != n {
+		return nil, &DimensionError{Op: "SparseLU.Solve", Expected: fmt.Sprintf("вектор размера %d", n), Actual: fmt.Sprint(b.Len())}
+	}
+
+	y := make([]T, n)
+	for k := range y {
+		y[k] = b.Data[f.RowPerm[k]]
+	}
+
+	// Прямой ход: диагональная единица L стоит первой в столбце
+	L := f.L
+	for j := 0; j < n; j++ {
+		for p := L.Ptr[j] + 1; p < L.Ptr[j+1]; p++ {
+			y[L.Indices[p]] = y[L.Indices[p]].Sub(L.Values[p].Mul(y[j]))
+		}
+	}
+
+	// Обратный ход: диагональ U стоит последней в столбце
+	U := f.U
+	for j := n - 1; j >= 0; j-- {
+		last := U.Ptr[j+1] - 1
+		var err error
+		if y[j], err = y[j].Div(U.Values[last]); err != nil {
+			return nil, err
+		}
+		for p := U.Ptr[j]; p < last; p++ {
+			y[U.Indices[p]] = y[U.Indices[p]].Sub(U.Values[p].Mul(y[j]))
+		}
+	}
+
+	x := make([]T, n)
+	for k, j := range f.ColPerm {
+		x[j] = y[k]
+	}
+	return vector.NewVector(x), nil
+}
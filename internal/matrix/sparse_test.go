@@ -0,0 +1,233 @@
+package matrix
+
+import (
+	"MatrixGo/internal/field"
+	"MatrixGo/internal/vector"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// randomSparseRational строит разреженную рациональную матрицу с заданной долей ненулевых элементов
+func randomSparseRational(rng *rand.Rand, rows, cols int, density float64) *SparseMatrix[field.Rational] {
+	coo := NewCOO(rows, cols, field.NewRational(0, 1))
+	for i := 0; i < rows; i++ {
+		for j := 0; j < cols; j++ {
+			if rng.Float64() < density {
+				coo.Append(i, j, field.NewRational(rng.Int63n(9)-4, 1))
+			}
+		}
+	}
+	return coo.ToCSR()
+}
+
+// gridLaplacian строит матрицу пятиточечного разностного оператора на сетке k×k
+func gridLaplacian(k int) *SparseMatrix[field.Float64] {
+	n := k * k
+	coo := NewCOO(n, n, field.Float64(0))
+	for r := 0; r < k; r++ {
+		for c := 0; c < k; c++ {
+			i := r*k + c
+			coo.Append(i, i, 4)
+			if r > 0 {
+				coo.Append(i, i-k, -1)
+			}
+			if r < k-1 {
+				coo.Append(i, i+k, -1)
+			}
+			if c > 0 {
+				coo.Append(i, i-1, -1)
+			}
+			if c < k-1 {
+				coo.Append(i, i+1, -1)
+			}
+		}
+	}
+	return coo.ToCSC()
+}
+
+func TestSparseConstruction(t *testing.T) {
+	zero := field.NewRational(0, 1)
+	coo := NewCOO(3, 4, zero)
+	assert.NoError(t, coo.Append(0, 1, field.NewRational(2, 1)))
+	assert.NoError(t, coo.Append(2, 3, field.NewRational(5, 1)))
+	// Повторные элементы суммируются, нулевые суммы отбрасываются
+	assert.NoError(t, coo.Append(0, 1, field.NewRational(3, 1)))
+	assert.NoError(t, coo.Append(1, 0, field.NewRational(1, 1)))
+	assert.NoError(t, coo.Append(1, 0, field.NewRational(-1, 1)))
+	assert.NoError(t, coo.Append(1, 2, zero))
+	var idxErr *IndexError
+	assert.ErrorAs(t, coo.Append(3, 0, zero), &idxErr)
+
+	expected := ratMatrix(t, [][]int64{
+		{0, 5, 0, 0},
+		{0, 0, 0, 0},
+		{0, 0, 0, 5},
+	})
+	csr, csc := coo.ToCSR(), coo.ToCSC()
+	assert.Equal(t, SparseCSR, csr.Format)
+	assert.Equal(t, SparseCSC, csc.Format)
+	assert.Equal(t, 2, csr.Nnz())
+	assert.Equal(t, 2, csc.Nnz())
+	assertMatrixEqual(t, expected, csr.ToDense())
+	assertMatrixEqual(t, expected, csc.ToDense())
+	assertMatrixEqual(t, expected, csr.ToCSC().ToDense())
+	assertMatrixEqual(t, expected, csc.ToCSR().ToDense())
+
+	v, err := csc.At(2, 3)
+	assert.NoError(t, err)
+	assert.True(t, v.Equal(field.NewRational(5, 1)))
+	v, err = csr.At(1, 1)
+	assert.NoError(t, err)
+	assert.True(t, v.Equal(zero))
+	_, err = csr.At(0, 4)
+	assert.ErrorAs(t, err, &idxErr)
+
+	assertMatrixEqual(t, expected.Transpose(), csr.Transpose().ToDense())
+	assertMatrixEqual(t, expected, SparseFromDense(expected).ToDense())
+	assertMatrixEqual(t, ratMatrix(t, [][]int64{{1, 0}, {0, 1}}), NewSparseIdentity(2, zero).ToDense())
+}
+
+func TestSparseArithmetic(t *testing.T) {
+	rng := rand.New(rand.NewSource(44))
+	a := randomSparseRational(rng, 6, 5, 0.3)
+	b := randomSparseRational(rng, 6, 5, 0.3)
+	c := randomSparseRational(rng, 5, 7, 0.3)
+	da, db, dc := a.ToDense(), b.ToDense(), c.ToDense()
+
+	sum, err := a.Add(b.ToCSC())
+	assert.NoError(t, err)
+	expSum, _ := da.Add(db)
+	assertMatrixEqual(t, expSum, sum.ToDense())
+
+	expProd, _ := da.Mul(dc)
+	for _, pair := range [][2]*SparseMatrix[field.Rational]{{a, c}, {a.ToCSC(), c}, {a, c.ToCSC()}} {
+		prod, err := pair[0].Mul(pair[1])
+		assert.NoError(t, err)
+		assertMatrixEqual(t, expProd, prod.ToDense())
+	}
+
+	sd, err := a.MulDense(dc)
+	assert.NoError(t, err)
+	assertMatrixEqual(t, expProd, sd)
+	d := ratMatrix(t, [][]int64{{1, 0, 2, -1, 0, 3}, {0, 4, 0, 0, 1, -2}})
+	ds, err := a.LeftMulDense(d)
+	assert.NoError(t, err)
+	expDS, _ := d.Mul(da)
+	assertMatrixEqual(t, expDS, ds)
+
+	x := ratVector(1, -2, 0, 3, 1)
+	for _, s := range []*SparseMatrix[field.Rational]{a, a.ToCSC()} {
+		y, err := s.MulVec(x)
+		assert.NoError(t, err)
+		for i := 0; i < da.Rows; i++ {
+			exp := field.NewRational(0, 1)
+			for j := 0; j < da.Cols; j++ {
+				exp = exp.Add(da.Data[i][j].Mul(x.Data[j]))
+			}
+			assert.True(t, exp.Equal(y.Data[i]))
+		}
+
+		sy, err := s.MulSparseVector(vector.FromDense(x))
+		assert.NoError(t, err)
+		assert.Equal(t, y.Data, sy.ToDense().Data)
+	}
+
+	_, err = a.Add(c)
+	assert.ErrorIs(t, err, ErrDimensionMismatch)
+	_, err = a.Mul(b)
+	assert.ErrorIs(t, err, ErrDimensionMismatch)
+	_, err = a.MulVec(ratVector(1, 2))
+	assert.ErrorIs(t, err, ErrDimensionMismatch)
+}
+
+func TestSparseLU(t *testing.T) {
+	t.Run("rational with pivoting", func(t *testing.T) {
+		// Нулевой диагональный элемент требует перестановки строк
+		dense := ratMatrix(t, [][]int64{
+			{0, 2, 0, 1},
+			{3, 0, 1, 0},
+			{0, 1, 4, 0},
+			{1, 0, 0, 2},
+		})
+		for _, ordering := range []SparseOrdering{OrderingNatural, OrderingMinimumDegree} {
+			lu, err := SparseFromDense(dense).LU(ordering)
+			assert.NoError(t, err)
+
+			// P*A*Q = L*U
+			pa := NewMatrix(4, 4, field.NewRational(0, 1))
+			for i := 0; i < 4; i++ {
+				for j := 0; j < 4; j++ {
+					pa.Data[i][j] = dense.Data[lu.RowPerm[i]][lu.ColPerm[j]]
+				}
+			}
+			prod, _ := lu.L.Mul(lu.U)
+			assertMatrixEqual(t, pa, prod.ToDense())
+
+			b := ratVector(4, 5, 9, 5)
+			x, err := lu.Solve(b)
+			assert.NoError(t, err)
+			assert.Equal(t, ratVector(1, 1, 2, 2).Data, x.Data)
+		}
+	})
+
+	t.Run("singular", func(t *testing.T) {
+		dense := ratMatrix(t, [][]int64{{1, 2}, {2, 4}})
+		_, err := SparseFromDense(dense).LU(OrderingNatural)
+		assert.Error(t, err)
+		_, err = SparseFromDense(ratMatrix(t, [][]int64{{1, 2}})).LU(OrderingNatural)
+		assert.ErrorIs(t, err, ErrDimensionMismatch)
+	})
+
+	t.Run("minimum degree reduces fill", func(t *testing.T) {
+		a := gridLaplacian(30)
+		natural, err := a.LU(OrderingNatural)
+		assert.NoError(t, err)
+		md, err := a.LU(OrderingMinimumDegree)
+		assert.NoError(t, err)
+		assert.Less(t, md.Nnz(), natural.Nnz())
+
+		b := make([]field.Float64, a.Rows)
+		for i := range b {
+			b[i] = field.Float64(i % 7)
+		}
+		for _, lu := range []*SparseLU[field.Float64]{natural, md} {
+			x, err := lu.Solve(vector.NewVector(b))
+			assert.NoError(t, err)
+			r, _ := a.MulVec(x)
+			for i := range b {
+				assert.InDelta(t, float64(b[i]), float64(r.Data[i]), 1e-9)
+			}
+		}
+	})
+
+	t.Run("large tridiagonal", func(t *testing.T) {
+		const n = 100000
+		coo := NewCOO(n, n, field.Float64(0))
+		for i := 0; i < n; i++ {
+			coo.Append(i, i, 2)
+			if i > 0 {
+				coo.Append(i, i-1, -1)
+				coo.Append(i-1, i, -1)
+			}
+		}
+		a := coo.ToCSC()
+		lu, err := a.LU(OrderingMinimumDegree)
+		assert.NoError(t, err)
+		// Для трехдиагональной матрицы заполнения нет
+		assert.LessOrEqual(t, lu.Nnz(), 2*a.Nnz()+n)
+
+		b := make([]field.Float64, n)
+		b[0], b[n-1] = 1, 1
+		x, err := lu.Solve(vector.NewVector(b))
+		assert.NoError(t, err)
+		// Решение — вектор из единиц
+		maxErr := 0.0
+		for _, xi := range x.Data {
+			maxErr = math.Max(maxErr, math.Abs(float64(xi)-1))
+		}
+		assert.Less(t, maxErr, 1e-6)
+	})
+}
@@ -0,0 +1,194 @@
+package matrix
+
+import (
+	"MatrixGo/internal/field"
+	"fmt"
+)
+
+// Элементы матрицы хранятся в одном непрерывном срезе elems по строкам: элемент (i, j)
+// находится в elems[i*stride+j]. Поле Data сохранено для совместимости: его строки —
+// срезы того же хранилища, поэтому запись через Data видна в представлениях и наоборот.
+// Код, который переставляет сами строки Data (а не их элементы) или собирает Data вручную,
+// нарушает это соответствие; в таком случае быстрые пути переходят на построчный доступ,
+// а представления строятся над упакованной копией строк и не разделяют память с матрицей.
+
+// newMatrixFromStorage создает матрицу поверх готового хранилища без копирования
+func newMatrixFromStorage[T field.Field[T]](rows, cols int, elems []T, stride int) *Matrix[T] {
+	m := &Matrix[T]{Rows: rows, Cols: cols, elems: elems, stride: stride}
+	m.Data = make([][]T, rows)
+	for i := range m.Data {
+		start := i * stride
+		// Емкость строки ограничена, чтобы append не затирал следующую строку
+		m.Data[i] = elems[start : start+cols : start+cols]
+	}
+	return m
+}
+
+// storage возвращает непрерывное хранилище и шаг строк, если строки Data по-прежнему
+// указывают на него в исходном порядке
+func (m *Matrix[T]) storage() ([]T, int, bool) {
+	if len(m.Data) != m.Rows {
+		return nil, 0, false
+	}
+	if m.Rows == 0 || m.Cols == 0 {
+		return m.elems, m.stride, true
+	}
+	if m.elems == nil || (m.Rows-1)*m.stride+m.Cols > len(m.elems) {
+		return nil, 0, false
+	}
+	for i, row := range m.Data {
+		if len(row) < m.Cols || &row[0] != &m.elems[i*m.stride] {
+			return nil, 0, false
+		}
+	}
+	return m.elems, m.stride, true
+}
+
+// packedStorage возвращает непрерывное хранилище матрицы и его шаг. Если строки Data
+// не указывают на хранилище, строки копируются в новый срез; матрица при этом не изменяется.
+func (m *Matrix[T]) packedStorage() ([]T, int) {
+	if elems, stride, ok := m.storage(); ok {
+		return elems, stride
+	}
+	elems := make([]T, m.Rows*m.Cols)
+	for i := 0; i < m.Rows; i++ {
+		copy(elems[i*m.Cols:(i+1)*m.Cols], m.Data[i])
+	}
+	return elems, m.Cols
+}
+
+// At возвращает элемент (i, j) без проверки границ
+func (m *Matrix[T]) At(i, j int) T {
+	return m.Data[i][j]
+}
+
+// View — представление прямоугольной части матрицы, разделяющее с ней память
+// (кроме представлений матриц с переставленными строками Data, см. Matrix.View).
+// Элемент (i, j) находится в elems[offset + i*rowStride + j*colStride].
+type View[T field.Field[T]] struct {
+	rows, cols int
+	elems      []T
+	offset     int
+	rowStride  int
+	colStride  int
+}
+
+// View возвращает представление всей матрицы. Матрица не изменяется; если строки Data
+// переставлены или собраны вручную, представление строится над копией строк и не разделяет
+// память с матрицей.
+func (m *Matrix[T]) View() *View[T] {
+	elems, stride := m.packedStorage()
+	return &View[T]{rows: m.Rows, cols: m.Cols, elems: elems, rowStride: stride, colStride: 1}
+}
+
+// SubmatrixView возвращает представление блока rows×cols с левым верхним углом (r0, c0)
+func (m *Matrix[T]) SubmatrixView(r0, c0, rows, cols int) (*View[T], error) {
+	return m.View().Submatrix(r0, c0, rows, cols)
+}
+
+// RowRangeView возвращает представление строк [from, to)
+func (m *Matrix[T]) RowRangeView(from, to int) (*View[T], error) {
+	return m.View().RowRange(from, to)
+}
+
+// TransposeView возвращает транспонированное представление без копирования элементов
+func (m *Matrix[T]) TransposeView() *View[T] {
+	return m.View().T()
+}
+
+// Rows возвращает количество строк представления
+func (v *View[T]) Rows() int {
+	return v.rows
+}
+
+// Cols возвращает количество столбцов представления
+func (v *View[T]) Cols() int {
+	return v.cols
+}
+
+// At возвращает элемент (i, j) без проверки границ
+func (v *View[T]) At(i, j int) T {
+	return v.elems[v.offset+i*v.rowStride+j*v.colStride]
+}
+
+// Set записывает элемент (i, j) в общую с матрицей память
+func (v *View[T]) Set(i, j int, x T) error {
+	if err := checkIndex("View.Set", axisRow, i, v.rows); err != nil {
+		return err
+	}
+	if err := checkIndex("View.Set", axisColumn, j, v.cols); err != nil {
+		return err
+	}
+	v.elems[v.offset+i*v.rowStride+j*v.colStride] = x
+	return nil
+}
+
+// Submatrix возвращает представление блока rows×cols с левым верхним углом (r0, c0)
+func (v *View[T]) Submatrix(r0, c0, rows, cols int) (*View[T], error) {
+	if rows <= 0 || cols <= 0 {
+		return nil, fmt.Errorf("Submatrix: %w", ErrEmptyMatrix)
+	}
+	if err := checkIndex("Submatrix", axisRow, r0, v.rows); err != nil {
+		return nil, err
+	}
+	if err := checkIndex("Submatrix", axisColumn, c0, v.cols); err != nil {
+		return nil, err
+	}
+	if r0+rows > v.rows || c0+cols > v.cols {
+		return nil, &DimensionError{
+			Op:       "Submatrix",
+			Expected: fmt.Sprintf("блок в пределах %s", sizeString(v.rows, v.cols)),
+			Actual:   fmt.Sprintf("%s с углом (%d, %d)", sizeString(rows, cols), r0, c0),
+		}
+	}
+	return &View[T]{
+		rows:      rows,
+		cols:      cols,
+		elems:     v.elems,
+		offset:    v.offset + r0*v.rowStride + c0*v.colStride,
+		rowStride: v.rowStride,
+		colStride: v.colStride,
+	}, nil
+}
+
+// RowRange возвращает представление строк [from, to)
+func (v *View[T]) RowRange(from, to int) (*View[T], error) {
+	return v.Submatrix(from, 0, to-from, v.cols)
+}
+
+// T возвращает транспонированное представление: шаги строк и столбцов меняются местами
+func (v *View[T]) T() *View[T] {
+	return &View[T]{
+		rows:      v.cols,
+		cols:      v.rows,
+		elems:     v.elems,
+		offset:    v.offset,
+		rowStride: v.colStride,
+		colStride: v.rowStride,
+	}
+}
+
+// Materialize копирует представление в новую матрицу с собственным хранилищем
+func (v *View[T]) Materialize() *Matrix[T] {
+	elems := make([]T, v.rows*v.cols)
+	for i := 0; i < v.rows; i++ {
+		for j := 0; j < v.cols; j++ {
+			elems[i*v.cols+j] = v.At(i, j)
+		}
+	}
+	return newMatrixFromStorage(v.rows, v.cols, elems, v.cols)
+}
+
+// AsMatrix возвращает матрицу, разделяющую память с представлением, чтобы передать его
+// в алгоритмы без копирования. Это возможно, только если строки представления лежат
+// в хранилище подряд (представление не транспонировано); иначе ok = false.
+func (v *View[T]) AsMatrix() (m *Matrix[T], ok bool) {
+	if v.colStride != 1 {
+		return nil, false
+	}
+	if v.rows == 0 || v.cols == 0 {
+		return newMatrixFromStorage[T](v.rows, v.cols, nil, 0), true
+	}
+	end := v.offset + (v.rows-1)*v.rowStride + v.cols
+	return newMatrixFromStorage(v.rows, v.cols, v.elems[v.offset:end], v.rowStride), true
+}
@@ -0,0 +1,279 @@
+package matrix
+
+import (
+	"MatrixGo/internal/field"
+	"math/rand"
+	"runtime"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContiguousStorage(t *testing.T) {
+	m := ratMatrix(t, [][]int64{{1, 2, 3}, {4, 5, 6}})
+	elems, stride, ok := m.storage()
+	assert.True(t, ok)
+	assert.Equal(t, 3, stride)
+	assert.Len(t, elems, 6)
+
+	// Запись через Data видна в хранилище, а append к строке не затирает следующую
+	m.Data[1][0] = field.NewRational(-4, 1)
+	assert.True(t, elems[3].Equal(field.NewRational(-4, 1)))
+	_ = append(m.Data[0], field.NewRational(100, 1))
+	assert.True(t, m.Data[1][0].Equal(field.NewRational(-4, 1)))
+
+	// Перестановка срезов Data нарушает соответствие; Clone и представления это учитывают
+	m.Data[0], m.Data[1] = m.Data[1], m.Data[0]
+	_, _, ok = m.storage()
+	assert.False(t, ok)
+	assertMatrixEqual(t, ratMatrix(t, [][]int64{{-4, 5, 6}, {1, 2, 3}}), m.Clone())
+	row := m.Data[0]
+	v := m.View()
+	assert.True(t, v.At(0, 0).Equal(field.NewRational(-4, 1)))
+	// Представление построено над копией: матрица и ее строки не изменились
+	_, _, ok = m.storage()
+	assert.False(t, ok)
+	assert.NoError(t, v.Set(0, 0, field.NewRational(7, 1)))
+	assert.True(t, m.Data[0][0].Equal(field.NewRational(-4, 1)))
+	row[1] = field.NewRational(-5, 1)
+	assert.True(t, m.Data[0][1].Equal(field.NewRational(-5, 1)))
+
+	// Одновременное создание представлений только читает матрицу
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.True(t, m.View().At(1, 2).Equal(field.NewRational(3, 1)))
+		}()
+	}
+	wg.Wait()
+
+	// Матрица, собранная вручную, тоже поддерживается
+	manual := &Matrix[field.Rational]{Rows: 1, Cols: 2, Data: [][]field.Rational{{field.NewRational(1, 1), field.NewRational(2, 1)}}}
+	sub, err := manual.SubmatrixView(0, 1, 1, 1)
+	assert.NoError(t, err)
+	assert.True(t, sub.At(0, 0).Equal(field.NewRational(2, 1)))
+}
+
+func TestViews(t *testing.T) {
+	m := ratMatrix(t, [][]int64{
+		{1, 2, 3, 4},
+		{5, 6, 7, 8},
+		{9, 10, 11, 12},
+	})
+
+	sub, err := m.SubmatrixView(1, 1, 2, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, sub.Rows())
+	assert.Equal(t, 3, sub.Cols())
+	assertMatrixEqual(t, ratMatrix(t, [][]int64{{6, 7, 8}, {10, 11, 12}}), sub.Materialize())
+
+	// Представление разделяет память с матрицей в обе стороны
+	assert.NoError(t, sub.Set(0, 0, field.NewRational(-6, 1)))
+	assert.True(t, m.Data[1][1].Equal(field.NewRational(-6, 1)))
+	m.Data[2][3] = field.NewRational(-12, 1)
+	assert.True(t, sub.At(1, 2).Equal(field.NewRational(-12, 1)))
+
+	tr := m.TransposeView()
+	assert.Equal(t, 4, tr.Rows())
+	assertMatrixEqual(t, m.Transpose(), tr.Materialize())
+	assert.NoError(t, tr.Set(3, 0, field.NewRational(40, 1)))
+	assert.True(t, m.Data[0][3].Equal(field.NewRational(40, 1)))
+	_, ok := tr.AsMatrix()
+	assert.False(t, ok)
+
+	// Подпредставление транспонированного представления
+	trSub, err := tr.Submatrix(1, 1, 2, 2)
+	assert.NoError(t, err)
+	assertMatrixEqual(t, ratMatrix(t, [][]int64{{-6, 10}, {7, 11}}), trSub.Materialize())
+
+	rows, err := m.RowRangeView(1, 3)
+	assert.NoError(t, err)
+	shared, ok := rows.AsMatrix()
+	assert.True(t, ok)
+	assert.NoError(t, shared.ScaleRow(0, field.NewRational(2, 1)))
+	assert.True(t, m.Data[1][0].Equal(field.NewRational(10, 1)))
+
+	// Представление со строками через промежутки работает с алгоритмами без копирования
+	block, _ := m.SubmatrixView(0, 0, 2, 2)
+	bm, ok := block.AsMatrix()
+	assert.True(t, ok)
+	assert.True(t, bm.Determinant().Equal(field.NewRational(1*(-12)-2*10, 1)))
+	assertMatrixEqual(t, ratMatrix(t, [][]int64{{1, 2}, {10, -12}}), bm.Clone())
+
+	_, err = m.SubmatrixView(2, 2, 2, 2)
+	assert.ErrorIs(t, err, ErrDimensionMismatch)
+	_, err = m.RowRangeView(3, 4)
+	assert.ErrorIs(t, err, ErrIndexOutOfRange)
+	_, err = m.RowRangeView(1, 1)
+	assert.ErrorIs(t, err, ErrEmptyMatrix)
+	assert.ErrorIs(t, sub.Set(2, 0, field.NewRational(0, 1)), ErrIndexOutOfRange)
+}
+
+const benchmarkStorageSize = 120
+
+// randomFloatMatrix создает случайную матрицу с элементами из [-1, 1)
+func randomFloatMatrix(rng *rand.Rand, n int) *Matrix[field.Float64] {
+	m := NewMatrix[field.Float64](n, n, 0)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			m.Data[i][j] = field.Float64(2*rng.Float64() - 1)
+		}
+	}
+	return m
+}
+
+// legacyCopy копирует матрицу в прежнем формате: каждая строка — отдельный срез
+func legacyCopy[T field.Field[T]](m *Matrix[T]) *Matrix[T] {
+	res := &Matrix[T]{Rows: m.Rows, Cols: m.Cols, Data: make([][]T, m.Rows)}
+	for i := range res.Data {
+		res.Data[i] = append([]T(nil), m.Data[i]...)
+	}
+	return res
+}
+
+// legacyMul воспроизводит прежнее умножение: построчное хранение и обход B по столбцам
+func legacyMul[T field.Field[T]](m1, m2 *Matrix[T]) *Matrix[T] {
+	res := legacyCopy(NewMatrix[T](m1.Rows, m2.Cols, m1.Data[0][0].Zero()))
+	for i := 0; i < m1.Rows; i++ {
+		for j := 0; j < m2.Cols; j++ {
+			elem := m1.Data[i][0].Mul(m2.Data[0][j])
+			for k := 1; k < m1.Cols; k++ {
+				elem = elem.Add(m1.Data[i][k].Mul(m2.Data[k][j]))
+			}
+			res.Data[i][j] = elem
+		}
+	}
+	return res
+}
+
+// legacyMultiplyParallel воспроизводит прежнее параллельное умножение с задачей на каждый элемент
+func legacyMultiplyParallel[T field.Field[T]](m1, m2 *Matrix[T]) *Matrix[T] {
+	res := legacyCopy(NewMatrix[T](m1.Rows, m2.Cols, m1.Data[0][0].Zero()))
+	type task struct{ row, col int }
+	tasks := make(chan task, m1.Rows*m2.Cols)
+	var wg sync.WaitGroup
+	for w := 0; w < runtime.NumCPU(); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for t := range tasks {
+				sum := m1.Data[0][0].Zero()
+				for k := 0; k < m1.Cols; k++ {
+					sum = sum.Add(m1.Data[t.row][k].Mul(m2.Data[k][t.col]))
+				}
+				res.Data[t.row][t.col] = sum
+			}
+		}()
+	}
+	for i := 0; i < m1.Rows; i++ {
+		for j := 0; j < m2.Cols; j++ {
+			tasks <- task{i, j}
+		}
+	}
+	close(tasks)
+	wg.Wait()
+	return res
+}
+
+// legacyDeterminantParallel воспроизводит прежний DeterminantParallel на построчном хранении
+func legacyDeterminantParallel[T field.Field[T]](m *Matrix[T]) T {
+	mat := legacyCopy(m)
+	n := mat.Rows
+	det := mat.Data[0][0].One()
+	for i := 0; i < n; i++ {
+		if mat.Data[i][i].Equal(mat.Data[i][i].Zero()) {
+			swapped := false
+			for j := i + 1; j < n; j++ {
+				if !mat.Data[j][i].Equal(mat.Data[j][i].Zero()) {
+					mat.Data[i], mat.Data[j] = mat.Data[j], mat.Data[i]
+					det = det.Neg()
+					swapped = true
+					break
+				}
+			}
+			if !swapped {
+				return mat.Data[0][0].Zero()
+			}
+		}
+		det = det.Mul(mat.Data[i][i])
+
+		var wg sync.WaitGroup
+		rowsPerGoroutine := max((n-i-1)/runtime.NumCPU(), 1)
+		for startRow := i + 1; startRow < n; startRow += rowsPerGoroutine {
+			wg.Add(1)
+			go func(start, end int) {
+				defer wg.Done()
+				for j := start; j < end; j++ {
+					if !mat.Data[j][i].Equal(mat.Data[j][i].Zero()) {
+						factor, _ := mat.Data[j][i].Div(mat.Data[i][i])
+						for k := i; k < n; k++ {
+							mat.Data[j][k] = mat.Data[j][k].Sub(factor.Mul(mat.Data[i][k]))
+						}
+					}
+				}
+			}(startRow, min(startRow+rowsPerGoroutine, n))
+		}
+		wg.Wait()
+	}
+	return det
+}
+
+func TestLegacyReference(t *testing.T) {
+	rng := rand.New(rand.NewSource(7))
+	a, b := randomFloatMatrix(rng, 9), randomFloatMatrix(rng, 9)
+	prod, _ := a.Mul(b)
+	par, _ := a.MultiplyParallel(b)
+	assertMatrixEqual(t, legacyMul(legacyCopy(a), legacyCopy(b)), prod)
+	assertMatrixEqual(t, legacyMultiplyParallel(a, b), par)
+	assert.InDelta(t, float64(legacyDeterminantParallel(a)), float64(a.DeterminantParallel()), 1e-9)
+}
+
+func BenchmarkStorageMul(b *testing.B) {
+	rng := rand.New(rand.NewSource(1))
+	x, y := randomFloatMatrix(rng, benchmarkStorageSize), randomFloatMatrix(rng, benchmarkStorageSize)
+	lx, ly := legacyCopy(x), legacyCopy(y)
+	b.Run("contiguous", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = x.Mul(y)
+		}
+	})
+	b.Run("legacy", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			legacyMul(lx, ly)
+		}
+	})
+}
+
+func BenchmarkStorageMultiplyParallel(b *testing.B) {
+	rng := rand.New(rand.NewSource(2))
+	x, y := randomFloatMatrix(rng, benchmarkStorageSize), randomFloatMatrix(rng, benchmarkStorageSize)
+	lx, ly := legacyCopy(x), legacyCopy(y)
+	b.Run("contiguous", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = x.MultiplyParallel(y)
+		}
+	})
+	b.Run("legacy", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			legacyMultiplyParallel(lx, ly)
+		}
+	})
+}
+
+func BenchmarkStorageDeterminantParallel(b *testing.B) {
+	rng := rand.New(rand.NewSource(3))
+	x := randomFloatMatrix(rng, benchmarkStorageSize)
+	b.Run("contiguous", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			x.DeterminantParallel()
+		}
+	})
+	b.Run("legacy", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			legacyDeterminantParallel(x)
+		}
+	})
+}
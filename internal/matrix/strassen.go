@@ -0,0 +1,132 @@
+package matrix
+
+import (
+	"MatrixGo/internal/field"
+	"errors"
+)
+
+// isExactField сообщает, выполняются ли операции поля без округления. Для Float64 и Complex
+// схема Штрассена дает более слабую оценку погрешности, поэтому автоматически не применяется.
+func isExactField[T field.Field[T]](x T) bool {
+	switch any(x).(type) {
+	case field.Rational, field.GF:
+		return true
+	}
+	return false
+}
+
+// MultiplyStrassen умножает матрицы по схеме Штрассена–Винограда: 7 умножений блоков
+// половинного размера и 15 сложений вместо 8 умножений, что дает O(n^2.81) операций.
+// Блоки размером меньше threshold (по любому измерению) умножаются обычным блочным
+// алгоритмом. Результат точен для rational и GF; для Float64 и Complex погрешность
+// может быть больше, чем у классического умножения.
+func (m1 *Matrix[T]) MultiplyStrassen(m2 *Matrix[T], threshold int) (*Matrix[T], error) {
+	if m1.Cols != m2.Rows {
+		return nil, errors.New("недопустимые размеры для умножения")
+	}
+	if threshold < 2 {
+		return nil, errors.New("порог рекурсии должен быть не меньше 2")
+	}
+	return strassenMul(m1, m2, threshold, mulTiledSerial[T]), nil
+}
+
+// mulTiledSerial — последовательное блочное умножение (основание рекурсии)
+func mulTiledSerial[T field.Field[T]](m1, m2 *Matrix[T]) *Matrix[T] {
+	res := NewMatrix[T](m1.Rows, m2.Cols, m1.Data[0][0].Zero())
+	tile := DefaultMulTileSize
+	if b, ok := any(m2).(*Matrix[field.Float64]); ok {
+		mulTileFloat64(any(res).(*Matrix[field.Float64]), any(m1).(*Matrix[field.Float64]), b.Transpose(), 0, m1.Rows, 0, m2.Cols, tile)
+		return res
+	}
+	mulTile(res, m1, m2, 0, m1.Rows, 0, m2.Cols, tile)
+	return res
+}
+
+// strassenMul рекурсивно умножает A (m×k) на B (k×n). Нечетные измерения дополняются
+// нулевой строкой или столбцом на текущем уровне рекурсии, результат затем обрезается.
+func strassenMul[T field.Field[T]](A, B *Matrix[T], threshold int, base func(a, b *Matrix[T]) *Matrix[T]) *Matrix[T] {
+	m, k, n := A.Rows, A.Cols, B.Cols
+	if min(m, k, n) < threshold {
+		return base(A, B)
+	}
+
+	hm, hk, hn := (m+1)/2, (k+1)/2, (n+1)/2
+	a11, a12, a21, a22 := quarters(A, hm, hk)
+	b11, b12, b21, b22 := quarters(B, hk, hn)
+
+	// Схема Винограда: 8 сложений операндов
+	s1 := blockAdd(a21, a22)
+	s2 := blockSub(s1, a11)
+	s3 := blockSub(a11, a21)
+	s4 := blockSub(a12, s2)
+	t1 := blockSub(b12, b11)
+	t2 := blockSub(b22, t1)
+	t3 := blockSub(b22, b12)
+	t4 := blockSub(t2, b21)
+
+	p1 := strassenMul(a11, b11, threshold, base)
+	p2 := strassenMul(a12, b21, threshold, base)
+	p3 := strassenMul(s4, b22, threshold, base)
+	p4 := strassenMul(a22, t4, threshold, base)
+	p5 := strassenMul(s1, t1, threshold, base)
+	p6 := strassenMul(s2, t2, threshold, base)
+	p7 := strassenMul(s3, t3, threshold, base)
+
+	// и 7 сложений произведений
+	u2 := blockAdd(p1, p6)
+	u3 := blockAdd(u2, p7)
+	u4 := blockAdd(u2, p5)
+	c11 := blockAdd(p1, p2)
+	c12 := blockAdd(u4, p3)
+	c21 := blockSub(u3, p4)
+	c22 := blockAdd(u3, p5)
+
+	res := NewMatrix[T](m, n, A.Data[0][0].Zero())
+	for i := 0; i < m; i++ {
+		if i < hm {
+			copy(res.Data[i][:hn], c11.Data[i])
+			copy(res.Data[i][hn:], c12.Data[i])
+		} else {
+			copy(res.Data[i][:hn], c21.Data[i-hm])
+			copy(res.Data[i][hn:], c22.Data[i-hm])
+		}
+	}
+	return res
+}
+
+// quarters делит матрицу на четыре блока размера rows×cols, дополняя нулями
+// нижние и правые блоки, если размеры матрицы нечетны
+func quarters[T field.Field[T]](M *Matrix[T], rows, cols int) (q11, q12, q21, q22 *Matrix[T]) {
+	zero := M.Data[0][0].Zero()
+	block := func(r0, c0 int) *Matrix[T] {
+		q := NewMatrix[T](rows, cols, zero)
+		for i := 0; i < rows && r0+i < M.Rows; i++ {
+			if c0 < M.Cols {
+				copy(q.Data[i], M.Data[r0+i][c0:min(c0+cols, M.Cols)])
+			}
+		}
+		return q
+	}
+	return block(0, 0), block(0, cols), block(rows, 0), block(rows, cols)
+}
+
+// blockAdd и blockSub складывают и вычитают блоки одинакового размера
+func blockAdd[T field.Field[T]](x, y *Matrix[T]) *Matrix[T] {
+	res := NewMatrix[T](x.Rows, x.Cols, x.Data[0][0].Zero())
+	for i := range res.Data {
+		for j := range res.Data[i] {
+			res.Data[i][j] = x.Data[i][j].Add(y.Data[i][j])
+		}
+	}
+	return res
+}
+
+func blockSub[T field.Field[T]](x, y *Matrix[T]) *Matrix[T] {
+	res := NewMatrix[T](x.Rows, x.Cols, x.Data[0][0].Zero())
+	for i := range res.Data {
+		for j := range res.Data[i] {
+			res.Data[i][j] = x.Data[i][j].Sub(y.Data[i][j])
+		}
+	}
+	return res
+}
@@ -0,0 +1,312 @@
+package matrix
+
+import (
+	"MatrixGo/internal/field"
+	"fmt"
+)
+
+const (
+	axisRow    = "строка"
+	axisColumn = "столбец"
+)
+
+// checkIndex проверяет, что индекс лежит в [0, limit)
+func checkIndex(op, axis string, index, limit int) error {
+	if index < 0 || index >= limit {
+		return &IndexError{Op: op, Axis: axis, Index: index, Limit: limit}
+	}
+	return nil
+}
+
+// checkNonEmpty проверяет, что все матрицы заданы и непусты
+func checkNonEmpty[T field.Field[T]](op string, mats ...*Matrix[T]) error {
+	if len(mats) == 0 {
+		return fmt.Errorf("%s: %w", op, ErrEmptyMatrix)
+	}
+	for _, m := range mats {
+		if m == nil || m.Rows == 0 || m.Cols == 0 {
+			return fmt.Errorf("%s: %w", op, ErrEmptyMatrix)
+		}
+	}
+	return nil
+}
+
+// Kronecker вычисляет кронекерово произведение A ⊗ B размера (m*p)×(n*q):
+// блок (i, j) равен a_ij * B
+func (m1 *Matrix[T]) Kronecker(m2 *Matrix[T]) (*Matrix[T], error) {
+	if err := checkNonEmpty("Kronecker", m1, m2); err != nil {
+		return nil, err
+	}
+
+	res := NewMatrix[T](m1.Rows*m2.Rows, m1.Cols*m2.Cols, m1.Data[0][0].Zero())
+	for i := 0; i < m1.Rows; i++ {
+		for j := 0; j < m1.Cols; j++ {
+			a := m1.Data[i][j]
+			for k := 0; k < m2.Rows; k++ {
+				for l := 0; l < m2.Cols; l++ {
+					res.Data[i*m2.Rows+k][j*m2.Cols+l] = a.Mul(m2.Data[k][l])
+				}
+			}
+		}
+	}
+	return res, nil
+}
+
+// Hadamard вычисляет поэлементное произведение матриц одинакового размера
+func (m1 *Matrix[T]) Hadamard(m2 *Matrix[T]) (*Matrix[T], error) {
+	if err := checkNonEmpty("Hadamard", m1, m2); err != nil {
+		return nil, err
+	}
+	if m1.Rows != m2.Rows || m1.Cols != m2.Cols {
+		return nil, &DimensionError{Op: "Hadamard", Expected: sizeString(m1.Rows, m1.Cols), Actual: sizeString(m2.Rows, m2.Cols)}
+	}
+
+	res := NewMatrix[T](m1.Rows, m1.Cols, m1.Data[0][0].Zero())
+	for i := 0; i < m1.Rows; i++ {
+		for j := 0; j < m1.Cols; j++ {
+			res.Data[i][j] = m1.Data[i][j].Mul(m2.Data[i][j])
+		}
+	}
+	return res, nil
+}
+
+// DirectSum строит блочно-диагональную матрицу A_1 ⊕ A_2 ⊕ ... ⊕ A_k
+func DirectSum[T field.Field[T]](mats ...*Matrix[T]) (*Matrix[T], error) {
+	if err := checkNonEmpty("DirectSum", mats...); err != nil {
+		return nil, err
+	}
+
+	rows, cols := 0, 0
+	for _, m := range mats {
+		rows += m.Rows
+		cols += m.Cols
+	}
+	res := NewMatrix[T](rows, cols, mats[0].Data[0][0].Zero())
+	r, c := 0, 0
+	for _, m := range mats {
+		for i := 0; i < m.Rows; i++ {
+			copy(res.Data[r+i][c:c+m.Cols], m.Data[i])
+		}
+		r += m.Rows
+		c += m.Cols
+	}
+	return res, nil
+}
+
+// HStack располагает матрицы с одинаковым числом строк слева направо
+func HStack[T field.Field[T]](mats ...*Matrix[T]) (*Matrix[T], error) {
+	return Block([][]*Matrix[T]{mats})
+}
+
+// VStack располагает матрицы с одинаковым числом столбцов сверху вниз
+func VStack[T field.Field[T]](mats ...*Matrix[T]) (*Matrix[T], error) {
+	grid := make([][]*Matrix[T], len(mats))
+	for i, m := range mats {
+		grid[i] = []*Matrix[T]{m}
+	}
+	return Block(grid)
+}
+
+// Block собирает матрицу из сетки блоков. Все блоки одной строки сетки должны иметь
+// одинаковое число строк, а все блоки одного столбца сетки — одинаковое число столбцов.
+func Block[T field.Field[T]](grid [][]*Matrix[T]) (*Matrix[T], error) {
+	if len(grid) == 0 || len(grid[0]) == 0 {
+		return nil, fmt.Errorf("Block: %w", ErrEmptyMatrix)
+	}
+	for _, row := range grid {
+		if len(row) != len(grid[0]) {
+			return nil, &DimensionError{Op: "Block", Expected: fmt.Sprintf("%d блоков в строке сетки", len(grid[0])), Actual: fmt.Sprint(len(row))}
+		}
+		if err := checkNonEmpty("Block", row...); err != nil {
+			return nil, err
+		}
+	}
+
+	// Высоты задает первый столбец сетки, ширины — первая строка
+	heights := make([]int, len(grid))
+	widths := make([]int, len(grid[0]))
+	rows, cols := 0, 0
+	for i, row := range grid {
+		heights[i] = row[0].Rows
+		rows += heights[i]
+	}
+	for j, b := range grid[0] {
+		widths[j] = b.Cols
+		cols += widths[j]
+	}
+	for i, row := range grid {
+		for j, b := range row {
+			if b.Rows != heights[i] || b.Cols != widths[j] {
+				return nil, &DimensionError{
+					Op:       fmt.Sprintf("Block: блок (%d, %d)", i, j),
+					Expected: sizeString(heights[i], widths[j]),
+					Actual:   sizeString(b.Rows, b.Cols),
+				}
+			}
+		}
+	}
+
+	res := NewMatrix[T](rows, cols, grid[0][0].Data[0][0].Zero())
+	r := 0
+	for i, row := range grid {
+		c := 0
+		for j, b := range row {
+			for k := 0; k < b.Rows; k++ {
+				copy(res.Data[r+k][c:c+widths[j]], b.Data[k])
+			}
+			c += widths[j]
+		}
+		r += heights[i]
+	}
+	return res, nil
+}
+
+// Submatrix возвращает подматрицу из указанных строк и столбцов в заданном порядке.
+// Индексы могут повторяться.
+func (m *Matrix[T]) Submatrix(rows, cols []int) (*Matrix[T], error) {
+	if len(rows) == 0 || len(cols) == 0 {
+		return nil, fmt.Errorf("Submatrix: %w", ErrEmptyMatrix)
+	}
+	for _, i := range rows {
+		if err := checkIndex("Submatrix", axisRow, i, m.Rows); err != nil {
+			return nil, err
+		}
+	}
+	for _, j := range cols {
+		if err := checkIndex("Submatrix", axisColumn, j, m.Cols); err != nil {
+			return nil, err
+		}
+	}
+
+	res := NewMatrix[T](len(rows), len(cols), m.Data[0][0].Zero())
+	for r, i := range rows {
+		for c, j := range cols {
+			res.Data[r][c] = m.Data[i][j]
+		}
+	}
+	return res, nil
+}
+
+// InsertRow возвращает новую матрицу со строкой row, вставленной перед строкой i (i = Rows — в конец)
+func (m *Matrix[T]) InsertRow(i int, row []T) (*Matrix[T], error) {
+	if err := checkIndex("InsertRow", axisRow, i, m.Rows+1); err != nil {
+		return nil, err
+	}
+	if len(row) != m.Cols {
+		return nil, &DimensionError{Op: "InsertRow", Expected: fmt.Sprintf("строка длины %d", m.Cols), Actual: fmt.Sprint(len(row))}
+	}
+
+	res := NewMatrix[T](m.Rows+1, m.Cols, m.Data[0][0].Zero())
+	for k := 0; k < m.Rows; k++ {
+		dst := k
+		if k >= i {
+			dst++
+		}
+		copy(res.Data[dst], m.Data[k])
+	}
+	copy(res.Data[i], row)
+	return res, nil
+}
+
+// InsertColumn возвращает новую матрицу со столбцом col, вставленным перед столбцом j (j = Cols — в конец)
+func (m *Matrix[T]) InsertColumn(j int, col []T) (*Matrix[T], error) {
+	if err := checkIndex("InsertColumn", axisColumn, j, m.Cols+1); err != nil {
+		return nil, err
+	}
+	if len(col) != m.Rows {
+		return nil, &DimensionError{Op: "InsertColumn", Expected: fmt.Sprintf("столбец длины %d", m.Rows), Actual: fmt.Sprint(len(col))}
+	}
+
+	res := NewMatrix[T](m.Rows, m.Cols+1, m.Data[0][0].Zero())
+	for i := 0; i < m.Rows; i++ {
+		copy(res.Data[i], m.Data[i][:j])
+		res.Data[i][j] = col[i]
+		copy(res.Data[i][j+1:], m.Data[i][j:])
+	}
+	return res, nil
+}
+
+// DeleteRow возвращает новую матрицу без строки i
+func (m *Matrix[T]) DeleteRow(i int) (*Matrix[T], error) {
+	if err := checkIndex("DeleteRow", axisRow, i, m.Rows); err != nil {
+		return nil, err
+	}
+	if m.Rows == 1 {
+		return nil, fmt.Errorf("DeleteRow: %w", ErrEmptyMatrix)
+	}
+
+	res := NewMatrix[T](m.Rows-1, m.Cols, m.Data[0][0].Zero())
+	for k := 0; k < m.Rows-1; k++ {
+		src := k
+		if k >= i {
+			src++
+		}
+		copy(res.Data[k], m.Data[src])
+	}
+	return res, nil
+}
+
+// DeleteColumn возвращает новую матрицу без столбца j
+func (m *Matrix[T]) DeleteColumn(j int) (*Matrix[T], error) {
+	if err := checkIndex("DeleteColumn", axisColumn, j, m.Cols); err != nil {
+		return nil, err
+	}
+	if m.Cols == 1 {
+		return nil, fmt.Errorf("DeleteColumn: %w", ErrEmptyMatrix)
+	}
+
+	res := NewMatrix[T](m.Rows, m.Cols-1, m.Data[0][0].Zero())
+	for i := 0; i < m.Rows; i++ {
+		copy(res.Data[i], m.Data[i][:j])
+		copy(res.Data[i][j:], m.Data[i][j+1:])
+	}
+	return res, nil
+}
+
+// SwapRows меняет местами строки i и j (элементарное преобразование первого типа).
+// Переставляются элементы, а не срезы Data, поэтому представления матрицы остаются согласованными.
+func (m *Matrix[T]) SwapRows(i, j int) error {
+	if err := checkIndex("SwapRows", axisRow, i, m.Rows); err != nil {
+		return err
+	}
+	if err := checkIndex("SwapRows", axisRow, j, m.Rows); err != nil {
+		return err
+	}
+	ri, rj := m.Data[i], m.Data[j]
+	for k := range ri {
+		ri[k], rj[k] = rj[k], ri[k]
+	}
+	return nil
+}
+
+// ScaleRow умножает строку i на ненулевой скаляр c (элементарное преобразование второго типа)
+func (m *Matrix[T]) ScaleRow(i int, c T) error {
+	if err := checkIndex("ScaleRow", axisRow, i, m.Rows); err != nil {
+		return err
+	}
+	if c.Equal(c.Zero()) {
+		return fmt.Errorf("ScaleRow: %w", ErrZeroScalar)
+	}
+	for j := range m.Data[i] {
+		m.Data[i][j] = m.Data[i][j].Mul(c)
+	}
+	return nil
+}
+
+// AddScaledRow прибавляет к строке dst строку src, умноженную на c
+// (элементарное преобразование третьего типа, dst ≠ src)
+func (m *Matrix[T]) AddScaledRow(dst, src int, c T) error {
+	if err := checkIndex("AddScaledRow", axisRow, dst, m.Rows); err != nil {
+		return err
+	}
+	if err := checkIndex("AddScaledRow", axisRow, src, m.Rows); err != nil {
+		return err
+	}
+	if dst == src {
+		return fmt.Errorf("AddScaledRow: %w", ErrSameRow)
+	}
+	for j := range m.Data[dst] {
+		m.Data[dst][j] = m.Data[dst][j].Add(c.Mul(m.Data[src][j]))
+	}
+	return nil
+}
@@ -0,0 +1,137 @@
+package matrix
+
+import (
+	"MatrixGo/internal/field"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKroneckerHadamard(t *testing.T) {
+	a := ratMatrix(t, [][]int64{{1, 2}, {3, 4}})
+	b := ratMatrix(t, [][]int64{{0, 5}, {6, 7}})
+
+	k, err := a.Kronecker(b)
+	assert.NoError(t, err)
+	assertMatrixEqual(t, ratMatrix(t, [][]int64{
+		{0, 5, 0, 10},
+		{6, 7, 12, 14},
+		{0, 15, 0, 20},
+		{18, 21, 24, 28},
+	}), k)
+
+	// (A ⊗ B)(C ⊗ D) = AC ⊗ BD
+	c := ratMatrix(t, [][]int64{{1}, {-1}})
+	d := ratMatrix(t, [][]int64{{2, 0, 1}, {1, 1, 0}})
+	cd, _ := c.Kronecker(d)
+	left, _ := k.Mul(cd)
+	ac, _ := a.Mul(c)
+	bd, _ := b.Mul(d)
+	right, _ := ac.Kronecker(bd)
+	assertMatrixEqual(t, right, left)
+
+	h, err := a.Hadamard(b)
+	assert.NoError(t, err)
+	assertMatrixEqual(t, ratMatrix(t, [][]int64{{0, 10}, {18, 28}}), h)
+
+	_, err = a.Hadamard(c)
+	var dimErr *DimensionError
+	assert.ErrorAs(t, err, &dimErr)
+	assert.ErrorIs(t, err, ErrDimensionMismatch)
+}
+
+func TestStacking(t *testing.T) {
+	a := ratMatrix(t, [][]int64{{1, 2}, {3, 4}})
+	col := ratMatrix(t, [][]int64{{5}, {6}})
+	row := ratMatrix(t, [][]int64{{7, 8}})
+
+	ds, err := DirectSum(a, col, row)
+	assert.NoError(t, err)
+	assertMatrixEqual(t, ratMatrix(t, [][]int64{
+		{1, 2, 0, 0, 0},
+		{3, 4, 0, 0, 0},
+		{0, 0, 5, 0, 0},
+		{0, 0, 6, 0, 0},
+		{0, 0, 0, 7, 8},
+	}), ds)
+
+	hs, err := HStack(a, col)
+	assert.NoError(t, err)
+	assertMatrixEqual(t, ratMatrix(t, [][]int64{{1, 2, 5}, {3, 4, 6}}), hs)
+
+	vs, err := VStack(a, row)
+	assert.NoError(t, err)
+	assertMatrixEqual(t, ratMatrix(t, [][]int64{{1, 2}, {3, 4}, {7, 8}}), vs)
+
+	corner := ratMatrix(t, [][]int64{{9}})
+	blk, err := Block([][]*Matrix[field.Rational]{{a, col}, {row, corner}})
+	assert.NoError(t, err)
+	assertMatrixEqual(t, ratMatrix(t, [][]int64{{1, 2, 5}, {3, 4, 6}, {7, 8, 9}}), blk)
+
+	_, err = HStack(a, row)
+	assert.ErrorIs(t, err, ErrDimensionMismatch)
+	_, err = VStack(a, col)
+	assert.ErrorIs(t, err, ErrDimensionMismatch)
+	_, err = Block([][]*Matrix[field.Rational]{{a, col}, {row}})
+	assert.ErrorIs(t, err, ErrDimensionMismatch)
+	_, err = DirectSum[field.Rational]()
+	assert.ErrorIs(t, err, ErrEmptyMatrix)
+	_, err = HStack(a, nil)
+	assert.ErrorIs(t, err, ErrEmptyMatrix)
+}
+
+func TestSubmatrixAndEditing(t *testing.T) {
+	m := ratMatrix(t, [][]int64{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}})
+
+	sub, err := m.Submatrix([]int{2, 0}, []int{1, 1, 2})
+	assert.NoError(t, err)
+	assertMatrixEqual(t, ratMatrix(t, [][]int64{{8, 8, 9}, {2, 2, 3}}), sub)
+
+	_, err = m.Submatrix([]int{3}, []int{0})
+	var idxErr *IndexError
+	assert.ErrorAs(t, err, &idxErr)
+	assert.Equal(t, 3, idxErr.Index)
+	assert.ErrorIs(t, err, ErrIndexOutOfRange)
+
+	ins, err := m.InsertRow(1, ratVector(0, 0, 1).Data)
+	assert.NoError(t, err)
+	assertMatrixEqual(t, ratMatrix(t, [][]int64{{1, 2, 3}, {0, 0, 1}, {4, 5, 6}, {7, 8, 9}}), ins)
+	ins, err = m.InsertColumn(3, ratVector(-1, -2, -3).Data)
+	assert.NoError(t, err)
+	assertMatrixEqual(t, ratMatrix(t, [][]int64{{1, 2, 3, -1}, {4, 5, 6, -2}, {7, 8, 9, -3}}), ins)
+	_, err = m.InsertRow(0, ratVector(1).Data)
+	assert.ErrorIs(t, err, ErrDimensionMismatch)
+	_, err = m.InsertColumn(5, ratVector(1, 2, 3).Data)
+	assert.ErrorIs(t, err, ErrIndexOutOfRange)
+
+	del, err := m.DeleteRow(0)
+	assert.NoError(t, err)
+	assertMatrixEqual(t, ratMatrix(t, [][]int64{{4, 5, 6}, {7, 8, 9}}), del)
+	del, err = m.DeleteColumn(1)
+	assert.NoError(t, err)
+	assertMatrixEqual(t, ratMatrix(t, [][]int64{{1, 3}, {4, 6}, {7, 9}}), del)
+	_, err = ratMatrix(t, [][]int64{{1, 2}}).DeleteRow(0)
+	assert.ErrorIs(t, err, ErrEmptyMatrix)
+
+	// Исходная матрица не изменилась
+	assertMatrixEqual(t, ratMatrix(t, [][]int64{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}}), m)
+}
+
+func TestElementaryRowOperations(t *testing.T) {
+	m := ratMatrix(t, [][]int64{{1, 2}, {3, 4}})
+
+	assert.NoError(t, m.SwapRows(0, 1))
+	assertMatrixEqual(t, ratMatrix(t, [][]int64{{3, 4}, {1, 2}}), m)
+
+	assert.NoError(t, m.ScaleRow(1, field.NewRational(-2, 1)))
+	assertMatrixEqual(t, ratMatrix(t, [][]int64{{3, 4}, {-2, -4}}), m)
+
+	assert.NoError(t, m.AddScaledRow(0, 1, field.NewRational(1, 1)))
+	assertMatrixEqual(t, ratMatrix(t, [][]int64{{1, 0}, {-2, -4}}), m)
+
+	assert.ErrorIs(t, m.ScaleRow(0, field.NewRational(0, 1)), ErrZeroScalar)
+	assert.ErrorIs(t, m.AddScaledRow(1, 1, field.NewRational(1, 1)), ErrSameRow)
+	assert.ErrorIs(t, m.SwapRows(0, 2), ErrIndexOutOfRange)
+	assert.True(t, errors.Is(m.AddScaledRow(-1, 0, field.NewRational(1, 1)), ErrIndexOutOfRange))
+}
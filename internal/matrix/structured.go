@@ -0,0 +1,519 @@
+package matrix
+
+import (
+	"MatrixGo/internal/field"
+	"MatrixGo/internal/vector"
+	"errors"
+	"fmt"
+)
+
+// MatrixStructure — вид структуры ненулевых элементов квадратной матрицы
+type MatrixStructure int
+
+const (
+	StructureGeneral         MatrixStructure = iota // плотная матрица общего вида
+	StructureDiagonal                               // диагональная
+	StructureLowerTriangular                        // нижняя треугольная
+	StructureUpperTriangular                        // верхняя треугольная
+	StructureTridiagonal                            // трехдиагональная
+	StructureBanded                                 // ленточная с узкой лентой
+)
+
+func (s MatrixStructure) String() string {
+	switch s {
+	case StructureDiagonal:
+		return "diagonal"
+	case StructureLowerTriangular:
+		return "lower-triangular"
+	case StructureUpperTriangular:
+		return "upper-triangular"
+	case StructureTridiagonal:
+		return "tridiagonal"
+	case StructureBanded:
+		return "banded"
+	}
+	return "general"
+}
+
+// StructureInfo описывает структуру матрицы и ширину ее ленты:
+// a_ij = 0 при i - j > Lower и при j - i > Upper
+type StructureInfo struct {
+	Kind  MatrixStructure
+	Lower int
+	Upper int
+}
+
+// Bandwidth возвращает нижнюю и верхнюю ширину ленты матрицы
+func (m *Matrix[T]) Bandwidth() (lower, upper int) {
+	for i := 0; i < m.Rows; i++ {
+		for j := 0; j < m.Cols; j++ {
+			if m.Data[i][j].Equal(m.Data[i][j].Zero()) {
+				continue
+			}
+			lower = max(lower, i-j)
+			upper = max(upper, j-i)
+		}
+	}
+	return lower, upper
+}
+
+// DetectStructure определяет структуру квадратной матрицы за O(n²). Ленточной считается
+// матрица, у которой лента занимает меньше половины ширины: тогда ленточное LU-разложение
+// (O(n·p·(p+q))) заметно быстрее общего исключения.
+func (m *Matrix[T]) DetectStructure() StructureInfo {
+	if m.Rows != m.Cols {
+		return StructureInfo{Kind: StructureGeneral, Lower: max(m.Rows-1, 0), Upper: max(m.Cols-1, 0)}
+	}
+	lower, upper := m.Bandwidth()
+	info := StructureInfo{Lower: lower, Upper: upper}
+	switch {
+	case lower == 0 && upper == 0:
+		info.Kind = StructureDiagonal
+	case upper == 0:
+		info.Kind = StructureLowerTriangular
+	case lower == 0:
+		info.Kind = StructureUpperTriangular
+	case lower <= 1 && upper <= 1:
+		info.Kind = StructureTridiagonal
+	case 2*(lower+upper) < m.Rows:
+		info.Kind = StructureBanded
+	default:
+		info.Kind = StructureGeneral
+	}
+	return info
+}
+
+// SolveSystemStructured решает систему, используя заранее известную структуру матрицы.
+// Элементы вне указанной структуры не читаются, поэтому она должна быть верной.
+func SolveSystemStructured[T field.Field[T]](mat *Matrix[T], vec *vector.Vector[T], info StructureInfo) (*vector.Vector[T], error) {
+	if mat.Rows != mat.Cols {
+		return nil, errors.New("матрица должна быть квадратной")
+	}
+	if mat.Rows != vec.Len() {
+		return nil, errors.New("размер вектора не совпадает с размером матрицы")
+	}
+
+	switch info.Kind {
+	case StructureDiagonal:
+		return SolveDiagonal(mat, vec)
+	case StructureLowerTriangular:
+		return SolveLowerTriangular(mat, vec, false)
+	case StructureUpperTriangular:
+		return SolveUpperTriangular(mat, vec, false)
+	case StructureTridiagonal:
+		return TridiagonalFromDense(mat).Solve(vec)
+	case StructureBanded:
+		return bandFromDense(mat, info.Lower, info.Upper).Solve(vec)
+	}
+	return solveSystemGauss(mat, vec)
+}
+
+// SolveDiagonal решает систему с диагональной матрицей
+func SolveDiagonal[T field.Field[T]](mat *Matrix[T], vec *vector.Vector[T]) (*vector.Vector[T], error) {
+	if err := checkTriangularArgs("SolveDiagonal", mat, vec); err != nil {
+		return nil, err
+	}
+	x := make([]T, mat.Rows)
+	for i := range x {
+		xi, err := divPivot(vec.Data[i], mat.Data[i][i])
+		if err != nil {
+			return nil, err
+		}
+		x[i] = xi
+	}
+	return vector.NewVector(x), nil
+}
+
+// SolveLowerTriangular решает систему с нижней треугольной матрицей прямой подстановкой
+// за O(n²). При unit = true диагональ считается единичной и не читается.
+func SolveLowerTriangular[T field.Field[T]](mat *Matrix[T], vec *vector.Vector[T], unit bool) (*vector.Vector[T], error) {
+	if err := checkTriangularArgs("SolveLowerTriangular", mat, vec); err != nil {
+		return nil, err
+	}
+	n := mat.Rows
+	x := make([]T, n)
+	for i := 0; i < n; i++ {
+		sum := vec.Data[i]
+		for j := 0; j < i; j++ {
+			sum = sum.Sub(mat.Data[i][j].Mul(x[j]))
+		}
+		if unit {
+			x[i] = sum
+			continue
+		}
+		xi, err := divPivot(sum, mat.Data[i][i])
+		if err != nil {
+			return nil, err
+		}
+		x[i] = xi
+	}
+	return vector.NewVector(x), nil
+}
+
+// SolveUpperTriangular решает систему с верхней треугольной матрицей обратной подстановкой
+// за O(n²). При unit = true диагональ считается единичной и не читается.
+func SolveUpperTriangular[T field.Field[T]](mat *Matrix[T], vec *vector.Vector[T], unit bool) (*vector.Vector[T], error) {
+	if err := checkTriangularArgs("SolveUpperTriangular", mat, vec); err != nil {
+		return nil, err
+	}
+	n := mat.Rows
+	x := make([]T, n)
+	for i := n - 1; i >= 0; i-- {
+		sum := vec.Data[i]
+		for j := i + 1; j < n; j++ {
+			sum = sum.Sub(mat.Data[i][j].Mul(x[j]))
+		}
+		if unit {
+			x[i] = sum
+			continue
+		}
+		xi, err := divPivot(sum, mat.Data[i][i])
+		if err != nil {
+			return nil, err
+		}
+		x[i] = xi
+	}
+	return vector.NewVector(x), nil
+}
+
+// divPivot делит на диагональный элемент; нулевой (с точностью Equal) элемент означает вырожденность
+func divPivot[T field.Field[T]](x, pivot T) (T, error) {
+	if pivot.Equal(pivot.Zero()) {
+		return x, errors.New("матрица вырождена, решение невозможно")
+	}
+	return x.Div(pivot)
+}
+
+func checkTriangularArgs[T field.Field[T]](op string, mat *Matrix[T], vec *vector.Vector[T]) error {
+	if mat.Rows != mat.Cols {
+		return &DimensionError{Op: op, Expected: "квадратная матрица", Actual: sizeString(mat.Rows, mat.Cols)}
+	}
+	if vec.Len() != mat.Rows {
+		return &DimensionError{Op: op, Expected: fmt.Sprintf("вектор размера %d", mat.Rows), Actual: fmt.Sprint(vec.Len())}
+	}
+	return nil
+}
+
+// TridiagonalMatrix хранит три диагонали матрицы n×n: Sub[i] = a_{i+1,i},
+// Diag[i] = a_{i,i}, Super[i] = a_{i,i+1}
+type TridiagonalMatrix[T field.Field[T]] struct {
+	Sub   []T
+	Diag  []T
+	Super []T
+}
+
+// NewTridiagonalMatrix создает трехдиагональную матрицу по диагоналям длины n-1, n и n-1
+func NewTridiagonalMatrix[T field.Field[T]](sub, diag, super []T) (*TridiagonalMatrix[T], error) {
+	n := len(diag)
+	if n == 0 {
+		return nil, fmt.Errorf("NewTridiagonalMatrix: %w", ErrEmptyMatrix)
+	}
+	if len(sub) != n-1 || len(super) != n-1 {
+		return nil, &DimensionError{
+			Op:       "NewTridiagonalMatrix",
+			Expected: fmt.Sprintf("побочные диагонали длины %d", n-1),
+			Actual:   fmt.Sprintf("%d и %d", len(sub), len(super)),
+		}
+	}
+	return &TridiagonalMatrix[T]{Sub: sub, Diag: diag, Super: super}, nil
+}
+
+// TridiagonalFromDense извлекает три диагонали квадратной матрицы (остальные элементы игнорируются)
+func TridiagonalFromDense[T field.Field[T]](m *Matrix[T]) *TridiagonalMatrix[T] {
+	n := m.Rows
+	t := &TridiagonalMatrix[T]{Sub: make([]T, max(n-1, 0)), Diag: make([]T, n), Super: make([]T, max(n-1, 0))}
+	for i := 0; i < n; i++ {
+		t.Diag[i] = m.Data[i][i]
+		if i+1 < n {
+			t.Sub[i] = m.Data[i+1][i]
+			t.Super[i] = m.Data[i][i+1]
+		}
+	}
+	return t
+}
+
+// Size возвращает порядок матрицы
+func (t *TridiagonalMatrix[T]) Size() int {
+	return len(t.Diag)
+}
+
+// ToDense преобразует трехдиагональную матрицу в плотную
+func (t *TridiagonalMatrix[T]) ToDense() *Matrix[T] {
+	n := t.Size()
+	res := NewMatrix(n, n, t.Diag[0].Zero())
+	for i := 0; i < n; i++ {
+		res.Data[i][i] = t.Diag[i]
+		if i+1 < n {
+			res.Data[i+1][i] = t.Sub[i]
+			res.Data[i][i+1] = t.Super[i]
+		}
+	}
+	return res
+}
+
+// Solve решает систему методом прогонки (алгоритм Томаса) за O(n). Прогонка не переставляет
+// строки; если на каком-то шаге ведущий элемент обращается в ноль, система решается
+// ленточным LU-разложением с выбором ведущего элемента.
+func (t *TridiagonalMatrix[T]) Solve(vec *vector.Vector[T]) (*vector.Vector[T], error) {
+	n := t.Size()
+	if vec.Len() != n {
+		return nil, &DimensionError{Op: "TridiagonalMatrix.Solve", Expected: fmt.Sprintf("вектор размера %d", n), Actual: fmt.Sprint(vec.Len())}
+	}
+
+	// Прямой ход: c[i] = super[i] / denom_i, d[i] = (b_i - sub[i-1]*d[i-1]) / denom_i
+	c := make([]T, n)
+	d := make([]T, n)
+	zero := t.Diag[0].Zero()
+	for i := 0; i < n; i++ {
+		denom := t.Diag[i]
+		rhs := vec.Data[i]
+		if i > 0 {
+			denom = denom.Sub(t.Sub[i-1].Mul(c[i-1]))
+			rhs = rhs.Sub(t.Sub[i-1].Mul(d[i-1]))
+		}
+		if denom.Equal(zero) {
+			return t.band().Solve(vec)
+		}
+		if i+1 < n {
+			c[i], _ = t.Super[i].Div(denom)
+		}
+		d[i], _ = rhs.Div(denom)
+	}
+
+	// Обратный ход
+	x := make([]T, n)
+	x[n-1] = d[n-1]
+	for i := n - 2; i >= 0; i-- {
+		x[i] = d[i].Sub(c[i].Mul(x[i+1]))
+	}
+	return vector.NewVector(x), nil
+}
+
+// band возвращает ту же матрицу в ленточном хранении
+func (t *TridiagonalMatrix[T]) band() *BandMatrix[T] {
+	n := t.Size()
+	b := NewBandMatrix(n, 1, 1, t.Diag[0].Zero())
+	for i := 0; i < n; i++ {
+		b.Data[i][1] = t.Diag[i]
+		if i+1 < n {
+			b.Data[i+1][0] = t.Sub[i]
+			b.Data[i][2] = t.Super[i]
+		}
+	}
+	return b
+}
+
+// BandMatrix хранит ленту матрицы n×n с нижней шириной Lower и верхней Upper:
+// элемент (i, j) при -Lower ≤ j - i ≤ Upper находится в Data[i][j-i+Lower]
+type BandMatrix[T field.Field[T]] struct {
+	N     int
+	Lower int
+	Upper int
+	Data  [][]T
+	zero  T
+}
+
+// NewBandMatrix создает нулевую ленточную матрицу
+func NewBandMatrix[T field.Field[T]](n, lower, upper int, zero T) *BandMatrix[T] {
+	width := lower + upper + 1
+	elems := make([]T, n*width)
+	for i := range elems {
+		elems[i] = zero
+	}
+	data := make([][]T, n)
+	for i := range data {
+		data[i] = elems[i*width : (i+1)*width : (i+1)*width]
+	}
+	return &BandMatrix[T]{N: n, Lower: lower, Upper: upper, Data: data, zero: zero}
+}
+
+// BandFromDense извлекает ленту квадратной матрицы; ненулевые элементы вне ленты — ошибка
+func BandFromDense[T field.Field[T]](m *Matrix[T], lower, upper int) (*BandMatrix[T], error) {
+	if m.Rows != m.Cols {
+		return nil, &DimensionError{Op: "BandFromDense", Expected: "квадратная матрица", Actual: sizeString(m.Rows, m.Cols)}
+	}
+	if l, u := m.Bandwidth(); l > lower || u > upper {
+		return nil, fmt.Errorf("BandFromDense: ширина ленты матрицы (%d, %d) больше заданной (%d, %d)", l, u, lower, upper)
+	}
+	return bandFromDense(m, lower, upper), nil
+}
+
+// bandFromDense копирует ленту без проверки элементов вне нее
+func bandFromDense[T field.Field[T]](m *Matrix[T], lower, upper int) *BandMatrix[T] {
+	b := NewBandMatrix(m.Rows, lower, upper, m.Data[0][0].Zero())
+	for i := 0; i < m.Rows; i++ {
+		for j := max(0, i-lower); j <= min(m.Cols-1, i+upper); j++ {
+			b.Data[i][j-i+lower] = m.Data[i][j]
+		}
+	}
+	return b
+}
+
+// inBand сообщает, хранится ли элемент (i, j)
+func (b *BandMatrix[T]) inBand(i, j int) bool {
+	return j-i >= -b.Lower && j-i <= b.Upper
+}
+
+// At возвращает элемент (i, j) (ноль вне ленты) без проверки границ
+func (b *BandMatrix[T]) At(i, j int) T {
+	if !b.inBand(i, j) {
+		return b.zero
+	}
+	return b.Data[i][j-i+b.Lower]
+}
+
+// Set записывает элемент (i, j); запись ненулевого элемента вне ленты — ошибка
+func (b *BandMatrix[T]) Set(i, j int, v T) error {
+	if err := checkIndex("BandMatrix.Set", axisRow, i, b.N); err != nil {
+		return err
+	}
+	if err := checkIndex("BandMatrix.Set", axisColumn, j, b.N); err != nil {
+		return err
+	}
+	if !b.inBand(i, j) {
+		if v.Equal(b.zero) {
+			return nil
+		}
+		return fmt.Errorf("BandMatrix.Set: элемент (%d, %d) вне ленты", i, j)
+	}
+	b.Data[i][j-i+b.Lower] = v
+	return nil
+}
+
+// ToDense преобразует ленточную матрицу в плотную
+func (b *BandMatrix[T]) ToDense() *Matrix[T] {
+	res := NewMatrix(b.N, b.N, b.zero)
+	for i := 0; i < b.N; i++ {
+		for j := max(0, i-b.Lower); j <= min(b.N-1, i+b.Upper); j++ {
+			res.Data[i][j] = b.Data[i][j-i+b.Lower]
+		}
+	}
+	return res
+}
+
+// Dims возвращает размеры матрицы
+func (b *BandMatrix[T]) Dims() (rows, cols int) {
+	return b.N, b.N
+}
+
+// MulVec вычисляет произведение ленточной матрицы на вектор за O(n·(Lower+Upper))
+func (b *BandMatrix[T]) MulVec(v *vector.Vector[T]) (*vector.Vector[T], error) {
+	if v.Len() != b.N {
+		return nil, &DimensionError{Op: "BandMatrix.MulVec", Expected: fmt.Sprintf("вектор размера %d", b.N), Actual: fmt.Sprint(v.Len())}
+	}
+	res := make([]T, b.N)
+	for i := 0; i < b.N; i++ {
+		sum := b.zero
+		for j := max(0, i-b.Lower); j <= min(b.N-1, i+b.Upper); j++ {
+			sum = sum.Add(b.Data[i][j-i+b.Lower].Mul(v.Data[j]))
+		}
+		res[i] = sum
+	}
+	return vector.NewVector(res), nil
+}
+
+// BandLU хранит ленточное LU-разложение с перестановками строк. Перестановки расширяют
+// верхнюю ленту U до Lower+Upper; множители L хранятся на местах исключенных элементов,
+// Pivots[k] — строка, переставленная со строкой k на шаге k.
+type BandLU[T field.Field[T]] struct {
+	LU     *BandMatrix[T]
+	Pivots []int
+}
+
+// LU вычисляет ленточное LU-разложение за O(n·Lower·(Lower+Upper)). Ведущий элемент
+// выбирается среди Lower+1 строк: для числовых полей — наибольший по модулю,
+// для точных — первый ненулевой.
+func (b *BandMatrix[T]) LU() (*BandLU[T], error) {
+	n, kl := b.N, b.Lower
+	ku := b.Lower + b.Upper
+	w := NewBandMatrix(n, kl, ku, b.zero)
+	for i := 0; i < n; i++ {
+		copy(w.Data[i][:kl+b.Upper+1], b.Data[i])
+	}
+	at := func(i, j int) *T { return &w.Data[i][j-i+kl] }
+
+	pivots := make([]int, n)
+	for k := 0; k < n; k++ {
+		last := min(n-1, k+kl)
+		p := -1
+		best := 0.0
+		for i := k; i <= last; i++ {
+			v := *at(i, k)
+			if mag, ok := pivotMagnitude(v); ok {
+				if mag > best {
+					best, p = mag, i
+				}
+			} else if !v.Equal(b.zero) {
+				p = i
+				break
+			}
+		}
+		if p < 0 || (*at(p, k)).Equal(b.zero) {
+			return nil, errors.New("матрица вырождена, решение невозможно")
+		}
+		pivots[k] = p
+
+		right := min(n-1, k+ku)
+		if p != k {
+			for j := k; j <= right; j++ {
+				*at(k, j), *at(p, j) = *at(p, j), *at(k, j)
+			}
+		}
+		pivot := *at(k, k)
+		for i := k + 1; i <= last; i++ {
+			l, _ := (*at(i, k)).Div(pivot)
+			*at(i, k) = l
+			if l.Equal(b.zero) {
+				continue
+			}
+			for j := k + 1; j <= right; j++ {
+				*at(i, j) = (*at(i, j)).Sub(l.Mul(*at(k, j)))
+			}
+		}
+	}
+	return &BandLU[T]{LU: w, Pivots: pivots}, nil
+}
+
+// Solve решает систему с ленточной матрицей через ленточное LU-разложение
+func (b *BandMatrix[T]) Solve(vec *vector.Vector[T]) (*vector.Vector[T], error) {
+	if vec.Len() != b.N {
+		return nil, &DimensionError{Op: "BandMatrix.Solve", Expected: fmt.Sprintf("вектор размера %d", b.N), Actual: fmt.Sprint(vec.Len())}
+	}
+	lu, err := b.LU()
+	if err != nil {
+		return nil, err
+	}
+	return lu.Solve(vec)
+}
+
+// Solve решает систему по готовому разложению за O(n·(Lower+Upper))
+func (f *BandLU[T]) Solve(vec *vector.Vector[T]) (*vector.Vector[T], error) {
+	w := f.LU
+	n, kl, ku := w.N, w.Lower, w.Upper
+	if vec.Len() != n {
+		return nil, &DimensionError{Op: "BandLU.Solve", Expected: fmt.Sprintf("вектор размера %d", n), Actual: fmt.Sprint(vec.Len())}
+	}
+	x := append([]T(nil), vec.Data...)
+
+	// Перестановки и исключение чередуются в том же порядке, что и при разложении
+	for k := 0; k < n; k++ {
+		if p := f.Pivots[k]; p != k {
+			x[k], x[p] = x[p], x[k]
+		}
+		for i := k + 1; i <= min(n-1, k+kl); i++ {
+			x[i] = x[i].Sub(w.Data[i][k-i+kl].Mul(x[k]))
+		}
+	}
+	for i := n - 1; i >= 0; i-- {
+		sum := x[i]
+		for j := i + 1; j <= min(n-1, i+ku); j++ {
+			sum = sum.Sub(w.Data[i][j-i+kl].Mul(x[j]))
+		}
+		xi, err := divPivot(sum, w.Data[i][kl])
+		if err != nil {
+			return nil, err
+		}
+		x[i] = xi
+	}
+	return vector.NewVector(x), nil
+}
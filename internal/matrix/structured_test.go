@@ -0,0 +1,208 @@
+package matrix
+
+import (
+	"MatrixGo/internal/field"
+	"MatrixGo/internal/vector"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// randomBandRational строит рациональную ленточную матрицу с диагональным преобладанием
+func randomBandRational(rng *rand.Rand, n, lower, upper int) *Matrix[field.Rational] {
+	m := NewMatrix(n, n, field.NewRational(0, 1))
+	for i := 0; i < n; i++ {
+		for j := max(0, i-lower); j <= min(n-1, i+upper); j++ {
+			m.Data[i][j] = field.NewRational(rng.Int63n(7)-3, 1)
+		}
+		m.Data[i][i] = field.NewRational(int64(lower+upper)*3+1, 1)
+	}
+	return m
+}
+
+func TestDetectStructure(t *testing.T) {
+	tests := []struct {
+		name   string
+		matrix [][]int64
+		kind   MatrixStructure
+	}{
+		{"diagonal", [][]int64{{2, 0}, {0, 3}}, StructureDiagonal},
+		{"lower", [][]int64{{1, 0, 0}, {2, 3, 0}, {4, 5, 6}}, StructureLowerTriangular},
+		{"upper", [][]int64{{1, 2, 3}, {0, 4, 5}, {0, 0, 6}}, StructureUpperTriangular},
+		{"tridiagonal", [][]int64{{2, 1, 0}, {1, 2, 1}, {0, 1, 2}}, StructureTridiagonal},
+		{"general", [][]int64{{1, 2, 3}, {4, 5, 6}, {7, 8, 10}}, StructureGeneral},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.kind, ratMatrix(t, tt.matrix).DetectStructure().Kind)
+		})
+	}
+
+	band := randomBandRational(rand.New(rand.NewSource(1)), 12, 2, 1)
+	info := band.DetectStructure()
+	assert.Equal(t, StructureInfo{Kind: StructureBanded, Lower: 2, Upper: 1}, info)
+	assert.Equal(t, "banded", info.Kind.String())
+}
+
+func TestTriangularSolvers(t *testing.T) {
+	lower := ratMatrix(t, [][]int64{{2, 0, 0}, {1, 3, 0}, {-1, 2, 4}})
+	b := ratVector(2, 7, 15)
+	x, err := SolveLowerTriangular(lower, b, false)
+	assert.NoError(t, err)
+	assert.Equal(t, ratVector(1, 2, 3).Data, x.Data)
+
+	// С единичной диагональю читаются только поддиагональные элементы
+	x, err = SolveLowerTriangular(lower, ratVector(1, 3, 6), true)
+	assert.NoError(t, err)
+	assert.Equal(t, ratVector(1, 2, 3).Data, x.Data)
+
+	upper := lower.Transpose()
+	x, err = SolveUpperTriangular(upper, ratVector(1, 12, 12), false)
+	assert.NoError(t, err)
+	assert.Equal(t, ratVector(1, 2, 3).Data, x.Data)
+
+	x, err = SolveDiagonal(ratMatrix(t, [][]int64{{2, 0}, {0, -3}}), ratVector(4, 9))
+	assert.NoError(t, err)
+	assert.Equal(t, ratVector(2, -3).Data, x.Data)
+
+	_, err = SolveUpperTriangular(ratMatrix(t, [][]int64{{1, 2}, {0, 0}}), ratVector(1, 1), false)
+	assert.EqualError(t, err, "матрица вырождена, решение невозможно")
+	_, err = SolveLowerTriangular(lower, ratVector(1, 2), false)
+	assert.ErrorIs(t, err, ErrDimensionMismatch)
+}
+
+func TestTridiagonalSolve(t *testing.T) {
+	t.Run("thomas", func(t *testing.T) {
+		mat := ratMatrix(t, [][]int64{
+			{2, -1, 0, 0},
+			{-1, 2, -1, 0},
+			{0, -1, 2, -1},
+			{0, 0, -1, 2},
+		})
+		b := ratVector(1, 0, 0, 1)
+		x, err := TridiagonalFromDense(mat).Solve(b)
+		assert.NoError(t, err)
+		assert.Equal(t, ratVector(1, 1, 1, 1).Data, x.Data)
+		assertMatrixEqual(t, mat, TridiagonalFromDense(mat).ToDense())
+	})
+
+	t.Run("zero pivot falls back to pivoting", func(t *testing.T) {
+		mat := ratMatrix(t, [][]int64{{0, 1, 0}, {1, 0, 1}, {0, 1, 1}})
+		b := ratVector(2, 4, 5)
+		x, err := TridiagonalFromDense(mat).Solve(b)
+		assert.NoError(t, err)
+		expected, _ := solveSystemGauss(mat, b)
+		assert.Equal(t, expected.Data, x.Data)
+	})
+
+	t.Run("gf", func(t *testing.T) {
+		gf := func(v int64) field.GF {
+			x, _ := field.NewGF(v, 7)
+			return x
+		}
+		tri, err := NewTridiagonalMatrix([]field.GF{gf(1), gf(2)}, []field.GF{gf(3), gf(1), gf(5)}, []field.GF{gf(4), gf(6)})
+		assert.NoError(t, err)
+		b := vector.NewVector([]field.GF{gf(1), gf(2), gf(3)})
+		x, err := tri.Solve(b)
+		assert.NoError(t, err)
+		expected, _ := solveSystemGauss(tri.ToDense(), b)
+		for i := range x.Data {
+			assert.True(t, expected.Data[i].Equal(x.Data[i]))
+		}
+
+		_, err = NewTridiagonalMatrix([]field.GF{gf(1)}, []field.GF{gf(3), gf(1), gf(5)}, []field.GF{gf(4), gf(6)})
+		assert.ErrorIs(t, err, ErrDimensionMismatch)
+	})
+
+	t.Run("large float64", func(t *testing.T) {
+		const n = 100000
+		sub, diag, super := make([]field.Float64, n-1), make([]field.Float64, n), make([]field.Float64, n-1)
+		for i := range diag {
+			diag[i] = 2
+			if i < n-1 {
+				sub[i], super[i] = -1, -1
+			}
+		}
+		tri, _ := NewTridiagonalMatrix(sub, diag, super)
+		b := make([]field.Float64, n)
+		b[0], b[n-1] = 1, 1
+		x, err := tri.Solve(vector.NewVector(b))
+		assert.NoError(t, err)
+		maxErr := 0.0
+		for _, xi := range x.Data {
+			maxErr = math.Max(maxErr, math.Abs(float64(xi)-1))
+		}
+		assert.Less(t, maxErr, 1e-6)
+	})
+}
+
+func TestBandSolve(t *testing.T) {
+	rng := rand.New(rand.NewSource(46))
+	mat := randomBandRational(rng, 15, 2, 3)
+	b := randomIntMatrix(rng, 15, 5).Data[0]
+	vec := vector.NewVector(b)
+
+	band, err := BandFromDense(mat, 2, 3)
+	assert.NoError(t, err)
+	assertMatrixEqual(t, mat, band.ToDense())
+
+	x, err := band.Solve(vec)
+	assert.NoError(t, err)
+	expected, _ := solveSystemGauss(mat, vec)
+	assert.Equal(t, expected.Data, x.Data)
+
+	ax, err := band.MulVec(x)
+	assert.NoError(t, err)
+	assert.Equal(t, b, ax.Data)
+
+	// Ведущий элемент вне диагонали требует перестановки строк внутри ленты
+	pivoting := ratMatrix(t, [][]int64{
+		{0, 1, 0, 0},
+		{2, 1, 1, 0},
+		{0, 3, 0, 1},
+		{0, 0, 1, 1},
+	})
+	pb, _ := BandFromDense(pivoting, 1, 1)
+	lu, err := pb.LU()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, lu.Pivots[0])
+	x, err = lu.Solve(ratVector(1, 4, 5, 3))
+	assert.NoError(t, err)
+	expected, _ = solveSystemGauss(pivoting, ratVector(1, 4, 5, 3))
+	assert.Equal(t, expected.Data, x.Data)
+
+	_, err = BandFromDense(mat, 1, 3)
+	assert.Error(t, err)
+	assert.Error(t, band.Set(0, 10, field.NewRational(1, 1)))
+	assert.NoError(t, band.Set(0, 10, field.NewRational(0, 1)))
+	var idxErr *IndexError
+	assert.ErrorAs(t, band.Set(15, 0, field.NewRational(1, 1)), &idxErr)
+
+	singular, _ := BandFromDense(ratMatrix(t, [][]int64{{1, 1, 0}, {1, 1, 0}, {0, 0, 1}}), 1, 1)
+	_, err = singular.LU()
+	assert.EqualError(t, err, "матрица вырождена, решение невозможно")
+}
+
+func TestSolveSystemDispatch(t *testing.T) {
+	rng := rand.New(rand.NewSource(7))
+	for _, mat := range []*Matrix[field.Rational]{
+		ratMatrix(t, [][]int64{{3, 0, 0}, {0, 2, 0}, {0, 0, 1}}),
+		ratMatrix(t, [][]int64{{1, 0, 0}, {2, 3, 0}, {4, 5, 6}}),
+		ratMatrix(t, [][]int64{{1, 2, 3}, {0, 4, 5}, {0, 0, 6}}),
+		ratMatrix(t, [][]int64{{2, 1, 0}, {1, 2, 1}, {0, 1, 2}}),
+		randomBandRational(rng, 20, 3, 2),
+		randomIntMatrix(rng, 6, 5),
+	} {
+		b := make([]field.Rational, mat.Rows)
+		for i := range b {
+			b[i] = field.NewRational(int64(i+1), 1)
+		}
+		vec := vector.NewVector(b)
+		x, err := SolveSystem(mat, vec)
+		assert.NoError(t, err, mat.DetectStructure().Kind.String())
+		expected, _ := solveSystemGauss(mat, vec)
+		assert.Equal(t, expected.Data, x.Data, mat.DetectStructure().Kind.String())
+	}
+}
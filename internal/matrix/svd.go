@@ -0,0 +1,431 @@
+package matrix
+
+import (
+	"MatrixGo/internal/field"
+	"errors"
+	"math"
+	"sort"
+)
+
+// svdMaxIterations ограничивает число шагов Голуба–Кахана на одно сингулярное число
+const svdMaxIterations = 75
+
+// SVDDecomposition хранит сокращенное сингулярное разложение A = U*diag(S)*V^H
+type SVDDecomposition[T field.Scalar[T]] struct {
+	U *Matrix[T] // Rows×k, k = min(Rows, Cols), ортонормированные столбцы
+	S []float64  // сингулярные числа по убыванию
+	V *Matrix[T] // Cols×k, ортонормированные столбцы
+}
+
+// SVD вычисляет сингулярное разложение матрицы над Float64 или Complex.
+// Матрица приводится к двухдиагональному виду отражениями Хаусхолдера (бидиагонализация
+// Голуба–Кахана), после чего сингулярные числа двухдиагональной матрицы находятся
+// неявным QR-алгоритмом со сдвигом.
+func SVD[T field.Scalar[T]](m *Matrix[T]) (*SVDDecomposition[T], error) {
+	if m.Rows < m.Cols {
+		// A^H = U'*S*V'^H  =>  A = V'*S*U'^H
+		res, err := SVD(conjugateTranspose(m))
+		if err != nil {
+			return nil, err
+		}
+		res.U, res.V = res.V, res.U
+		return res, nil
+	}
+
+	rows, n := m.Rows, m.Cols
+	zero := m.Data[0][0].Zero()
+	B := m.Clone()
+	U := IdentityMatrix[T](rows, zero, zero.One())
+	V := IdentityMatrix[T](n, zero, zero.One())
+
+	d, e := bidiagonalize(B, U, V)
+	if err := bidiagonalSVD(d, e, U, V); err != nil {
+		return nil, err
+	}
+
+	// Делаем сингулярные числа неотрицательными и упорядочиваем по убыванию
+	for i := range d {
+		if d[i] < 0 {
+			d[i] = -d[i]
+			for r := 0; r < n; r++ {
+				V.Data[r][i] = V.Data[r][i].Neg()
+			}
+		}
+	}
+	order := make([]int, n)
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool { return d[order[a]] > d[order[b]] })
+
+	res := &SVDDecomposition[T]{
+		U: NewMatrix[T](rows, n, zero),
+		S: make([]float64, n),
+		V: NewMatrix[T](n, n, zero),
+	}
+	for j, k := range order {
+		res.S[j] = d[k]
+		for r := 0; r < rows; r++ {
+			res.U.Data[r][j] = U.Data[r][k]
+		}
+		for r := 0; r < n; r++ {
+			res.V.Data[r][j] = V.Data[r][k]
+		}
+	}
+	return res, nil
+}
+
+// bidiagonalize приводит B (Rows ≥ Cols) к верхнему двухдиагональному виду B = U^H*A*V
+// и затем фазовыми множителями делает диагональ d и наддиагональ e вещественными
+func bidiagonalize[T field.Scalar[T]](B, U, V *Matrix[T]) (d, e []float64) {
+	n := B.Cols
+	zero := B.Data[0][0].Zero()
+
+	for k := 0; k < n; k++ {
+		// Отражение слева обнуляет столбец k ниже диагонали
+		if v, alpha, vNorm2, ok := householderVector(B, k, k); ok {
+			applyHouseholderLeft(B, v, k, k, vNorm2)
+			applyHouseholderRight(U, v, k, vNorm2)
+			B.Data[k][k] = alpha
+			for i := k + 1; i < B.Rows; i++ {
+				B.Data[i][k] = zero
+			}
+		}
+
+		// Отражение справа обнуляет строку k правее наддиагонали: строка как столбец x = conj(row)
+		if k+2 < n {
+			x := NewMatrix[T](n-k-1, 1, zero)
+			for j := k + 1; j < n; j++ {
+				x.Data[j-k-1][0] = B.Data[k][j].Conj()
+			}
+			if v, alpha, vNorm2, ok := householderVector(x, 0, 0); ok {
+				applyHouseholderRight(B, v, k+1, vNorm2)
+				applyHouseholderRight(V, v, k+1, vNorm2)
+				B.Data[k][k+1] = alpha.Conj()
+				for j := k + 2; j < n; j++ {
+					B.Data[k][j] = zero
+				}
+			}
+		}
+	}
+
+	// A = U*B*V^H = (U*D1)*(D1^H*B*D2)*(V*D2)^H с диагональными унитарными D1, D2
+	d = make([]float64, n)
+	e = make([]float64, max(n-1, 0))
+	for k := 0; k < n; k++ {
+		if a := B.Data[k][k]; a.Abs() > 0 {
+			phase := a.Scale(1 / a.Abs())
+			for r := 0; r < U.Rows; r++ {
+				U.Data[r][k] = U.Data[r][k].Mul(phase)
+			}
+			if k+1 < n {
+				B.Data[k][k+1] = B.Data[k][k+1].Mul(phase.Conj())
+			}
+		}
+		d[k] = B.Data[k][k].Abs()
+
+		if k+1 < n {
+			if b := B.Data[k][k+1]; b.Abs() > 0 {
+				conjPhase := b.Conj().Scale(1 / b.Abs())
+				for r := 0; r < V.Rows; r++ {
+					V.Data[r][k+1] = V.Data[r][k+1].Mul(conjPhase)
+				}
+				B.Data[k+1][k+1] = B.Data[k+1][k+1].Mul(conjPhase)
+			}
+			e[k] = B.Data[k][k+1].Abs()
+		}
+	}
+	return d, e
+}
+
+// bidiagonalSVD диагонализует вещественную двухдиагональную матрицу (d, e) шагами
+// Голуба–Кахана, накапливая вращения в столбцах U и V
+func bidiagonalSVD[T field.Scalar[T]](d, e []float64, U, V *Matrix[T]) error {
+	n := len(d)
+	bnorm := 0.0
+	for i := range d {
+		bnorm = math.Max(bnorm, math.Abs(d[i]))
+		if i < len(e) {
+			bnorm = math.Max(bnorm, math.Abs(d[i])+math.Abs(e[i]))
+		}
+	}
+	tiny := machineEpsilon * bnorm
+
+	hi, iter := n-1, 0
+	for hi > 0 {
+		for i := 0; i < hi; i++ {
+			if math.Abs(e[i]) <= machineEpsilon*(math.Abs(d[i])+math.Abs(d[i+1])) {
+				e[i] = 0
+			}
+		}
+
+		// Последний блок диагонален — значение d[hi] найдено
+		if e[hi-1] == 0 {
+			hi--
+			iter = 0
+			continue
+		}
+
+		// Активный блок [lo, hi] с ненулевой наддиагональю
+		lo := hi - 1
+		for lo > 0 && e[lo-1] != 0 {
+			lo--
+		}
+
+		iter++
+		if iter > svdMaxIterations {
+			return errors.New("сингулярное разложение не сошлось")
+		}
+
+		if math.Abs(d[hi]) <= tiny {
+			// Нулевой последний диагональный элемент: вращениями столбцов обнуляем e[hi-1]
+			d[hi] = 0
+			f := e[hi-1]
+			e[hi-1] = 0
+			for j := hi - 1; j >= lo; j-- {
+				r := math.Hypot(d[j], f)
+				c, s := d[j]/r, f/r
+				d[j] = r
+				rotateColumns(V, j, hi, c, s)
+				if j > lo {
+					f = -s * e[j-1]
+					e[j-1] *= c
+				}
+			}
+			continue
+		}
+
+		zeroDiag := -1
+		for i := lo; i < hi; i++ {
+			if math.Abs(d[i]) <= tiny {
+				zeroDiag = i
+				break
+			}
+		}
+		if zeroDiag >= 0 {
+			// Нулевой диагональный элемент: вращениями строк выталкиваем e[i] вправо
+			i := zeroDiag
+			d[i] = 0
+			f := e[i]
+			e[i] = 0
+			for j := i + 1; j <= hi; j++ {
+				r := math.Hypot(d[j], f)
+				c, s := d[j]/r, f/r
+				d[j] = r
+				rotateColumns(U, j, i, c, s)
+				if j < hi {
+					f = -s * e[j]
+					e[j] *= c
+				}
+			}
+			continue
+		}
+
+		golubKahanStep(d, e, lo, hi, U, V)
+	}
+	return nil
+}
+
+// golubKahanStep выполняет неявный QR-шаг со сдвигом Уилкинсона для B^T*B на блоке [lo, hi]
+func golubKahanStep[T field.Scalar[T]](d, e []float64, lo, hi int, U, V *Matrix[T]) {
+	// Сдвиг — собственное значение нижнего блока 2×2 матрицы B^T*B, ближайшее к последнему элементу
+	prev := 0.0
+	if hi-1 > lo {
+		prev = e[hi-2]
+	}
+	t11 := d[hi-1]*d[hi-1] + prev*prev
+	t12 := d[hi-1] * e[hi-1]
+	t22 := d[hi]*d[hi] + e[hi-1]*e[hi-1]
+	delta := (t11 - t22) / 2
+	den := math.Abs(delta) + math.Hypot(delta, t12)
+	mu := t22
+	if den != 0 {
+		mu = t22 - math.Copysign(t12*t12/den, delta)
+	}
+
+	y := d[lo]*d[lo] - mu
+	z := d[lo] * e[lo]
+	for k := lo; k < hi; k++ {
+		// Вращение столбцов k, k+1 обнуляет z
+		r := math.Hypot(y, z)
+		c, s := 1.0, 0.0
+		if r != 0 {
+			c, s = y/r, z/r
+		}
+		if k > lo {
+			e[k-1] = r
+		}
+		dk, ek := d[k], e[k]
+		d[k] = c*dk + s*ek
+		e[k] = c*ek - s*dk
+		bulge := s * d[k+1]
+		d[k+1] *= c
+		rotateColumns(V, k, k+1, c, s)
+
+		// Вращение строк k, k+1 обнуляет выпуклость под диагональю
+		y, z = d[k], bulge
+		r = math.Hypot(y, z)
+		c, s = 1.0, 0.0
+		if r != 0 {
+			c, s = y/r, z/r
+		}
+		d[k] = r
+		ek, dk1 := e[k], d[k+1]
+		e[k] = c*ek + s*dk1
+		d[k+1] = c*dk1 - s*ek
+		rotateColumns(U, k, k+1, c, s)
+		if k+1 < hi {
+			y, z = e[k], s*e[k+1]
+			e[k+1] *= c
+		}
+	}
+}
+
+// rotateColumns применяет вещественное вращение к столбцам i и j:
+// col_i = c*col_i + s*col_j, col_j = c*col_j - s*col_i
+func rotateColumns[T field.Scalar[T]](M *Matrix[T], i, j int, c, s float64) {
+	for r := 0; r < M.Rows; r++ {
+		a, b := M.Data[r][i], M.Data[r][j]
+		M.Data[r][i] = a.Scale(c).Add(b.Scale(s))
+		M.Data[r][j] = b.Scale(c).Sub(a.Scale(s))
+	}
+}
+
+// conjugateTranspose возвращает эрмитово сопряженную матрицу A^H
+func conjugateTranspose[T field.Scalar[T]](m *Matrix[T]) *Matrix[T] {
+	res := NewMatrix[T](m.Cols, m.Rows, m.Data[0][0].Zero())
+	for i := 0; i < m.Rows; i++ {
+		for j := 0; j < m.Cols; j++ {
+			res.Data[j][i] = m.Data[i][j].Conj()
+		}
+	}
+	return res
+}
+
+// DefaultTolerance возвращает стандартный порог max(Rows, Cols)*eps*σ_max,
+// ниже которого сингулярные числа считаются нулевыми
+func (s *SVDDecomposition[T]) DefaultTolerance() float64 {
+	if len(s.S) == 0 {
+		return 0
+	}
+	return float64(max(s.U.Rows, s.V.Rows)) * machineEpsilon * s.S[0]
+}
+
+// Rank возвращает численный ранг — количество сингулярных чисел больше tol.
+// При tol ≤ 0 используется DefaultTolerance.
+func (s *SVDDecomposition[T]) Rank(tol float64) int {
+	if tol <= 0 {
+		tol = s.DefaultTolerance()
+	}
+	rank := 0
+	for _, sigma := range s.S {
+		if sigma > tol {
+			rank++
+		}
+	}
+	return rank
+}
+
+// Norm2 возвращает спектральную норму ||A||₂ = σ_max
+func (s *SVDDecomposition[T]) Norm2() float64 {
+	if len(s.S) == 0 {
+		return 0
+	}
+	return s.S[0]
+}
+
+// Condition возвращает число обусловленности σ_max/σ_min (+Inf для вырожденной матрицы)
+func (s *SVDDecomposition[T]) Condition() float64 {
+	if len(s.S) == 0 {
+		return 0
+	}
+	last := s.S[len(s.S)-1]
+	if last == 0 {
+		return math.Inf(1)
+	}
+	return s.S[0] / last
+}
+
+// PseudoInverse вычисляет псевдообратную Мура–Пенроуза A⁺ = V*diag(1/σ)*U^H,
+// отбрасывая сингулярные числа не больше tol (при tol ≤ 0 — DefaultTolerance)
+func (s *SVDDecomposition[T]) PseudoInverse(tol float64) *Matrix[T] {
+	rank := s.Rank(tol)
+	zero := s.U.Data[0][0].Zero()
+	res := NewMatrix[T](s.V.Rows, s.U.Rows, zero)
+	for i := 0; i < s.V.Rows; i++ {
+		for j := 0; j < s.U.Rows; j++ {
+			sum := zero
+			for k := 0; k < rank; k++ {
+				sum = sum.Add(s.V.Data[i][k].Mul(s.U.Data[j][k].Conj()).Scale(1 / s.S[k]))
+			}
+			res.Data[i][j] = sum
+		}
+	}
+	return res
+}
+
+// LowRank возвращает наилучшее в 2-норме и норме Фробениуса приближение ранга k:
+// A_k = U_k*diag(σ_1..σ_k)*V_k^H (теорема Эккарта–Янга)
+func (s *SVDDecomposition[T]) LowRank(k int) (*Matrix[T], error) {
+	if k < 0 || k > len(s.S) {
+		return nil, errors.New("ранг приближения вне допустимого диапазона")
+	}
+	zero := s.U.Data[0][0].Zero()
+	res := NewMatrix[T](s.U.Rows, s.V.Rows, zero)
+	for i := 0; i < s.U.Rows; i++ {
+		for j := 0; j < s.V.Rows; j++ {
+			sum := zero
+			for l := 0; l < k; l++ {
+				sum = sum.Add(s.U.Data[i][l].Mul(s.V.Data[j][l].Conj()).Scale(s.S[l]))
+			}
+			res.Data[i][j] = sum
+		}
+	}
+	return res, nil
+}
+
+// NumericalRank вычисляет ранг по сингулярным числам с порогом tol (при tol ≤ 0 — стандартный).
+// В отличие от Rank, не зависит от точных сравнений с нулем.
+func NumericalRank[T field.Scalar[T]](m *Matrix[T], tol float64) (int, error) {
+	s, err := SVD(m)
+	if err != nil {
+		return 0, err
+	}
+	return s.Rank(tol), nil
+}
+
+// PseudoInverse вычисляет псевдообратную Мура–Пенроуза через SVD
+func PseudoInverse[T field.Scalar[T]](m *Matrix[T], tol float64) (*Matrix[T], error) {
+	s, err := SVD(m)
+	if err != nil {
+		return nil, err
+	}
+	return s.PseudoInverse(tol), nil
+}
+
+// ConditionNumber вычисляет число обусловленности в 2-норме
+func ConditionNumber[T field.Scalar[T]](m *Matrix[T]) (float64, error) {
+	s, err := SVD(m)
+	if err != nil {
+		return 0, err
+	}
+	return s.Condition(), nil
+}
+
+// Norm2 вычисляет спектральную норму матрицы
+func Norm2[T field.Scalar[T]](m *Matrix[T]) (float64, error) {
+	s, err := SVD(m)
+	if err != nil {
+		return 0, err
+	}
+	return s.Norm2(), nil
+}
+
+// LowRankApproximation вычисляет наилучшее приближение матрицы ранга k
+func LowRankApproximation[T field.Scalar[T]](m *Matrix[T], k int) (*Matrix[T], error) {
+	s, err := SVD(m)
+	if err != nil {
+		return nil, err
+	}
+	return s.LowRank(k)
+}
@@ -0,0 +1,223 @@
+package matrix
+
+import (
+	"MatrixGo/internal/field"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// reconstructSVD собирает U*diag(S)*V^H
+func reconstructSVD[T field.Scalar[T]](s *SVDDecomposition[T]) *Matrix[T] {
+	res, _ := s.LowRank(len(s.S))
+	return res
+}
+
+// assertOrthonormalColumns проверяет M^H*M = I
+func assertOrthonormalColumns[T field.Scalar[T]](t *testing.T, M *Matrix[T]) {
+	t.Helper()
+	mhm, _ := conjugateTranspose(M).Mul(M)
+	zero := M.Data[0][0].Zero()
+	assertMatrixEqual(t, IdentityMatrix[T](M.Cols, zero, zero.One()), mhm)
+}
+
+func TestSVD(t *testing.T) {
+	t.Run("tall float64", func(t *testing.T) {
+		mat, _ := FromSlice([][]field.Float64{
+			{1, 2},
+			{3, 4},
+			{5, 6},
+		})
+
+		s, err := SVD(mat)
+		assert.NoError(t, err)
+		assert.InDeltaSlice(t, []float64{9.525518091565107, 0.514300580658644}, s.S, 1e-12)
+		assertOrthonormalColumns(t, s.U)
+		assertOrthonormalColumns(t, s.V)
+		assertMatrixEqual(t, mat, reconstructSVD(s))
+	})
+
+	t.Run("wide complex", func(t *testing.T) {
+		mat, _ := FromSlice([][]field.Complex{
+			{{Re: 1, Im: 1}, {Re: 2}, {Im: -1}, {Re: 0.5}},
+			{{Re: 0}, {Re: 3, Im: -2}, {Re: 1}, {Im: 4}},
+			{{Re: 1}, {Im: 2}, {Re: -1}, {Re: 2, Im: 2}},
+		})
+
+		s, err := SVD(mat)
+		assert.NoError(t, err)
+		assert.Equal(t, 4, s.V.Rows)
+		assert.Len(t, s.S, 3)
+		assertOrthonormalColumns(t, s.U)
+		assertOrthonormalColumns(t, s.V)
+		assertMatrixEqual(t, mat, reconstructSVD(s))
+
+		// ||A||_F² = Σσ²
+		sum := 0.0
+		for _, sigma := range s.S {
+			sum += sigma * sigma
+		}
+		assert.InDelta(t, frobeniusNorm(mat), math.Sqrt(sum), 1e-9)
+	})
+
+	t.Run("larger float64", func(t *testing.T) {
+		mat := NewMatrix[field.Float64](9, 6, 0)
+		seed := 11
+		for i := range mat.Data {
+			for j := range mat.Data[i] {
+				seed = (seed*1103515245 + 12345) % 2147483648
+				mat.Data[i][j] = field.Float64(seed%21-10) / 3
+			}
+		}
+
+		s, err := SVD(mat)
+		assert.NoError(t, err)
+		assertOrthonormalColumns(t, s.U)
+		assertOrthonormalColumns(t, s.V)
+		assertMatrixEqual(t, mat, reconstructSVD(s))
+		for i := 1; i < len(s.S); i++ {
+			assert.GreaterOrEqual(t, s.S[i-1], s.S[i])
+		}
+	})
+
+	t.Run("rank deficient", func(t *testing.T) {
+		mat, _ := FromSlice([][]field.Float64{
+			{1, 2, 3},
+			{2, 4, 6},
+			{1, 0, 1},
+			{0, 0, 0},
+		})
+
+		s, err := SVD(mat)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, s.Rank(0))
+		assertMatrixEqual(t, mat, reconstructSVD(s))
+		assert.True(t, math.IsInf(s.Condition(), 1))
+	})
+}
+
+func TestNumericalRank(t *testing.T) {
+	// Шум порядка 1e-8 делает точный ранг полным, а численный — равным 1
+	mat, _ := FromSlice([][]field.Float64{
+		{1, 2},
+		{2, 4 + 1e-8},
+	})
+
+	rank, err := NumericalRank(mat, 1e-6)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, rank)
+	assert.Equal(t, 2, mat.Rank())
+
+	rank, _ = NumericalRank(mat, 1e-12)
+	assert.Equal(t, 2, rank)
+}
+
+func TestSVDNormsAndApproximation(t *testing.T) {
+	mat, _ := FromSlice([][]field.Float64{
+		{3, 0},
+		{0, -2},
+	})
+
+	norm, err := Norm2(mat)
+	assert.NoError(t, err)
+	assert.InDelta(t, 3, norm, 1e-12)
+
+	cond, err := ConditionNumber(mat)
+	assert.NoError(t, err)
+	assert.InDelta(t, 1.5, cond, 1e-12)
+
+	approx, err := LowRankApproximation(mat, 1)
+	assert.NoError(t, err)
+	expected, _ := FromSlice([][]field.Float64{{3, 0}, {0, 0}})
+	assertMatrixEqual(t, expected, approx)
+
+	_, err = LowRankApproximation(mat, 3)
+	assert.Error(t, err)
+}
+
+func TestPseudoInverse(t *testing.T) {
+	mat, _ := FromSlice([][]field.Float64{
+		{1, 2},
+		{2, 4},
+		{3, 6},
+	})
+
+	pinv, err := PseudoInverse(mat, 0)
+	assert.NoError(t, err)
+
+	// Условия Мура–Пенроуза: A*A⁺*A = A и A⁺*A*A⁺ = A⁺
+	apa, _ := mat.Mul(pinv)
+	apa, _ = apa.Mul(mat)
+	assertMatrixEqual(t, mat, apa)
+	pap, _ := pinv.Mul(mat)
+	pap, _ = pap.Mul(pinv)
+	assertMatrixEqual(t, pinv, pap)
+
+	// Для матрицы ранга 1 A⁺ = A^T/||A||_F² = A^T/70
+	expected := mat.Transpose()
+	for i := range expected.Data {
+		for j := range expected.Data[i] {
+			expected.Data[i][j] /= 70
+		}
+	}
+	assertMatrixEqual(t, expected, pinv)
+}
+
+func TestPseudoInverseSingular(t *testing.T) {
+	// Квадратная вырожденная матрица ранга 2: численная псевдообратная совпадает с точной
+	singular, _ := FromSlice([][]field.Float64{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}})
+	pinv, err := PseudoInverse(singular, 0)
+	assert.NoError(t, err)
+
+	apa, _ := singular.Mul(pinv)
+	apa, _ = apa.Mul(singular)
+	assertMatrixNear(t, singular, apa, 1e-12)
+	pap, _ := pinv.Mul(singular)
+	pap, _ = pap.Mul(pinv)
+	assertMatrixNear(t, pinv, pap, 1e-12)
+
+	// Матрицы Грама скелетного разложения невырождены, хотя сама матрица вырождена
+	exact, err := PseudoInverseExact(ratMatrix(t, [][]int64{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}}))
+	assert.NoError(t, err)
+	for i := range exact.Data {
+		for j := range exact.Data[i] {
+			assert.InDelta(t, exact.Data[i][j].Float64(), float64(pinv.Data[i][j]), 1e-12)
+		}
+	}
+}
+
+func TestPseudoInverseExact(t *testing.T) {
+	mat := ratMatrix(t, [][]int64{
+		{1, 2},
+		{2, 4},
+		{3, 6},
+	})
+
+	pinv, err := PseudoInverseExact(mat)
+	assert.NoError(t, err)
+
+	expected := mat.Transpose()
+	for i := range expected.Data {
+		for j := range expected.Data[i] {
+			expected.Data[i][j], _ = expected.Data[i][j].Div(field.NewRational(70, 1))
+		}
+	}
+	assertMatrixEqual(t, expected, pinv)
+
+	t.Run("invertible matches inverse", func(t *testing.T) {
+		sq := ratMatrix(t, [][]int64{{2, 1}, {1, 1}})
+		pinv, err := PseudoInverseExact(sq)
+		assert.NoError(t, err)
+		inv, _ := sq.InverseParallel()
+		assertMatrixEqual(t, inv, pinv)
+	})
+
+	t.Run("zero matrix", func(t *testing.T) {
+		z := ratMatrix(t, [][]int64{{0, 0, 0}, {0, 0, 0}})
+		pinv, err := PseudoInverseExact(z)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, pinv.Rows)
+		assert.Equal(t, 2, pinv.Cols)
+	})
+}
@@ -0,0 +1,287 @@
+package polynomial
+
+import (
+	"MatrixGo/internal/field"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Polynomial — многочлен с коэффициентами из поля T.
+// Coeffs[i] — коэффициент при x^i; старший коэффициент всегда ненулевой,
+// нулевой многочлен хранится как пустой срез.
+type Polynomial[T field.Field[T]] struct {
+	Coeffs []T
+	zero   T
+}
+
+// New создает многочлен по коэффициентам в порядке возрастания степеней, отбрасывая старшие нули
+func New[T field.Field[T]](coeffs []T, zero T) *Polynomial[T] {
+	p := &Polynomial[T]{Coeffs: append([]T(nil), coeffs...), zero: zero}
+	p.trim()
+	return p
+}
+
+// Zero создает нулевой многочлен
+func Zero[T field.Field[T]](zero T) *Polynomial[T] {
+	return &Polynomial[T]{zero: zero}
+}
+
+// Monomial создает одночлен c*x^degree
+func Monomial[T field.Field[T]](c T, degree int, zero T) *Polynomial[T] {
+	coeffs := make([]T, degree+1)
+	for i := range coeffs {
+		coeffs[i] = zero
+	}
+	coeffs[degree] = c
+	return New(coeffs, zero)
+}
+
+// trim удаляет нулевые старшие коэффициенты
+func (p *Polynomial[T]) trim() {
+	n := len(p.Coeffs)
+	for n > 0 && p.Coeffs[n-1].Equal(p.zero) {
+		n--
+	}
+	p.Coeffs = p.Coeffs[:n]
+}
+
+// Degree возвращает степень многочлена (-1 для нулевого)
+func (p *Polynomial[T]) Degree() int {
+	return len(p.Coeffs) - 1
+}
+
+// IsZero проверяет, является ли многочлен нулевым
+func (p *Polynomial[T]) IsZero() bool {
+	return len(p.Coeffs) == 0
+}
+
+// Coefficient возвращает коэффициент при x^i (ноль за пределами степени)
+func (p *Polynomial[T]) Coefficient(i int) T {
+	if i < 0 || i >= len(p.Coeffs) {
+		return p.zero
+	}
+	return p.Coeffs[i]
+}
+
+// Leading возвращает старший коэффициент (ноль для нулевого многочлена)
+func (p *Polynomial[T]) Leading() T {
+	return p.Coefficient(p.Degree())
+}
+
+// Add складывает многочлены
+func (p *Polynomial[T]) Add(q *Polynomial[T]) *Polynomial[T] {
+	n := max(len(p.Coeffs), len(q.Coeffs))
+	coeffs := make([]T, n)
+	for i := range coeffs {
+		coeffs[i] = p.Coefficient(i).Add(q.Coefficient(i))
+	}
+	return New(coeffs, p.zero)
+}
+
+// Sub вычитает многочлены
+func (p *Polynomial[T]) Sub(q *Polynomial[T]) *Polynomial[T] {
+	n := max(len(p.Coeffs), len(q.Coeffs))
+	coeffs := make([]T, n)
+	for i := range coeffs {
+		coeffs[i] = p.Coefficient(i).Sub(q.Coefficient(i))
+	}
+	return New(coeffs, p.zero)
+}
+
+// Mul перемножает многочлены
+func (p *Polynomial[T]) Mul(q *Polynomial[T]) *Polynomial[T] {
+	if p.IsZero() || q.IsZero() {
+		return Zero(p.zero)
+	}
+	coeffs := make([]T, len(p.Coeffs)+len(q.Coeffs)-1)
+	for i := range coeffs {
+		coeffs[i] = p.zero
+	}
+	for i, a := range p.Coeffs {
+		for j, b := range q.Coeffs {
+			coeffs[i+j] = coeffs[i+j].Add(a.Mul(b))
+		}
+	}
+	return New(coeffs, p.zero)
+}
+
+// Scale умножает многочлен на элемент поля
+func (p *Polynomial[T]) Scale(c T) *Polynomial[T] {
+	coeffs := make([]T, len(p.Coeffs))
+	for i, a := range p.Coeffs {
+		coeffs[i] = a.Mul(c)
+	}
+	return New(coeffs, p.zero)
+}
+
+// Derivative вычисляет формальную производную. Множитель i получается как сумма i единиц
+// поля, поэтому в характеристике p производная x^p равна нулю.
+func (p *Polynomial[T]) Derivative() *Polynomial[T] {
+	if len(p.Coeffs) < 2 {
+		return Zero(p.zero)
+	}
+	coeffs := make([]T, len(p.Coeffs)-1)
+	k := p.zero.One()
+	for i := 1; i < len(p.Coeffs); i++ {
+		coeffs[i-1] = p.Coeffs[i].Mul(k)
+		k = k.Add(p.zero.One())
+	}
+	return New(coeffs, p.zero)
+}
+
+// DivMod делит p на q с остатком: p = quo*q + rem, deg rem < deg q
+func (p *Polynomial[T]) DivMod(q *Polynomial[T]) (quo, rem *Polynomial[T], err error) {
+	if q.IsZero() {
+		return nil, nil, errors.New("деление многочлена на ноль")
+	}
+
+	rem = New(p.Coeffs, p.zero)
+	quoCoeffs := make([]T, max(p.Degree()-q.Degree()+1, 0))
+	for i := range quoCoeffs {
+		quoCoeffs[i] = p.zero
+	}
+
+	for !rem.IsZero() && rem.Degree() >= q.Degree() {
+		top := rem.Degree()
+		c, err := rem.Coeffs[top].Div(q.Leading())
+		if err != nil {
+			return nil, nil, err
+		}
+		shift := top - q.Degree()
+		quoCoeffs[shift] = c
+		for i, b := range q.Coeffs {
+			rem.Coeffs[shift+i] = rem.Coeffs[shift+i].Sub(c.Mul(b))
+		}
+		// Старший коэффициент обнуляется точно, даже если округление оставило погрешность
+		rem.Coeffs = rem.Coeffs[:top]
+		rem.trim()
+	}
+	return New(quoCoeffs, p.zero), rem, nil
+}
+
+// Monic делит многочлен на старший коэффициент
+func (p *Polynomial[T]) Monic() (*Polynomial[T], error) {
+	if p.IsZero() {
+		return p, nil
+	}
+	inv, err := p.zero.One().Div(p.Leading())
+	if err != nil {
+		return nil, err
+	}
+	return p.Scale(inv), nil
+}
+
+// Eval вычисляет значение многочлена в точке x по схеме Горнера
+func (p *Polynomial[T]) Eval(x T) T {
+	res := p.zero
+	for i := len(p.Coeffs) - 1; i >= 0; i-- {
+		res = res.Mul(x).Add(p.Coeffs[i])
+	}
+	return res
+}
+
+// Equal сравнивает многочлены покоэффициентно
+func (p *Polynomial[T]) Equal(q *Polynomial[T]) bool {
+	if len(p.Coeffs) != len(q.Coeffs) {
+		return false
+	}
+	for i := range p.Coeffs {
+		if !p.Coeffs[i].Equal(q.Coeffs[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// GCD вычисляет нормированный наибольший общий делитель алгоритмом Евклида
+func GCD[T field.Field[T]](a, b *Polynomial[T]) (*Polynomial[T], error) {
+	for !b.IsZero() {
+		_, r, err := a.DivMod(b)
+		if err != nil {
+			return nil, err
+		}
+		a, b = b, r
+	}
+	return a.Monic()
+}
+
+// LCM вычисляет нормированное наименьшее общее кратное
+func LCM[T field.Field[T]](a, b *Polynomial[T]) (*Polynomial[T], error) {
+	if a.IsZero() || b.IsZero() {
+		return Zero(a.zero), nil
+	}
+	g, err := GCD(a, b)
+	if err != nil {
+		return nil, err
+	}
+	q, _, err := a.Mul(b).DivMod(g)
+	if err != nil {
+		return nil, err
+	}
+	return q.Monic()
+}
+
+// String возвращает запись многочлена от переменной x, например "x^3 - 2x + 1/2"
+func (p *Polynomial[T]) String() string {
+	return p.Format("x")
+}
+
+// Format возвращает запись многочлена от заданной переменной по убыванию степеней.
+// Составные коэффициенты (комплексные числа) заключаются в скобки, а для GF(p)
+// модуль указывается один раз в конце записи.
+func (p *Polynomial[T]) Format(variable string) string {
+	if p.IsZero() {
+		return "0"
+	}
+
+	var sb strings.Builder
+	suffix := ""
+	for i := p.Degree(); i >= 0; i-- {
+		c := p.Coeffs[i]
+		if c.Equal(p.zero) {
+			continue
+		}
+		coef, negative, mod := formatCoefficient(c)
+		suffix = mod
+
+		switch {
+		case sb.Len() == 0 && negative:
+			sb.WriteString("-")
+		case sb.Len() > 0 && negative:
+			sb.WriteString(" - ")
+		case sb.Len() > 0:
+			sb.WriteString(" + ")
+		}
+
+		if i == 0 {
+			sb.WriteString(coef)
+			continue
+		}
+		if coef != "1" {
+			sb.WriteString(coef)
+		}
+		sb.WriteString(variable)
+		if i > 1 {
+			fmt.Fprintf(&sb, "^%d", i)
+		}
+	}
+	return sb.String() + suffix
+}
+
+// formatCoefficient возвращает модуль записи коэффициента, признак знака минус
+// и суффикс с модулем для элементов конечного поля
+func formatCoefficient[T field.Field[T]](c T) (coef string, negative bool, suffix string) {
+	if g, ok := any(c).(field.GF); ok {
+		return g.Value().String(), false, fmt.Sprintf(" (mod %s)", g.Modulus())
+	}
+
+	s := fmt.Sprint(c)
+	if strings.HasPrefix(s, "-") && !strings.ContainsAny(s[1:], "+-") {
+		return s[1:], true, ""
+	}
+	if len(s) > 1 && strings.ContainsAny(s[1:], "+-") {
+		return "(" + s + ")", false, ""
+	}
+	return s, false, ""
+}
@@ -0,0 +1,81 @@
+package polynomial
+
+import (
+	"MatrixGo/internal/field"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func rat(num, den int64) field.Rational {
+	return field.NewRational(num, den)
+}
+
+func ratPoly(coeffs ...int64) *Polynomial[field.Rational] {
+	c := make([]field.Rational, len(coeffs))
+	for i, v := range coeffs {
+		c[i] = rat(v, 1)
+	}
+	return New(c, rat(0, 1))
+}
+
+func TestPolynomialArithmetic(t *testing.T) {
+	p := ratPoly(-1, 0, 1) // x^2 - 1
+	q := ratPoly(1, 1)     // x + 1
+
+	assert.Equal(t, 2, p.Degree())
+	assert.Equal(t, "x^2 + x", p.Add(q).String())
+	assert.Equal(t, "x^2 - x - 2", p.Sub(q).String())
+	assert.Equal(t, "x^3 + x^2 - x - 1", p.Mul(q).String())
+
+	quo, rem, err := p.DivMod(q)
+	assert.NoError(t, err)
+	assert.Equal(t, "x - 1", quo.String())
+	assert.True(t, rem.IsZero())
+
+	assert.True(t, rat(3, 1).Equal(p.Eval(rat(2, 1))))
+
+	_, _, err = p.DivMod(Zero(rat(0, 1)))
+	assert.Error(t, err)
+}
+
+func TestPolynomialGCD(t *testing.T) {
+	a := ratPoly(-1, 0, 1) // (x-1)(x+1)
+	b := ratPoly(2, -3, 1) // (x-1)(x-2)
+
+	g, err := GCD(a, b)
+	assert.NoError(t, err)
+	assert.Equal(t, "x - 1", g.String())
+
+	l, err := LCM(a, b)
+	assert.NoError(t, err)
+	assert.Equal(t, "x^3 - 2x^2 - x + 2", l.String())
+}
+
+func TestPolynomialDerivative(t *testing.T) {
+	assert.Equal(t, "3x^2 - 4x + 1", ratPoly(5, 1, -2, 1).Derivative().String())
+	assert.True(t, ratPoly(7).Derivative().IsZero())
+
+	// В GF(3) производная x^3 + 1 равна нулю
+	gf := func(v int64) field.GF {
+		x, _ := field.NewGF(v, 3)
+		return x
+	}
+	p := New([]field.GF{gf(1), gf(0), gf(0), gf(1)}, gf(0))
+	assert.True(t, p.Derivative().IsZero())
+}
+
+func TestPolynomialFormat(t *testing.T) {
+	assert.Equal(t, "0", Zero(rat(0, 1)).String())
+	assert.Equal(t, "-x^2 + 1/2x - 3/4", New([]field.Rational{rat(-3, 4), rat(1, 2), rat(-1, 1)}, rat(0, 1)).String())
+
+	c := New([]field.Complex{{Re: -2}, {Re: 1, Im: 2}, {Re: 1}}, field.Complex{})
+	assert.Equal(t, "λ^2 + (1+2i)λ - 2", c.Format("λ"))
+
+	gf := func(v int64) field.GF {
+		x, _ := field.NewGF(v, 5)
+		return x
+	}
+	g := New([]field.GF{gf(4), gf(0), gf(1)}, gf(0))
+	assert.Equal(t, "x^2 + 4 (mod 5)", g.String())
+}
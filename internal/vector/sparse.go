@@ -0,0 +1,281 @@
+package vector
+
+import (
+	"MatrixGo/internal/field"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// SparseVector хранит только ненулевые элементы вектора.
+// Индексы хранятся по возрастанию, явные нули не хранятся.
+type SparseVector[T field.Field[T]] struct {
+	Size    int
+	Indices []int
+	Values  []T
+	zero    T
+}
+
+// NewSparseVector создает нулевой разреженный вектор заданного размера
+func NewSparseVector[T field.Field[T]](size int, zero T) *SparseVector[T] {
+	return &SparseVector[T]{
+		Size: size,
+		zero: zero.Zero(),
+	}
+}
+
+// NewSparseVectorFromPairs создает разреженный вектор из пар индекс/значение.
+// Нулевые значения отбрасываются, повторяющиеся индексы суммируются.
+func NewSparseVectorFromPairs[T field.Field[T]](size int, indices []int, values []T, zero T) (*SparseVector[T], error) {
+	if len(indices) != len(values) {
+		return nil, errors.New("количество индексов не совпадает с количеством значений")
+	}
+
+	res := NewSparseVector(size, zero)
+	for k, idx := range indices {
+		if idx < 0 || idx >= size {
+			return nil, fmt.Errorf("индекс %d за пределами вектора размера %d", idx, size)
+		}
+		cur := res.At(idx)
+		res.Set(idx, cur.Add(values[k]))
+	}
+	return res, nil
+}
+
+// FromDense строит разреженный вектор по плотному
+func FromDense[T field.Field[T]](v *Vector[T]) *SparseVector[T] {
+	var zero T
+	if v.Size > 0 {
+		zero = v.Data[0].Zero()
+	}
+
+	res := &SparseVector[T]{Size: v.Size, zero: zero}
+	for i := 0; i < v.Size; i++ {
+		if !v.Data[i].Equal(zero) {
+			res.Indices = append(res.Indices, i)
+			res.Values = append(res.Values, v.Data[i])
+		}
+	}
+	return res
+}
+
+// ToDense преобразует разреженный вектор в плотный
+func (s *SparseVector[T]) ToDense() *Vector[T] {
+	data := make([]T, s.Size)
+	for i := range data {
+		data[i] = s.zero
+	}
+	for k, idx := range s.Indices {
+		data[idx] = s.Values[k]
+	}
+	return NewVector(data)
+}
+
+// Len возвращает размер вектора
+func (s *SparseVector[T]) Len() int {
+	return s.Size
+}
+
+// Nnz возвращает количество хранимых ненулевых элементов
+func (s *SparseVector[T]) Nnz() int {
+	return len(s.Indices)
+}
+
+// Zero возвращает нулевой элемент поля, над которым построен вектор
+func (s *SparseVector[T]) Zero() T {
+	return s.zero
+}
+
+// find возвращает позицию индекса в Indices и признак его наличия
+func (s *SparseVector[T]) find(index int) (int, bool) {
+	pos := sort.SearchInts(s.Indices, index)
+	return pos, pos < len(s.Indices) && s.Indices[pos] == index
+}
+
+// At возвращает элемент по индексу (ноль, если элемент не хранится)
+func (s *SparseVector[T]) At(index int) T {
+	if pos, ok := s.find(index); ok {
+		return s.Values[pos]
+	}
+	return s.zero
+}
+
+// Set записывает значение по индексу; запись нуля удаляет элемент
+func (s *SparseVector[T]) Set(index int, val T) error {
+	if index < 0 || index >= s.Size {
+		return fmt.Errorf("индекс за пределами")
+	}
+
+	pos, ok := s.find(index)
+	switch {
+	case val.Equal(s.zero) && ok:
+		s.Indices = append(s.Indices[:pos], s.Indices[pos+1:]...)
+		s.Values = append(s.Values[:pos], s.Values[pos+1:]...)
+	case val.Equal(s.zero):
+	case ok:
+		s.Values[pos] = val
+	default:
+		s.Indices = append(s.Indices, 0)
+		s.Values = append(s.Values, val)
+		copy(s.Indices[pos+1:], s.Indices[pos:])
+		copy(s.Values[pos+1:], s.Values[pos:])
+		s.Indices[pos] = index
+		s.Values[pos] = val
+	}
+	return nil
+}
+
+// Clone возвращает копию разреженного вектора
+func (s *SparseVector[T]) Clone() *SparseVector[T] {
+	res := &SparseVector[T]{
+		Size:    s.Size,
+		Indices: make([]int, len(s.Indices)),
+		Values:  make([]T, len(s.Values)),
+		zero:    s.zero,
+	}
+	copy(res.Indices, s.Indices)
+	copy(res.Values, s.Values)
+	return res
+}
+
+// Add возвращает сумму двух разреженных векторов
+func (s *SparseVector[T]) Add(other *SparseVector[T]) (*SparseVector[T], error) {
+	if s.Size != other.Size {
+		return nil, errors.New("разные размеры векторов")
+	}
+
+	res := &SparseVector[T]{Size: s.Size, zero: s.zero}
+	i, j := 0, 0
+	for i < len(s.Indices) || j < len(other.Indices) {
+		switch {
+		case j >= len(other.Indices) || (i < len(s.Indices) && s.Indices[i] < other.Indices[j]):
+			res.Indices = append(res.Indices, s.Indices[i])
+			res.Values = append(res.Values, s.Values[i])
+			i++
+		case i >= len(s.Indices) || other.Indices[j] < s.Indices[i]:
+			res.Indices = append(res.Indices, other.Indices[j])
+			res.Values = append(res.Values, other.Values[j])
+			j++
+		default:
+			sum := s.Values[i].Add(other.Values[j])
+			if !sum.Equal(s.zero) {
+				res.Indices = append(res.Indices, s.Indices[i])
+				res.Values = append(res.Values, sum)
+			}
+			i++
+			j++
+		}
+	}
+	return res, nil
+}
+
+// Scale возвращает вектор, умноженный на скаляр
+func (s *SparseVector[T]) Scale(alpha T) *SparseVector[T] {
+	res := &SparseVector[T]{Size: s.Size, zero: s.zero}
+	if alpha.Equal(s.zero) {
+		return res
+	}
+	for k, idx := range s.Indices {
+		val := s.Values[k].Mul(alpha)
+		if !val.Equal(s.zero) {
+			res.Indices = append(res.Indices, idx)
+			res.Values = append(res.Values, val)
+		}
+	}
+	return res
+}
+
+// Dot вычисляет скалярное произведение двух разреженных векторов.
+// Перемножаются только элементы с совпадающими индексами.
+func (s *SparseVector[T]) Dot(other *SparseVector[T]) (T, error) {
+	if s.Size != other.Size {
+		return s.zero, errors.New("разные размеры векторов")
+	}
+
+	sum := s.zero
+	i, j := 0, 0
+	for i < len(s.Indices) && j < len(other.Indices) {
+		switch {
+		case s.Indices[i] < other.Indices[j]:
+			i++
+		case s.Indices[i] > other.Indices[j]:
+			j++
+		default:
+			sum = sum.Add(s.Values[i].Mul(other.Values[j]))
+			i++
+			j++
+		}
+	}
+	return sum, nil
+}
+
+// DotDense вычисляет скалярное произведение с плотным вектором
+func (s *SparseVector[T]) DotDense(v *Vector[T]) (T, error) {
+	if s.Size != v.Len() {
+		return s.zero, errors.New("разные размеры векторов")
+	}
+
+	sum := s.zero
+	for k, idx := range s.Indices {
+		sum = sum.Add(s.Values[k].Mul(v.Data[idx]))
+	}
+	return sum, nil
+}
+
+// AXPY выполняет s = alpha*x + s на месте
+func (s *SparseVector[T]) AXPY(alpha T, x *SparseVector[T]) error {
+	if s.Size != x.Size {
+		return errors.New("разные размеры векторов")
+	}
+
+	res, _ := s.Add(x.Scale(alpha))
+	s.Indices = res.Indices
+	s.Values = res.Values
+	return nil
+}
+
+// sparseEntryJSON описывает один ненулевой элемент в JSON
+type sparseEntryJSON struct {
+	Index int    `json:"index"`
+	Value string `json:"value"`
+}
+
+// sparseVectorJSON описывает разреженный вектор в JSON
+type sparseVectorJSON struct {
+	Size    int               `json:"size"`
+	Entries []sparseEntryJSON `json:"entries"`
+}
+
+// MarshalJSON реализует интерфейс json.Marshaler
+func (s *SparseVector[T]) MarshalJSON() ([]byte, error) {
+	jsonData := sparseVectorJSON{
+		Size:    s.Size,
+		Entries: make([]sparseEntryJSON, len(s.Indices)),
+	}
+	for k, idx := range s.Indices {
+		jsonData.Entries[k] = sparseEntryJSON{Index: idx, Value: fmt.Sprintf("%v", s.Values[k])}
+	}
+	return json.Marshal(jsonData)
+}
+
+// SparseVectorFromJSON восстанавливает разреженный вектор из JSON.
+// Значения элементов разбираются функцией parse, ноль поля задается явно.
+func SparseVectorFromJSON[T field.Field[T]](data []byte, zero T, parse func(string) (T, error)) (*SparseVector[T], error) {
+	var jsonData sparseVectorJSON
+	if err := json.Unmarshal(data, &jsonData); err != nil {
+		return nil, fmt.Errorf("ошибка разбора JSON: %w", err)
+	}
+
+	indices := make([]int, len(jsonData.Entries))
+	values := make([]T, len(jsonData.Entries))
+	for k, e := range jsonData.Entries {
+		val, err := parse(e.Value)
+		if err != nil {
+			return nil, fmt.Errorf("ошибка парсинга элемента с индексом %d: %w", e.Index, err)
+		}
+		indices[k] = e.Index
+		values[k] = val
+	}
+	return NewSparseVectorFromPairs(jsonData.Size, indices, values, zero)
+}
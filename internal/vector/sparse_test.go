@@ -0,0 +1,77 @@
+package vector
+
+import (
+	"MatrixGo/internal/field"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSparseVectorDenseRoundTrip(t *testing.T) {
+	dense := NewVector([]field.Float64{0, 3, 0, 0, -1})
+	s := FromDense(dense)
+
+	assert.Equal(t, 5, s.Len())
+	assert.Equal(t, 2, s.Nnz())
+	assert.Equal(t, []int{1, 4}, s.Indices)
+	assert.Equal(t, dense.Data, s.ToDense().Data)
+}
+
+func TestSparseVectorOperations(t *testing.T) {
+	a, _ := NewSparseVectorFromPairs(6, []int{0, 2, 5}, []field.Float64{1, 2, 3}, field.Float64(0))
+	b, _ := NewSparseVectorFromPairs(6, []int{2, 3, 5}, []field.Float64{-2, 4, 1}, field.Float64(0))
+
+	t.Run("add drops cancelled entries", func(t *testing.T) {
+		sum, err := a.Add(b)
+		assert.NoError(t, err)
+		assert.Equal(t, []int{0, 3, 5}, sum.Indices)
+		assert.Equal(t, []field.Float64{1, 4, 4}, sum.Values)
+	})
+
+	t.Run("dot", func(t *testing.T) {
+		dot, err := a.Dot(b)
+		assert.NoError(t, err)
+		assert.Equal(t, field.Float64(-1), dot)
+	})
+
+	t.Run("scale", func(t *testing.T) {
+		scaled := a.Scale(2)
+		assert.Equal(t, []field.Float64{2, 4, 6}, scaled.Values)
+		assert.Equal(t, 0, a.Scale(0).Nnz())
+	})
+
+	t.Run("axpy", func(t *testing.T) {
+		y := b.Clone()
+		assert.NoError(t, y.AXPY(1, a))
+		assert.Equal(t, []int{0, 3, 5}, y.Indices)
+		assert.Equal(t, field.Float64(4), y.At(5))
+	})
+
+	t.Run("size mismatch", func(t *testing.T) {
+		c := NewSparseVector(3, field.Float64(0))
+		_, err := a.Add(c)
+		assert.Error(t, err)
+		_, err = a.Dot(c)
+		assert.Error(t, err)
+	})
+}
+
+func TestSparseVectorJSON(t *testing.T) {
+	s, _ := NewSparseVectorFromPairs(4, []int{3, 1}, []field.Rational{field.NewRational(1, 2), field.NewRational(-3, 1)}, field.NewRational(0, 1))
+
+	data, err := s.MarshalJSON()
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"size":4,"entries":[{"index":1,"value":"-3"},{"index":3,"value":"1/2"}]}`, string(data))
+
+	f, _ := NewSparseVectorFromPairs(5, []int{0, 4}, []field.Float64{2.5, -1}, field.Float64(0))
+	data, err = f.MarshalJSON()
+	assert.NoError(t, err)
+
+	restored, err := SparseVectorFromJSON(data, field.Float64(0), field.ParseFloat64)
+	assert.NoError(t, err)
+	assert.Equal(t, f.Indices, restored.Indices)
+	assert.Equal(t, f.Values, restored.Values)
+
+	_, err = SparseVectorFromJSON([]byte(`{"size":2,"entries":[{"index":5,"value":"1"}]}`), field.Float64(0), field.ParseFloat64)
+	assert.Error(t, err)
+}
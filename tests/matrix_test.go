@@ -67,16 +67,53 @@ func TestSolveSystem(t *testing.T) {
 	}
 }
 
-func TestSolveHomoSystem(t *testing.T) {
+func TestNullSpace(t *testing.T) {
 
 	A := matrix.NewMatrix[field.Float64](3, 3, field.Float64(0))
 	A.Data[0] = []field.Float64{field.Float64(1), field.Float64(2), field.Float64(3)}
 	A.Data[1] = []field.Float64{field.Float64(4), field.Float64(5), field.Float64(6)}
 	A.Data[2] = []field.Float64{field.Float64(7), field.Float64(8), field.Float64(9)}
 
-	_, err := matrix.SolveHomoSystem(A)
-	assert.Error(t, err)
+	basis := matrix.NullSpace(A)
+	assert.Len(t, basis, 1)
+
+	// Проверяем, что базисный вектор действительно лежит в ядре
+	for i := 0; i < A.Rows; i++ {
+		sum := field.Float64(0)
+		for j := 0; j < A.Cols; j++ {
+			sum = sum.Add(A.Data[i][j].Mul(basis[0].Data[j]))
+		}
+		assert.InDelta(t, 0, float64(sum), 1e-10)
+	}
+
+	left := matrix.LeftNullSpace(A)
+	assert.Len(t, left, 1)
 
+	I := matrix.Eye(3, field.Float64(0), field.Float64(1))
+	assert.Empty(t, matrix.NullSpace(I))
+}
+
+func TestNullSpaceRectangular(t *testing.T) {
+	gf := func(v int64) field.GF {
+		x, _ := field.NewGF(v, 5)
+		return x
+	}
+	A, _ := matrix.FromSlice([][]field.GF{
+		{gf(1), gf(2), gf(3), gf(4)},
+		{gf(2), gf(4), gf(1), gf(3)},
+	})
+
+	basis := matrix.NullSpace(A)
+	assert.Len(t, basis, A.Cols-A.Rank())
+	for _, v := range basis {
+		for i := 0; i < A.Rows; i++ {
+			sum := gf(0)
+			for j := 0; j < A.Cols; j++ {
+				sum = sum.Add(A.Data[i][j].Mul(v.Data[j]))
+			}
+			assert.True(t, sum.Equal(gf(0)))
+		}
+	}
 }
 
 func TestMatrixTranspose(t *testing.T) {
@@ -390,7 +390,9 @@ function OperationSelect({ value, onChange }) {
         { value: 'solve', label: 'Решить систему уравнений', icon: '🧮' },
         { value: 'determinant', label: 'Вычислить определитель', icon: '📊' },
         { value: 'rank', label: 'Вычислить ранг', icon: '📈' },
-        { value: 'inverse', label: 'Найти обратную матрицу', icon: '🔄' }
+        { value: 'inverse', label: 'Найти обратную матрицу', icon: '🔄' },
+        { value: 'eigen', label: 'Собственные значения и векторы', icon: '🌀' },
+        { value: 'analyze', label: 'Свойства матрицы', icon: '🔍' }
     ];
 
     const selectedOperation = operations.find(op => op.value === value);
@@ -505,6 +507,9 @@ function App() {
     const [vectorB, setVectorB] = React.useState(Array(2).fill({ type: 'float', value: 0 }));
     const [operation, setOperation] = React.useState('solve');
     const [result, setResult] = React.useState(null);
+    const [solution, setSolution] = React.useState(null);
+    const [eigen, setEigen] = React.useState(null);
+    const [properties, setProperties] = React.useState(null);
     const [error, setError] = React.useState(null);
     const [loading, setLoading] = React.useState(false);
     const [numberType, setNumberType] = React.useState('float');
@@ -614,7 +619,7 @@ function App() {
         }
     }, []);
 
-    const needsSquareMatrix = ['solve', 'determinant', 'inverse'].includes(operation);
+    const needsSquareMatrix = ['determinant', 'inverse', 'eigen'].includes(operation);
 
     const numberTypes = [
         { value: 'float', label: 'Действительные числа', icon: '🔢' },
@@ -825,6 +830,9 @@ function App() {
         setLoading(true);
         setError(null);
         setResult(null);
+        setSolution(null);
+        setEigen(null);
+        setProperties(null);
 
         try {
             const matrixForServer = matrixA.map(row => 
@@ -952,6 +960,31 @@ function App() {
                 }
             };
 
+            if (operation === 'eigen') {
+                setEigen({
+                    values: data.values || [],
+                    vectors: data.vectors || [],
+                    hermitian: data.hermitian
+                });
+                return;
+            }
+
+            if (operation === 'analyze') {
+                setProperties(data);
+                return;
+            }
+
+            if (operation === 'solve') {
+                setSolution({
+                    kind: data.kind,
+                    parametric: data.parametric || [],
+                    freeVariables: data.freeVariables || []
+                });
+                if (data.kind === 'inconsistent') {
+                    return;
+                }
+            }
+
             if (Array.isArray(data.result)) {
                 if (Array.isArray(data.result[0])) {
                     setResult(data.result.map(row => row.map(processValue)));
@@ -977,6 +1010,8 @@ function App() {
             case 'determinant': return 'Вычисление определителя';
             case 'rank': return 'Вычисление ранга';
             case 'inverse': return 'Обратная матрица';
+            case 'eigen': return 'Собственные значения и векторы';
+            case 'analyze': return 'Структурные свойства матрицы';
             default: return '';
         }
     };
@@ -987,6 +1022,8 @@ function App() {
             case 'determinant': return 'bi-braces';
             case 'rank': return 'bi-bar-chart-fill';
             case 'inverse': return 'bi-arrow-repeat';
+            case 'eigen': return 'bi-bullseye';
+            case 'analyze': return 'bi-search';
             default: return 'bi-calculator';
         }
     };
@@ -1057,10 +1094,13 @@ function App() {
                             value={operation}
                             onChange={(value) => {
                                 setOperation(value);
-                                if (['solve', 'determinant', 'inverse'].includes(value)) {
+                                if (['determinant', 'inverse', 'eigen'].includes(value)) {
                                     handleSizeChange(rows);
                                 }
                                 setResult(null);
+                                setSolution(null);
+                                setEigen(null);
+                                setProperties(null);
                                 setError(null);
                             }}
                         />
@@ -1162,6 +1202,107 @@ function App() {
                     </div>
                 )}
 
+                {solution && solution.kind === 'inconsistent' && (
+                    <div className="row mt-3">
+                        <div className="col">
+                            <div className="result-container">
+                                <h4 className="text-center mb-4">
+                                    <i className="bi bi-x-circle-fill me-2"></i>
+                                    Система несовместна
+                                </h4>
+                                <p className="text-center">Ранг расширенной матрицы больше ранга матрицы системы, решений нет.</p>
+                            </div>
+                        </div>
+                    </div>
+                )}
+
+                {eigen && (
+                    <div className="row mt-3">
+                        <div className="col">
+                            <div className="result-container">
+                                <h4 className="text-center mb-4">
+                                    <i className="bi bi-check-circle-fill me-2"></i>
+                                    Собственные значения и векторы:
+                                </h4>
+                                {eigen.hermitian && (
+                                    <p className="text-center">
+                                        Матрица симметрична (эрмитова): собственные значения вещественны, векторы ортонормированы.
+                                    </p>
+                                )}
+                                <table className="table eigen-table">
+                                    <thead>
+                                        <tr>
+                                            <th>λ</th>
+                                            <th>Собственный вектор</th>
+                                        </tr>
+                                    </thead>
+                                    <tbody>
+                                        {eigen.values.map((value, i) => (
+                                            <tr key={i}>
+                                                <td className="eigen-value">λ{i + 1} = {value}</td>
+                                                <td className="eigen-vector">({eigen.vectors[i].join(', ')})</td>
+                                            </tr>
+                                        ))}
+                                    </tbody>
+                                </table>
+                            </div>
+                        </div>
+                    </div>
+                )}
+
+                {properties && (
+                    <div className="row mt-3">
+                        <div className="col">
+                            <div className="result-container">
+                                <h4 className="text-center mb-4">
+                                    <i className="bi bi-check-circle-fill me-2"></i>
+                                    Свойства матрицы {properties.rows}×{properties.cols}:
+                                </h4>
+                                <p className="text-center">
+                                    {properties.exact
+                                        ? 'Проверки выполнены в точной арифметике.'
+                                        : `Проверки выполнены с относительным допуском ${properties.tolerance}.`}
+                                </p>
+                                <table className="table properties-table">
+                                    <tbody>
+                                        {[
+                                            ['Квадратная', properties.square],
+                                            ['Симметричная (A = Aᵀ)', properties.symmetric],
+                                            ['Кососимметричная (A = −Aᵀ)', properties.skewSymmetric],
+                                            ['Эрмитова (A = Aᴴ)', properties.hermitian],
+                                            ['Ортогональная (AᵀA = I)', properties.orthogonal],
+                                            ['Унитарная (AᴴA = I)', properties.unitary],
+                                            ['Диагональная', properties.diagonal],
+                                            ['Верхнетреугольная', properties.upperTriangular],
+                                            ['Нижнетреугольная', properties.lowerTriangular],
+                                            ['Идемпотентная (A² = A)', properties.idempotent],
+                                            ['Инволютивная (A² = I)', properties.involutory],
+                                            [properties.nilpotent
+                                                ? `Нильпотентная (индекс ${properties.nilpotencyIndex})`
+                                                : 'Нильпотентная', properties.nilpotent],
+                                            ['Положительно определенная', properties.positiveDefinite],
+                                            ['Диагонализуемая', properties.diagonalizable]
+                                        ].map(([label, value]) => (
+                                            <tr key={label}>
+                                                <td>{label}</td>
+                                                <td className="property-value">
+                                                    {value === undefined || value === null ? (
+                                                        <span className="text-muted">не определено</span>
+                                                    ) : value ? (
+                                                        <i className="bi bi-check-lg text-success"></i>
+                                                    ) : (
+                                                        <i className="bi bi-x-lg text-danger"></i>
+                                                    )}
+                                                </td>
+                                            </tr>
+                                        ))}
+                                    </tbody>
+                                </table>
+                            </div>
+                        </div>
+                    </div>
+                )}
+
                 {result && (
                     <div className="row mt-3">
                         <div className="col">
@@ -1192,6 +1333,17 @@ function App() {
                                 ) : (
                                     <p className="text-center fs-4">{formatNumber({ type: 'float', value: result })}</p>
                                 )}
+                                {solution && solution.kind === 'infinite' && (
+                                    <div className="parametric-solution mt-4">
+                                        <p>
+                                            Бесконечно много решений. Выше — частное решение,
+                                            свободные переменные: {solution.freeVariables.join(', ')}
+                                        </p>
+                                        {solution.parametric.map((equation, i) => (
+                                            <div key={i} className="parametric-equation">{equation}</div>
+                                        ))}
+                                    </div>
+                                )}
                             </div>
                         </div>
                     </div>